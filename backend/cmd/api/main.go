@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -12,10 +13,12 @@ import (
 	"github.com/yourusername/yuroku/internal/adapter/controller"
 	"github.com/yourusername/yuroku/internal/adapter/gateway"
 	"github.com/yourusername/yuroku/internal/adapter/presenter"
+	"github.com/yourusername/yuroku/internal/domain/entity"
 	"github.com/yourusername/yuroku/internal/domain/service"
 	"github.com/yourusername/yuroku/internal/infrastructure/database"
 	"github.com/yourusername/yuroku/internal/infrastructure/middleware"
 	"github.com/yourusername/yuroku/internal/infrastructure/router"
+	"github.com/yourusername/yuroku/internal/infrastructure/scheduler"
 	"github.com/yourusername/yuroku/internal/infrastructure/storage"
 	"github.com/yourusername/yuroku/internal/usecase/interactor"
 )
@@ -46,22 +49,90 @@ func main() {
 	userRepo := gateway.NewMongoUserRepository(db)
 	onsenLogRepo := gateway.NewMongoOnsenLogRepository(db)
 	onsenImageRepo := gateway.NewMongoOnsenImageRepository(db)
+	onsenRepo := gateway.NewMongoOnsenRepository(db)
+	achievementRepo := gateway.NewMongoAchievementRepository(db)
+	wishlistRepo := gateway.NewMongoWishlistRepository(db)
+	tripRepo := gateway.NewMongoTripRepository(db)
+	shareLinkRepo := gateway.NewMongoShareLinkRepository(db)
+	followRepo := gateway.NewMongoFollowRepository(db)
+	onsenLogRevisionRepo := gateway.NewMongoOnsenLogRevisionRepository(db)
+	exportJobRepo := gateway.NewMongoExportJobRepository(db)
+	calendarFeedRepo := gateway.NewMongoCalendarFeedRepository(db)
+
+	// ゴミ箱の設定
+	trashRetention := entity.DefaultTrashRetention // ゴミ箱の保持期間
+	if days, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS")); err == nil && days > 0 {
+		trashRetention = time.Duration(days) * 24 * time.Hour
+	}
+	trashPurgeInterval := scheduler.DefaultTrashPurgeInterval // ゴミ箱の定期削除の間隔
+	if interval, err := time.ParseDuration(os.Getenv("TRASH_PURGE_INTERVAL")); err == nil && interval > 0 {
+		trashPurgeInterval = interval
+	}
+
+	// エクスポートジョブの設定
+	exportRetention := entity.DefaultExportRetention // エクスポートしたファイルをダウンロードできる期間
+	if hours, err := strconv.Atoi(os.Getenv("EXPORT_RETENTION_HOURS")); err == nil && hours > 0 {
+		exportRetention = time.Duration(hours) * time.Hour
+	}
+	exportJobInterval := scheduler.DefaultExportJobInterval // 実行待ちのエクスポートジョブを確認する間隔
+	if interval, err := time.ParseDuration(os.Getenv("EXPORT_JOB_INTERVAL")); err == nil && interval > 0 {
+		exportJobInterval = interval
+	}
+
+	// カレンダーフィードの設定
+	publicBaseURL := os.Getenv("PUBLIC_BASE_URL") // カレンダーアプリからアクセスできるサーバーの公開URL
 
 	// ドメインサービスを初期化
 	jwtSecret := os.Getenv("JWT_SECRET")
 	authService := service.NewAuthService(userRepo, jwtSecret)
-	onsenLogService := service.NewOnsenLogService(onsenLogRepo, onsenImageRepo)
+	onsenLogService := service.NewOnsenLogService(onsenLogRepo, onsenImageRepo, onsenRepo, onsenLogRevisionRepo)
 	onsenImageService := service.NewOnsenImageService(onsenImageRepo, onsenLogRepo, fileStorage)
+	onsenService := service.NewOnsenService(onsenRepo, onsenLogRepo)
+	tagService := service.NewTagService(onsenLogRepo, onsenLogRevisionRepo)
+	prefectureService := service.NewPrefectureService(onsenLogRepo)
+	achievementService := service.NewAchievementService(achievementRepo, onsenLogRepo)
+	wishlistService := service.NewWishlistService(wishlistRepo)
+	tripService := service.NewTripService(tripRepo, onsenLogRepo)
+	shareLinkService := service.NewShareLinkService(shareLinkRepo, onsenLogRepo, onsenImageRepo)
+	followService := service.NewFollowService(followRepo, userRepo, onsenLogRepo)
+	trashService := service.NewTrashService(onsenLogRepo, onsenImageRepo, fileStorage, onsenLogRevisionRepo, trashRetention)
+	onsenLogRevisionService := service.NewOnsenLogRevisionService(onsenLogRevisionRepo, onsenLogRepo, onsenRepo)
+	exportJobService := service.NewExportJobService(exportJobRepo, onsenLogRepo, tripRepo, fileStorage, exportRetention)
+	calendarFeedService := service.NewCalendarFeedService(calendarFeedRepo, onsenLogRepo, tripRepo)
 
 	// プレゼンターを初期化
 	authPresenter := presenter.NewAuthPresenter()
 	onsenLogPresenter := presenter.NewOnsenLogPresenter()
 	onsenImagePresenter := presenter.NewOnsenImagePresenter()
+	onsenPresenter := presenter.NewOnsenPresenter()
+	tagPresenter := presenter.NewTagPresenter()
+	prefecturePresenter := presenter.NewPrefecturePresenter()
+	achievementPresenter := presenter.NewAchievementPresenter()
+	wishlistPresenter := presenter.NewWishlistPresenter()
+	tripPresenter := presenter.NewTripPresenter()
+	shareLinkPresenter := presenter.NewShareLinkPresenter()
+	followPresenter := presenter.NewFollowPresenter()
+	trashPresenter := presenter.NewTrashPresenter()
+	onsenLogRevisionPresenter := presenter.NewOnsenLogRevisionPresenter()
+	exportJobPresenter := presenter.NewExportJobPresenter()
+	calendarFeedPresenter := presenter.NewCalendarFeedPresenter()
 
 	// プレゼンターをOutputPortにアダプト
 	authOutputPort := presenter.NewAuthOutputAdapter(authPresenter)
 	onsenLogOutputPort := presenter.NewOnsenLogOutputAdapter(onsenLogPresenter)
 	onsenImageOutputPort := presenter.NewOnsenImageOutputAdapter(onsenImagePresenter)
+	onsenOutputPort := presenter.NewOnsenOutputAdapter(onsenPresenter)
+	tagOutputPort := presenter.NewTagOutputAdapter(tagPresenter)
+	prefectureOutputPort := presenter.NewPrefectureOutputAdapter(prefecturePresenter)
+	achievementOutputPort := presenter.NewAchievementOutputAdapter(achievementPresenter)
+	wishlistOutputPort := presenter.NewWishlistOutputAdapter(wishlistPresenter)
+	tripOutputPort := presenter.NewTripOutputAdapter(tripPresenter)
+	shareLinkOutputPort := presenter.NewShareLinkOutputAdapter(shareLinkPresenter)
+	followOutputPort := presenter.NewFollowOutputAdapter(followPresenter)
+	trashOutputPort := presenter.NewTrashOutputAdapter(trashPresenter)
+	onsenLogRevisionOutputPort := presenter.NewOnsenLogRevisionOutputAdapter(onsenLogRevisionPresenter)
+	exportJobOutputPort := presenter.NewExportJobOutputAdapter(exportJobPresenter)
+	calendarFeedOutputPort := presenter.NewCalendarFeedOutputAdapter(calendarFeedPresenter)
 
 	// JWTの設定
 	accessTokenDuration := 15 * time.Minute    // アクセストークンの有効期限
@@ -69,13 +140,37 @@ func main() {
 
 	// ユースケースを初期化
 	authInteractor := interactor.NewAuthInteractor(authService, authOutputPort, jwtSecret, accessTokenDuration, refreshTokenDuration)
-	onsenLogInteractor := interactor.NewOnsenLogInteractor(onsenLogService, onsenImageService, onsenLogOutputPort)
+	onsenLogInteractor := interactor.NewOnsenLogInteractor(onsenLogService, onsenImageService, achievementService, onsenLogOutputPort)
 	onsenImageInteractor := interactor.NewOnsenImageInteractor(onsenImageService, onsenImageOutputPort)
+	onsenInteractor := interactor.NewOnsenInteractor(onsenService, onsenOutputPort)
+	tagInteractor := interactor.NewTagInteractor(tagService, tagOutputPort)
+	prefectureInteractor := interactor.NewPrefectureInteractor(prefectureService, prefectureOutputPort)
+	achievementInteractor := interactor.NewAchievementInteractor(achievementService, achievementOutputPort)
+	wishlistInteractor := interactor.NewWishlistInteractor(wishlistService, onsenLogInteractor, wishlistOutputPort)
+	tripInteractor := interactor.NewTripInteractor(tripService, onsenLogInteractor, tripOutputPort)
+	shareLinkInteractor := interactor.NewShareLinkInteractor(shareLinkService, shareLinkOutputPort)
+	followInteractor := interactor.NewFollowInteractor(followService, followOutputPort)
+	trashInteractor := interactor.NewTrashInteractor(trashService, trashOutputPort)
+	onsenLogRevisionInteractor := interactor.NewOnsenLogRevisionInteractor(onsenLogRevisionService, onsenLogRevisionOutputPort)
+	exportJobInteractor := interactor.NewExportJobInteractor(exportJobService, onsenLogInteractor, tripInteractor, exportJobOutputPort)
+	calendarFeedInteractor := interactor.NewCalendarFeedInteractor(calendarFeedService, calendarFeedOutputPort, publicBaseURL)
 
 	// コントローラーを初期化
 	authController := controller.NewAuthController(authInteractor)
 	onsenLogController := controller.NewOnsenLogController(onsenLogInteractor)
 	onsenImageController := controller.NewOnsenImageController(onsenImageInteractor)
+	onsenController := controller.NewOnsenController(onsenInteractor)
+	tagController := controller.NewTagController(tagInteractor)
+	prefectureController := controller.NewPrefectureController(prefectureInteractor)
+	achievementController := controller.NewAchievementController(achievementInteractor)
+	wishlistController := controller.NewWishlistController(wishlistInteractor)
+	tripController := controller.NewTripController(tripInteractor)
+	shareLinkController := controller.NewShareLinkController(shareLinkInteractor)
+	followController := controller.NewFollowController(followInteractor)
+	trashController := controller.NewTrashController(trashInteractor)
+	onsenLogRevisionController := controller.NewOnsenLogRevisionController(onsenLogRevisionInteractor)
+	exportJobController := controller.NewExportJobController(exportJobInteractor)
+	calendarFeedController := controller.NewCalendarFeedController(calendarFeedInteractor)
 
 	// ミドルウェアを初期化
 	authMiddleware := middleware.NewAuthMiddleware(authService)
@@ -86,6 +181,18 @@ func main() {
 		authController,
 		onsenLogController,
 		onsenImageController,
+		onsenController,
+		tagController,
+		prefectureController,
+		achievementController,
+		wishlistController,
+		tripController,
+		shareLinkController,
+		followController,
+		trashController,
+		onsenLogRevisionController,
+		exportJobController,
+		calendarFeedController,
 	)
 
 	// ルートを設定
@@ -97,6 +204,16 @@ func main() {
 		port = "8080"
 	}
 
+	// ゴミ箱の定期削除を起動
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go scheduler.NewTrashPurger(trashInteractor, trashPurgeInterval).Run(purgeCtx)
+
+	// エクスポートジョブのランナーを起動
+	exportCtx, stopExport := context.WithCancel(context.Background())
+	defer stopExport()
+	go scheduler.NewExportJobRunner(exportJobInteractor, exportJobInterval).Run(exportCtx)
+
 	// シグナル処理を設定
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -113,6 +230,10 @@ func main() {
 	<-quit
 	log.Println("Shutting down server...")
 
+	// ゴミ箱の定期削除とエクスポートジョブのランナーを停止
+	stopPurge()
+	stopExport()
+
 	// グレースフルシャットダウンのためのコンテキスト
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// buildSearchTerms はすべての温泉メモについてキーワード検索用のN-gramを再計算します
+// 読み仮名辞書を更新した場合も、このマイグレーションを再実行すると反映されます
+func buildSearchTerms(ctx context.Context, db *mongo.Database, dryRun bool) error {
+	onsenLogsCollection := db.Collection("onsen_logs")
+
+	cursor, err := onsenLogsCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	updated := 0
+	for cursor.Next(ctx) {
+		var onsenLog entity.OnsenLog
+		if err := cursor.Decode(&onsenLog); err != nil {
+			return err
+		}
+
+		onsenLog.RefreshSearchTerms()
+		if dryRun {
+			log.Printf("[dry-run] %s: %d語", onsenLog.Name, len(onsenLog.SearchTerms))
+			updated++
+			continue
+		}
+
+		// 検索用のN-gramのみを更新（更新日時は変更しない）
+		_, err = onsenLogsCollection.UpdateOne(ctx,
+			bson.M{"_id": onsenLog.ID},
+			bson.M{"$set": bson.M{
+				"search_terms": onsenLog.SearchTerms,
+				"name_terms":   onsenLog.NameTerms,
+			}},
+		)
+		if err != nil {
+			return err
+		}
+		updated++
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	log.Printf("%d件の温泉メモの検索用データを更新しました", updated)
+	return nil
+}
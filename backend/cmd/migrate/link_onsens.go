@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/yourusername/yuroku/internal/adapter/gateway"
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+)
+
+// linkOnsens は温泉施設に紐づいていない温泉メモを、正規化した温泉名と所在地で施設に紐づけます
+func linkOnsens(ctx context.Context, db *mongo.Database, dryRun bool) error {
+	onsenLogsCollection := db.Collection("onsen_logs")
+	onsenService := service.NewOnsenService(
+		gateway.NewMongoOnsenRepository(db),
+		gateway.NewMongoOnsenLogRepository(db),
+	)
+
+	// 施設IDが未設定の温泉メモを取得
+	filter := bson.M{"$or": bson.A{
+		bson.M{"onsen_id": bson.M{"$exists": false}},
+		bson.M{"onsen_id": ""},
+	}}
+	cursor, err := onsenLogsCollection.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	linked := 0
+	for cursor.Next(ctx) {
+		var onsenLog entity.OnsenLog
+		if err := cursor.Decode(&onsenLog); err != nil {
+			return err
+		}
+
+		if dryRun {
+			log.Printf("[dry-run] %s (%s) -> %s", onsenLog.Name, onsenLog.Location, entity.NormalizeOnsenKey(onsenLog.Name, onsenLog.Location))
+			linked++
+			continue
+		}
+
+		// 照合キーで施設を検索し、なければ作成
+		onsen, err := onsenService.FindOrCreateOnsen(ctx, onsenLog.UserID, onsenLog.Name, onsenLog.Location)
+		if err != nil {
+			return err
+		}
+
+		// 温泉メモに施設IDを設定
+		_, err = onsenLogsCollection.UpdateOne(ctx,
+			bson.M{"_id": onsenLog.ID},
+			bson.M{"$set": bson.M{"onsen_id": onsen.UUID}},
+		)
+		if err != nil {
+			return err
+		}
+		linked++
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	log.Printf("%d件の温泉メモを温泉施設に紐づけました", linked)
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/yourusername/yuroku/internal/infrastructure/database"
+)
+
+// migration は既存データを移行する処理を表します
+type migration struct {
+	Name        string
+	Description string
+	Run         func(ctx context.Context, db *mongo.Database, dryRun bool) error
+}
+
+// migrations は利用可能なマイグレーションの一覧です（実行推奨順）
+var migrations = []migration{
+	{
+		Name:        "link_onsens",
+		Description: "温泉メモを温泉名と所在地で照合し、温泉施設に紐づけます",
+		Run:         linkOnsens,
+	},
+	{
+		Name:        "build_search_terms",
+		Description: "温泉メモのキーワード検索用データ（N-gram）を作成します",
+		Run:         buildSearchTerms,
+	},
+	{
+		Name:        "rating_scores",
+		Description: "従来の評価を総合評価として項目別評価の形式に移行します",
+		Run:         migrateRatingScores,
+	},
+	{
+		Name:        "spring_types",
+		Description: "以前の泉質を療養泉の泉質分類（複数指定）に移行します",
+		Run:         migrateSpringTypes,
+	},
+	{
+		Name:        "prefectures",
+		Description: "所在地から都道府県と市区町村を判定して保存します",
+		Run:         resolvePrefectures,
+	},
+}
+
+func main() {
+	name := flag.String("name", "", "実行するマイグレーション名")
+	dryRun := flag.Bool("dry-run", false, "データを変更せずに対象件数のみ表示します")
+	flag.Parse()
+
+	// 環境変数を読み込み
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	// マイグレーションを検索
+	var target *migration
+	for i := range migrations {
+		if migrations[i].Name == *name {
+			target = &migrations[i]
+			break
+		}
+	}
+	if target == nil {
+		log.Println("利用可能なマイグレーション:")
+		for _, m := range migrations {
+			log.Printf("  %-20s %s", m.Name, m.Description)
+		}
+		os.Exit(1)
+	}
+
+	// MongoDBに接続
+	db, err := database.GetMongoDB()
+	if err != nil {
+		log.Fatalf("データベース接続エラー: %v", err)
+	}
+
+	log.Printf("マイグレーション %s を開始します（dry-run: %v）", target.Name, *dryRun)
+	if err := target.Run(context.Background(), db, *dryRun); err != nil {
+		log.Fatalf("マイグレーションに失敗しました: %v", err)
+	}
+	log.Printf("マイグレーション %s が完了しました", target.Name)
+}
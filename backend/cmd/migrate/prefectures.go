@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// resolvePrefectures はすべての温泉メモについて所在地から都道府県と市区町村を判定します
+// 判定できなかった温泉メモは area.unresolved を true にし、所在地を一覧で出力します
+// 都道府県データを更新した場合も、このマイグレーションを再実行すると反映されます
+func resolvePrefectures(ctx context.Context, db *mongo.Database, dryRun bool) error {
+	onsenLogsCollection := db.Collection("onsen_logs")
+
+	cursor, err := onsenLogsCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	resolved, unresolved := 0, 0
+	for cursor.Next(ctx) {
+		var onsenLog entity.OnsenLog
+		if err := cursor.Decode(&onsenLog); err != nil {
+			return err
+		}
+
+		area := entity.ResolveLocationArea(onsenLog.Location)
+		if area.Unresolved {
+			log.Printf("都道府県を判定できませんでした: %s（%s: %q）", onsenLog.UUID, onsenLog.Name, onsenLog.Location)
+			unresolved++
+		} else {
+			resolved++
+		}
+
+		if dryRun {
+			if !area.Unresolved {
+				log.Printf("[dry-run] %s: %s -> %s %s", onsenLog.Name, onsenLog.Location, area.Prefecture, area.Municipality)
+			}
+			continue
+		}
+
+		// 都道府県と市区町村のみを更新（更新日時は変更しない）
+		_, err = onsenLogsCollection.UpdateOne(ctx,
+			bson.M{"_id": onsenLog.ID},
+			bson.M{"$set": bson.M{"area": area}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	log.Printf("%d件の温泉メモの都道府県を判定しました（判定できなかった温泉メモ: %d件）", resolved, unresolved)
+	return nil
+}
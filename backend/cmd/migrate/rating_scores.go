@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// migrateRatingScores は項目別評価の導入前の温泉メモを移行します
+// 従来の評価（rating）は手動で指定した総合評価として扱い、項目別評価は未評価にします
+func migrateRatingScores(ctx context.Context, db *mongo.Database, dryRun bool) error {
+	onsenLogsCollection := db.Collection("onsen_logs")
+	notMigrated := bson.M{"rating_overridden": bson.M{"$exists": false}}
+
+	if dryRun {
+		count, err := onsenLogsCollection.CountDocuments(ctx, notMigrated)
+		if err != nil {
+			return err
+		}
+		log.Printf("[dry-run] %d件の温泉メモが移行対象です", count)
+		return nil
+	}
+
+	// 評価済みの温泉メモは総合評価を手動指定として扱う
+	rated, err := onsenLogsCollection.UpdateMany(ctx,
+		bson.M{"rating_overridden": bson.M{"$exists": false}, "rating": bson.M{"$gt": 0}},
+		bson.M{"$set": bson.M{
+			"rating_overridden": true,
+			"rating_scores":     entity.RatingScores{},
+		}},
+	)
+	if err != nil {
+		return err
+	}
+
+	// 未評価の温泉メモは項目別評価の入力を待つ
+	unrated, err := onsenLogsCollection.UpdateMany(ctx,
+		notMigrated,
+		bson.M{"$set": bson.M{
+			"rating_overridden": false,
+			"rating_scores":     entity.RatingScores{},
+		}},
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%d件の温泉メモの評価を移行しました（うち未評価 %d件）", rated.ModifiedCount+unrated.ModifiedCount, unrated.ModifiedCount)
+	return nil
+}
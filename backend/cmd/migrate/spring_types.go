@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// migrateSpringTypes は以前の単一の泉質（spring_type）を療養泉の泉質のリスト（spring_types）に移行します
+// 元の値は legacy_spring_type に残し、療養泉の泉質に対応しない値（アルカリ泉など）は泉質を空にします
+func migrateSpringTypes(ctx context.Context, db *mongo.Database, dryRun bool) error {
+	onsenLogsCollection := db.Collection("onsen_logs")
+
+	// 移行前の温泉メモを取得
+	filter := bson.M{"spring_type": bson.M{"$exists": true}}
+	cursor, err := onsenLogsCollection.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	migrated := 0
+	unmapped := make(map[string]int)
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID         primitive.ObjectID `bson:"_id"`
+			SpringType string             `bson:"spring_type"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+
+		springTypes := []entity.SpringType{}
+		if springType, ok := entity.SpringTypeFromLegacy(doc.SpringType); ok {
+			springTypes = append(springTypes, springType)
+		} else if doc.SpringType != "" {
+			unmapped[doc.SpringType]++
+		}
+
+		if dryRun {
+			log.Printf("[dry-run] %s: %s -> %v", doc.ID.Hex(), doc.SpringType, springTypes)
+			migrated++
+			continue
+		}
+
+		_, err = onsenLogsCollection.UpdateOne(ctx,
+			bson.M{"_id": doc.ID},
+			bson.M{
+				"$set": bson.M{
+					"spring_types":       springTypes,
+					"legacy_spring_type": doc.SpringType,
+					"water_analysis":     entity.WaterAnalysis{},
+				},
+				"$unset": bson.M{"spring_type": ""},
+			},
+		)
+		if err != nil {
+			return err
+		}
+		migrated++
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	for legacy, count := range unmapped {
+		log.Printf("療養泉の泉質に対応しない値: %s（%d件、泉質は未設定になります）", legacy, count)
+	}
+	log.Printf("%d件の温泉メモの泉質を移行しました", migrated)
+	return nil
+}
@@ -26,6 +26,7 @@ func main() {
 	usersCollection := db.Collection("users")
 	onsenLogsCollection := db.Collection("onsen_logs")
 	onsenImagesCollection := db.Collection("onsen_images")
+	onsensCollection := db.Collection("onsens")
 
 	// 既存データのクリア（オプション）
 	ctx := context.Background()
@@ -33,6 +34,7 @@ func main() {
 	usersCollection.Drop(ctx)
 	onsenLogsCollection.Drop(ctx)
 	onsenImagesCollection.Drop(ctx)
+	onsensCollection.Drop(ctx)
 
 	// ユーザーデータの作成
 	users := createUsers()
@@ -51,6 +53,16 @@ func main() {
 	onsenLogs := createOnsenLogs(users)
 	log.Printf("%d件の温泉ログデータを作成しました", len(onsenLogs))
 
+	// 温泉施設データの作成と挿入
+	onsens := createOnsens(onsenLogs)
+	for _, onsen := range onsens {
+		_, err := onsensCollection.InsertOne(ctx, onsen)
+		if err != nil {
+			log.Printf("温泉施設挿入エラー: %v", err)
+		}
+	}
+	log.Printf("%d件の温泉施設データを挿入しました", len(onsens))
+
 	// 温泉ログデータの挿入
 	var insertedOnsenLogs []*entity.OnsenLog
 	for _, onsenLog := range onsenLogs {
@@ -132,103 +144,123 @@ func createOnsenLogs(users []*entity.User) []*entity.OnsenLog {
 		// 田中太郎の温泉ログ
 		if user.Name == "田中太郎" {
 			onsenLogs = append(onsenLogs, &entity.OnsenLog{
-				ID:         primitive.NewObjectID(),
-				UUID:       uuid.New().String(),
-				UserID:     user.UUID,
-				Name:       "草津温泉",
-				Location:   "群馬県吾妻郡草津町",
-				SpringType: entity.SpringTypeSulfur,
+				ID:          primitive.NewObjectID(),
+				UUID:        uuid.New().String(),
+				UserID:      user.UUID,
+				Name:        "草津温泉",
+				Location:    "群馬県吾妻郡草津町",
+				SpringTypes: []entity.SpringType{entity.SpringTypeAcidic, entity.SpringTypeSulfur},
+				WaterAnalysis: entity.WaterAnalysis{
+					SourceTemperature: float64Ptr(53.1),
+					PH:                float64Ptr(2.1),
+					Tonicity:          entity.TonicityHypotonic,
+				},
 				Features: []entity.Feature{
 					entity.FeatureOutdoorBath,
 					entity.FeatureViewpoint,
 					entity.FeatureHistorical,
 				},
-				VisitDate: time.Now().AddDate(0, -1, 0),
-				Rating:    5,
-				Comment:   "湯畑が素晴らしく、お湯の質も最高でした。硫黄の香りが強いですが、肌に良い感じがします。次回はゆっくり宿泊したいです。",
-				CreatedAt: now,
-				UpdatedAt: now,
+				VisitDate:        time.Now().AddDate(0, -1, 0),
+				Rating:           5,
+				RatingOverridden: true,
+				Comment:          "湯畑が素晴らしく、お湯の質も最高でした。硫黄の香りが強いですが、肌に良い感じがします。次回はゆっくり宿泊したいです。",
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			})
 
 			onsenLogs = append(onsenLogs, &entity.OnsenLog{
-				ID:         primitive.NewObjectID(),
-				UUID:       uuid.New().String(),
-				UserID:     user.UUID,
-				Name:       "箱根湯本温泉",
-				Location:   "神奈川県足柄下郡箱根町",
-				SpringType: entity.SpringTypeSalt,
+				ID:          primitive.NewObjectID(),
+				UUID:        uuid.New().String(),
+				UserID:      user.UUID,
+				Name:        "箱根湯本温泉",
+				Location:    "神奈川県足柄下郡箱根町",
+				SpringTypes: []entity.SpringType{entity.SpringTypeChloride},
 				Features: []entity.Feature{
 					entity.FeatureOutdoorBath,
 					entity.FeatureRestaurant,
 					entity.FeatureAccommodation,
 				},
 				VisitDate: time.Now().AddDate(0, -3, 0),
-				Rating:    4,
-				Comment:   "都心から近く便利。塩化物泉で湯冷めしにくいです。箱根の自然も楽しめて、温泉街の雰囲気も良かったです。",
-				CreatedAt: now,
-				UpdatedAt: now,
+				Visit: entity.VisitDetails{
+					ArrivalTime:     "14:00",
+					DurationMinutes: 90,
+					StayType:        entity.StayTypeDayTrip,
+					Companions:      []entity.Companion{entity.CompanionFamily},
+					Currency:        entity.DefaultCurrency,
+					EntranceFee:     float64Ptr(1450),
+					OtherCosts:      []entity.VisitCost{{Label: "昼食", Amount: 1800}},
+					TotalCost:       3250,
+				},
+				Rating:           4,
+				RatingOverridden: true,
+				Comment:          "都心から近く便利。塩化物泉で湯冷めしにくいです。箱根の自然も楽しめて、温泉街の雰囲気も良かったです。",
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			})
 		}
 
 		// 鈴木花子の温泉ログ
 		if user.Name == "鈴木花子" {
 			onsenLogs = append(onsenLogs, &entity.OnsenLog{
-				ID:         primitive.NewObjectID(),
-				UUID:       uuid.New().String(),
-				UserID:     user.UUID,
-				Name:       "城崎温泉",
-				Location:   "兵庫県豊岡市城崎町",
-				SpringType: entity.SpringTypeSimple,
+				ID:          primitive.NewObjectID(),
+				UUID:        uuid.New().String(),
+				UserID:      user.UUID,
+				Name:        "城崎温泉",
+				Location:    "兵庫県豊岡市城崎町",
+				SpringTypes: []entity.SpringType{entity.SpringTypeSimple},
 				Features: []entity.Feature{
 					entity.FeatureOutdoorBath,
 					entity.FeatureHistorical,
 					entity.FeatureAccommodation,
 				},
-				VisitDate: time.Now().AddDate(0, -2, 0),
-				Rating:    5,
-				Comment:   "風情ある温泉街が素敵。浴衣で外湯めぐりを楽しめました。7つの外湯を全て巡り、それぞれに特徴があって面白かったです。",
-				CreatedAt: now,
-				UpdatedAt: now,
+				VisitDate:        time.Now().AddDate(0, -2, 0),
+				Rating:           5,
+				RatingOverridden: true,
+				Comment:          "風情ある温泉街が素敵。浴衣で外湯めぐりを楽しめました。7つの外湯を全て巡り、それぞれに特徴があって面白かったです。",
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			})
 		}
 
 		// 佐藤一郎の温泉ログ
 		if user.Name == "佐藤一郎" {
 			onsenLogs = append(onsenLogs, &entity.OnsenLog{
-				ID:         primitive.NewObjectID(),
-				UUID:       uuid.New().String(),
-				UserID:     user.UUID,
-				Name:       "別府温泉",
-				Location:   "大分県別府市",
-				SpringType: entity.SpringTypeSulfur,
+				ID:          primitive.NewObjectID(),
+				UUID:        uuid.New().String(),
+				UserID:      user.UUID,
+				Name:        "別府温泉",
+				Location:    "大分県別府市",
+				SpringTypes: []entity.SpringType{entity.SpringTypeSulfur},
 				Features: []entity.Feature{
 					entity.FeatureOutdoorBath,
 					entity.FeatureDirectFromSpring,
 				},
-				VisitDate: time.Now().AddDate(0, -1, -15),
-				Rating:    4,
-				Comment:   "地獄めぐりが面白かった。多様な泉質が楽しめる温泉郷です。特に砂湯が気持ち良かったです。",
-				CreatedAt: now,
-				UpdatedAt: now,
+				VisitDate:        time.Now().AddDate(0, -1, -15),
+				Rating:           4,
+				RatingOverridden: true,
+				Comment:          "地獄めぐりが面白かった。多様な泉質が楽しめる温泉郷です。特に砂湯が気持ち良かったです。",
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			})
 
 			onsenLogs = append(onsenLogs, &entity.OnsenLog{
-				ID:         primitive.NewObjectID(),
-				UUID:       uuid.New().String(),
-				UserID:     user.UUID,
-				Name:       "黒川温泉",
-				Location:   "熊本県南小国町",
-				SpringType: entity.SpringTypeSimple,
+				ID:          primitive.NewObjectID(),
+				UUID:        uuid.New().String(),
+				UserID:      user.UUID,
+				Name:        "黒川温泉",
+				Location:    "熊本県南小国町",
+				SpringTypes: []entity.SpringType{entity.SpringTypeSimple},
 				Features: []entity.Feature{
 					entity.FeatureOutdoorBath,
 					entity.FeaturePrivateBath,
 					entity.FeatureViewpoint,
 				},
-				VisitDate: time.Now().AddDate(0, -4, 0),
-				Rating:    5,
-				Comment:   "自然に囲まれた露天風呂が最高。入湯手形で3つの温泉を巡りました。周囲の自然と調和した温泉で癒されました。",
-				CreatedAt: now,
-				UpdatedAt: now,
+				VisitDate:        time.Now().AddDate(0, -4, 0),
+				Rating:           5,
+				RatingOverridden: true,
+				Comment:          "自然に囲まれた露天風呂が最高。入湯手形で3つの温泉を巡りました。周囲の自然と調和した温泉で癒されました。",
+				CreatedAt:        now,
+				UpdatedAt:        now,
 			})
 		}
 	}
@@ -236,6 +268,28 @@ func createOnsenLogs(users []*entity.User) []*entity.OnsenLog {
 	return onsenLogs
 }
 
+// 温泉施設データを作成し、温泉ログに紐づける関数（検索用データもあわせて作成）
+func createOnsens(onsenLogs []*entity.OnsenLog) []*entity.Onsen {
+	var onsens []*entity.Onsen
+	onsensByKey := make(map[string]*entity.Onsen)
+
+	for _, onsenLog := range onsenLogs {
+		key := onsenLog.UserID + "/" + entity.NormalizeOnsenKey(onsenLog.Name, onsenLog.Location)
+		onsen, ok := onsensByKey[key]
+		if !ok {
+			onsen = entity.NewOnsen(onsenLog.UserID, onsenLog.Name, onsenLog.Location)
+			onsen.ID = primitive.NewObjectID()
+			onsensByKey[key] = onsen
+			onsens = append(onsens, onsen)
+		}
+		onsenLog.OnsenID = onsen.UUID
+		onsenLog.Area = entity.ResolveLocationArea(onsenLog.Location)
+		onsenLog.RefreshSearchTerms()
+	}
+
+	return onsens
+}
+
 // 温泉画像データを作成する関数
 func createOnsenImages(onsenLogs []*entity.OnsenLog, users []*entity.User) []*entity.OnsenImage {
 	var onsenImages []*entity.OnsenImage
@@ -274,3 +328,8 @@ func createOnsenImages(onsenLogs []*entity.OnsenLog, users []*entity.User) []*en
 
 	return onsenImages
 }
+
+// float64の値をポインタにする関数
+func float64Ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// AchievementController は実績関連のコントローラーです
+type AchievementController struct {
+	achievementUseCase port.AchievementInputPort
+}
+
+// NewAchievementController は新しい実績コントローラーを作成します
+func NewAchievementController(achievementUseCase port.AchievementInputPort) *AchievementController {
+	return &AchievementController{
+		achievementUseCase: achievementUseCase,
+	}
+}
+
+// GetAchievements はユーザーの獲得済みの実績と達成途中の実績を取得します
+func (c *AchievementController) GetAchievements(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	achievements, err := c.achievementUseCase.GetAchievements(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, achievements, "実績を取得しました")
+}
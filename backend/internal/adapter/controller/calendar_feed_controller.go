@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// CalendarFeedController はカレンダーフィード関連のコントローラーです
+type CalendarFeedController struct {
+	calendarFeedUseCase port.CalendarFeedInputPort
+}
+
+// NewCalendarFeedController は新しいカレンダーフィードコントローラーを作成します
+func NewCalendarFeedController(calendarFeedUseCase port.CalendarFeedInputPort) *CalendarFeedController {
+	return &CalendarFeedController{
+		calendarFeedUseCase: calendarFeedUseCase,
+	}
+}
+
+// IssueCalendarFeed はカレンダーフィードを発行します
+// 発行済みの場合はトークンを再発行するため、以前のフィードURLは使用できなくなります
+func (c *CalendarFeedController) IssueCalendarFeed(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	feed, err := c.calendarFeedUseCase.IssueCalendarFeed(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusCreated, gin.H{
+		"calendar_feed": feed,
+	}, "カレンダーフィードを発行しました")
+}
+
+// GetCalendarFeed はカレンダーフィードを取得します
+func (c *CalendarFeedController) GetCalendarFeed(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	feed, err := c.calendarFeedUseCase.GetCalendarFeed(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"calendar_feed": feed,
+	}, "カレンダーフィードを取得しました")
+}
+
+// DeleteCalendarFeed はカレンダーフィードを削除します
+func (c *CalendarFeedController) DeleteCalendarFeed(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.calendarFeedUseCase.DeleteCalendarFeed(ctx.Request.Context(), userID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "カレンダーフィードを削除しました")
+}
+
+// GetCalendarFeedICS はカレンダーフィードをiCalendar形式で返します
+// カレンダーアプリから購読できるよう、認証の代わりにパスのトークンで検証します（末尾の .ics は省略可能）
+func (c *CalendarFeedController) GetCalendarFeedICS(ctx *gin.Context) {
+	// パスパラメータからトークンを取得
+	token, ok := ValidatePathParam(ctx, "token", "トークンが指定されていません")
+	if !ok {
+		return
+	}
+	token = strings.TrimSuffix(token, ".ics")
+
+	// ユースケースを呼び出し（レスポンスに直接書き出す）
+	streamExport(ctx, "text/calendar; charset=utf-8", "yuroku.ics", func(w io.Writer) error {
+		return c.calendarFeedUseCase.WriteCalendarFeed(ctx.Request.Context(), token, w)
+	})
+}
@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// ExportJobController はエクスポートジョブ関連のコントローラーです
+type ExportJobController struct {
+	exportJobUseCase port.ExportJobInputPort
+}
+
+// NewExportJobController は新しいエクスポートジョブコントローラーを作成します
+func NewExportJobController(exportJobUseCase port.ExportJobInputPort) *ExportJobController {
+	return &ExportJobController{
+		exportJobUseCase: exportJobUseCase,
+	}
+}
+
+// exportJobRequest はエクスポートジョブ作成のリクエストボディです
+// 出力オプションの値はエクスポートAPIのクエリパラメータと同じです
+type exportJobRequest struct {
+	Format     string   `json:"format"`
+	TripID     string   `json:"trip_id"`
+	Encoding   string   `json:"encoding"`
+	Delimiter  string   `json:"delimiter"`
+	Columns    []string `json:"columns"`
+	DateFormat string   `json:"date_format"`
+	Header     string   `json:"header"`
+}
+
+// value はリクエストボディの出力オプションの値を返します（列はカンマ区切りにします）
+func (r exportJobRequest) value(key string) string {
+	switch key {
+	case "encoding":
+		return r.Encoding
+	case "delimiter":
+		return r.Delimiter
+	case "columns":
+		return strings.Join(r.Columns, ",")
+	case "date_format":
+		return r.DateFormat
+	case "header":
+		return r.Header
+	}
+	return ""
+}
+
+// CreateExportJob はエクスポートジョブを作成します
+// エクスポートはバックグラウンドで実行するため、作成したジョブを202 Acceptedで返します
+func (c *ExportJobController) CreateExportJob(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド（ボディは省略可能）
+	var input exportJobRequest
+	if ctx.Request.ContentLength != 0 && !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// フォーマットを検証
+	format := input.Format
+	if format == "" {
+		format = "json"
+	}
+	exportFormat, ok := exportFormats[format]
+	if !ok {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_FORMAT", "サポートされているフォーマットは json, csv, geojson, kml, gpx, xlsx, zip です")
+		return
+	}
+
+	// CSVの出力オプションを取得
+	csvOptions, ok := parseCSVExportOptions(ctx, input.value)
+	if !ok {
+		return
+	}
+
+	// 旅行のエクスポートはファイル名を旅行のエクスポートと揃える
+	filename := exportFormat.filename
+	if input.TripID != "" {
+		filename = "trip_" + filename
+	}
+
+	// ユースケースを呼び出し
+	job, err := c.exportJobUseCase.CreateExportJob(ctx.Request.Context(), port.CreateExportJobInput{
+		UserID:      userID,
+		Format:      format,
+		TripID:      input.TripID,
+		CSV:         csvOptions,
+		FileName:    filename,
+		ContentType: exportContentType(format, exportFormat, csvOptions),
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusAccepted, gin.H{
+		"export": job,
+	}, "エクスポートを受け付けました")
+}
+
+// GetExportJobs はユーザーのエクスポートジョブを取得します
+func (c *ExportJobController) GetExportJobs(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	jobs, err := c.exportJobUseCase.GetExportJobs(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"exports": jobs,
+	}, "エクスポートの一覧を取得しました")
+}
+
+// GetExportJob はエクスポートジョブの状態と進捗を取得します
+func (c *ExportJobController) GetExportJob(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "エクスポートIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	job, err := c.exportJobUseCase.GetExportJob(ctx.Request.Context(), id, userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"export": job,
+	}, "エクスポートの状態を取得しました")
+}
+
+// DownloadExportFile はエクスポートしたファイルをダウンロードします
+// ダウンロードURLだけで取得できるよう、認証の代わりにクエリパラメータのトークンで検証します
+func (c *ExportJobController) DownloadExportFile(ctx *gin.Context) {
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "エクスポートIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// クエリパラメータからトークンを取得
+	token := ctx.Query("token")
+	if token == "" {
+		RespondWithError(ctx, http.StatusBadRequest, "MISSING_TOKEN", "トークンが指定されていません")
+		return
+	}
+
+	// ユースケースを呼び出し
+	file, reader, err := c.exportJobUseCase.OpenExportFile(ctx.Request.Context(), id, token)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+	defer reader.Close()
+
+	ctx.DataFromReader(http.StatusOK, file.Size, file.ContentType, reader, map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%s", file.FileName),
+	})
+}
@@ -0,0 +1,315 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// FollowController はフォローとフィード関連のコントローラーです
+type FollowController struct {
+	followUseCase port.FollowInputPort
+}
+
+// NewFollowController は新しいフォローコントローラーを作成します
+func NewFollowController(followUseCase port.FollowInputPort) *FollowController {
+	return &FollowController{
+		followUseCase: followUseCase,
+	}
+}
+
+// Follow はユーザーにフォローリクエストを送ります
+// フォローするユーザーは user_id で指定します
+func (c *FollowController) Follow(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input struct {
+		UserID string `json:"user_id" binding:"required"`
+	}
+
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// ユースケースを呼び出し
+	user, err := c.followUseCase.Follow(ctx.Request.Context(), port.FollowInput{
+		UserID:     userID,
+		FolloweeID: input.UserID,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	message := "フォローリクエストを送信しました"
+	if user.Status == entity.FollowStatusApproved {
+		message = "既にフォローしています"
+	}
+	RespondWithSuccess(ctx, http.StatusCreated, user, message)
+}
+
+// Unfollow はユーザーのフォローを解除します
+func (c *FollowController) Unfollow(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからフォローを解除するユーザーのIDを取得
+	followeeID, ok := ValidatePathParam(ctx, "user_id", "ユーザーIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.followUseCase.Unfollow(ctx.Request.Context(), userID, followeeID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "フォローを解除しました")
+}
+
+// GetFollowRequests は承認待ちのフォローリクエストを取得します
+func (c *FollowController) GetFollowRequests(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	users, err := c.followUseCase.GetFollowRequests(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"users": users,
+	}, "フォローリクエストを取得しました")
+}
+
+// ApproveFollowRequest はフォローリクエストを承認します
+func (c *FollowController) ApproveFollowRequest(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからリクエストしたユーザーのIDを取得
+	followerID, ok := ValidatePathParam(ctx, "user_id", "ユーザーIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	user, err := c.followUseCase.ApproveFollowRequest(ctx.Request.Context(), userID, followerID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, user, "フォローリクエストを承認しました")
+}
+
+// RejectFollowRequest はフォローリクエストを拒否します
+func (c *FollowController) RejectFollowRequest(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからリクエストしたユーザーのIDを取得
+	followerID, ok := ValidatePathParam(ctx, "user_id", "ユーザーIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.followUseCase.RejectFollowRequest(ctx.Request.Context(), userID, followerID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "フォローリクエストを拒否しました")
+}
+
+// RemoveFollower はフォロワーを解除します
+func (c *FollowController) RemoveFollower(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータから解除するフォロワーのIDを取得
+	followerID, ok := ValidatePathParam(ctx, "user_id", "ユーザーIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.followUseCase.RemoveFollower(ctx.Request.Context(), userID, followerID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "フォロワーを解除しました")
+}
+
+// GetFollowing はフォローしているユーザーを取得します
+func (c *FollowController) GetFollowing(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	users, err := c.followUseCase.GetFollowing(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"users": users,
+	}, "フォロー中のユーザーを取得しました")
+}
+
+// GetFollowers はフォロワーを取得します
+func (c *FollowController) GetFollowers(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	users, err := c.followUseCase.GetFollowers(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"users": users,
+	}, "フォロワーを取得しました")
+}
+
+// GetFeed はフォローしているユーザーの温泉メモのフィードを取得します
+// cursor に前のレスポンスの next_cursor を指定すると続きを取得できます
+func (c *FollowController) GetFeed(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// クエリパラメータから取得件数を取得
+	limit, ok := parseFeedLimit(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	feed, err := c.followUseCase.GetFeed(ctx.Request.Context(), port.GetFeedInput{
+		UserID: userID,
+		Cursor: ctx.Query("cursor"),
+		Limit:  limit,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, feedResponse(feed), "フィードを取得しました")
+}
+
+// GetUserOnsenLogs はユーザーの温泉メモのうち閲覧者が閲覧できるものを取得します
+// 認証は任意で、未ログインの場合は公開の温泉メモのみを返します
+func (c *FollowController) GetUserOnsenLogs(ctx *gin.Context) {
+	// パスパラメータからユーザーIDを取得
+	userID, ok := ValidatePathParam(ctx, "id", "ユーザーIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// クエリパラメータから取得件数を取得
+	limit, ok := parseFeedLimit(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	feed, err := c.followUseCase.GetUserOnsenLogs(ctx.Request.Context(), port.GetUserOnsenLogsInput{
+		UserID:   userID,
+		ViewerID: ctx.GetString("userID"),
+		Cursor:   ctx.Query("cursor"),
+		Limit:    limit,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, feedResponse(feed), "ユーザーの温泉メモを取得しました")
+}
+
+// parseFeedLimit はクエリパラメータから取得件数をパースします
+// 省略した場合は0を返し、形式が無効な場合はエラーレスポンスを返してfalseを返します
+func parseFeedLimit(ctx *gin.Context) (int, bool) {
+	limitStr := ctx.Query("limit")
+	if limitStr == "" {
+		return 0, true
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < 1 {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_LIMIT", "取得件数は1以上の整数で指定してください")
+		return 0, false
+	}
+	return limit, true
+}
+
+// feedResponse はフィードの出力データをレスポンス用に整形します
+func feedResponse(feed port.FeedOutputData) gin.H {
+	items := make([]gin.H, len(feed.Items))
+	for i, item := range feed.Items {
+		items[i] = gin.H{
+			"id": item.ID,
+			"author": gin.H{
+				"id":   item.AuthorID,
+				"name": item.AuthorName,
+			},
+			"visibility":   item.Visibility,
+			"name":         item.Name,
+			"location":     item.Location,
+			"area":         item.Area,
+			"spring_types": item.SpringTypes,
+			"features":     item.Features,
+			"tags":         item.Tags,
+			"visit_date":   item.VisitDate.Format("2006-01-02"),
+			"rating":       item.Rating,
+			"comment":      item.Comment,
+			"created_at":   item.CreatedAt,
+		}
+	}
+
+	return gin.H{
+		"items":       items,
+		"next_cursor": feed.NextCursor,
+		"has_more":    feed.HasMore,
+	}
+}
@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// OnsenController は温泉施設関連のコントローラーです
+type OnsenController struct {
+	onsenUseCase port.OnsenInputPort
+}
+
+// NewOnsenController は新しい温泉施設コントローラーを作成します
+func NewOnsenController(onsenUseCase port.OnsenInputPort) *OnsenController {
+	return &OnsenController{
+		onsenUseCase: onsenUseCase,
+	}
+}
+
+// CreateOnsen は新しい温泉施設を作成します
+func (c *OnsenController) CreateOnsen(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input struct {
+		Name     string `json:"name" binding:"required"`
+		Location string `json:"location"`
+	}
+
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// ユースケースを呼び出し
+	onsen, err := c.onsenUseCase.CreateOnsen(ctx.Request.Context(), port.CreateOnsenInput{
+		UserID:   userID,
+		Name:     input.Name,
+		Location: input.Location,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusCreated, onsen, "温泉施設を作成しました")
+}
+
+// GetOnsens はユーザーの温泉施設リストを訪問集計付きで取得します
+func (c *OnsenController) GetOnsens(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	onsens, err := c.onsenUseCase.GetOnsens(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"onsens": onsens,
+	}, "温泉施設リストを取得しました")
+}
+
+// GetOnsen は特定の温泉施設を訪問集計付きで取得します
+func (c *OnsenController) GetOnsen(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉施設IDが必要です")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	onsen, err := c.onsenUseCase.GetOnsen(ctx.Request.Context(), id, userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, onsen, "温泉施設を取得しました")
+}
+
+// GetOnsenVisits は温泉施設に紐づく温泉メモを取得します
+func (c *OnsenController) GetOnsenVisits(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉施設IDが必要です")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	onsenLogs, err := c.onsenUseCase.GetOnsenVisits(ctx.Request.Context(), id, userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"onsen_logs": onsenLogs,
+	}, "温泉施設の訪問記録を取得しました")
+}
+
+// UpdateOnsen は温泉施設を更新します
+func (c *OnsenController) UpdateOnsen(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉施設IDが必要です")
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input struct {
+		Name     string `json:"name" binding:"required"`
+		Location string `json:"location"`
+	}
+
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// ユースケースを呼び出し
+	onsen, err := c.onsenUseCase.UpdateOnsen(ctx.Request.Context(), port.UpdateOnsenInput{
+		ID:       id,
+		UserID:   userID,
+		Name:     input.Name,
+		Location: input.Location,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, onsen, "温泉施設を更新しました")
+}
+
+// DeleteOnsen は温泉施設を削除します
+func (c *OnsenController) DeleteOnsen(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉施設IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.onsenUseCase.DeleteOnsen(ctx.Request.Context(), id, userID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "温泉施設の削除に成功しました")
+}
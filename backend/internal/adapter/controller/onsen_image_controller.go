@@ -114,7 +114,7 @@ func (c *OnsenImageController) GetImagesByOnsenID(ctx *gin.Context) {
 	}, "画像の取得に成功しました")
 }
 
-// DeleteImage は温泉画像を削除します
+// DeleteImage は温泉画像をゴミ箱に移動します
 func (c *OnsenImageController) DeleteImage(ctx *gin.Context) {
 	// コンテキストからユーザーIDを取得
 	userID, ok := GetUserID(ctx)
@@ -141,5 +141,5 @@ func (c *OnsenImageController) DeleteImage(ctx *gin.Context) {
 		return
 	}
 
-	RespondWithSuccess(ctx, http.StatusOK, nil, "画像の削除に成功しました")
+	RespondWithSuccess(ctx, http.StatusOK, nil, "画像をゴミ箱に移動しました")
 }
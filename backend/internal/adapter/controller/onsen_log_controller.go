@@ -1,9 +1,13 @@
 package controller
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -11,6 +15,32 @@ import (
 	"github.com/yourusername/yuroku/internal/usecase/port"
 )
 
+// exportFormat はエクスポート形式ごとのレスポンス設定です
+type exportFormat struct {
+	contentType string
+	filename    string
+}
+
+// exportFormats はサポートしているエクスポート形式です
+var exportFormats = map[string]exportFormat{
+	"json":    {contentType: "application/json", filename: "onsen_logs.json"},
+	"csv":     {contentType: "text/csv", filename: "onsen_logs.csv"},
+	"geojson": {contentType: "application/geo+json", filename: "onsen_logs.geojson"},
+	"kml":     {contentType: "application/vnd.google-earth.kml+xml", filename: "onsen_logs.kml"},
+	"gpx":     {contentType: "application/gpx+xml", filename: "onsen_logs.gpx"},
+	"xlsx":    {contentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", filename: "onsen_logs.xlsx"},
+	"zip":     {contentType: "application/zip", filename: "onsen_logs.zip"},
+}
+
+// yearbookContentTypes はサポートしている温泉アルバムの形式ごとのContent-Typeです
+var yearbookContentTypes = map[string]string{
+	"html": "text/html; charset=utf-8",
+	"pdf":  "application/pdf",
+}
+
+// maxImportFileSize はインポートできるファイルの最大サイズです
+const maxImportFileSize = 5 << 20
+
 // OnsenLogController は温泉メモ関連のコントローラーです
 type OnsenLogController struct {
 	onsenLogUseCase port.OnsenLogInputPort
@@ -33,13 +63,22 @@ func (c *OnsenLogController) CreateOnsenLog(ctx *gin.Context) {
 
 	// リクエストボディをバインド
 	var input struct {
-		Name       string            `json:"name" binding:"required"`
-		Location   string            `json:"location" binding:"required"`
-		SpringType entity.SpringType `json:"spring_type" binding:"required"`
-		Features   []entity.Feature  `json:"features"`
-		VisitDate  string            `json:"visit_date" binding:"required"`
-		Rating     int               `json:"rating" binding:"required,min=1,max=5"`
-		Comment    string            `json:"comment"`
+		OnsenID       string               `json:"onsen_id"`
+		Name          string               `json:"name" binding:"required"`
+		Location      string               `json:"location" binding:"required"`
+		Latitude      *float64             `json:"latitude"`
+		Longitude     *float64             `json:"longitude"`
+		SpringTypes   []entity.SpringType  `json:"spring_types"`
+		SpringType    entity.SpringType    `json:"spring_type"` // 以前の形式（単一の泉質）
+		WaterAnalysis entity.WaterAnalysis `json:"water_analysis"`
+		Features      []entity.Feature     `json:"features"`
+		Tags          []string             `json:"tags"`
+		VisitDate     string               `json:"visit_date" binding:"required"`
+		Visit         entity.VisitDetails  `json:"visit"`
+		Rating        *float64             `json:"rating" binding:"omitempty,min=1,max=5"`
+		RatingScores  entity.RatingScores  `json:"rating_scores"`
+		Comment       string               `json:"comment"`
+		Visibility    entity.Visibility    `json:"visibility"`
 	}
 
 	if !ValidateBindJSON(ctx, &input) {
@@ -55,14 +94,22 @@ func (c *OnsenLogController) CreateOnsenLog(ctx *gin.Context) {
 
 	// 入力データを作成
 	createInput := port.CreateOnsenLogInput{
-		UserID:     userID,
-		Name:       input.Name,
-		Location:   input.Location,
-		SpringType: input.SpringType,
-		Features:   input.Features,
-		VisitDate:  visitDate,
-		Rating:     input.Rating,
-		Comment:    input.Comment,
+		UserID:        userID,
+		OnsenID:       input.OnsenID,
+		Name:          input.Name,
+		Location:      input.Location,
+		Latitude:      input.Latitude,
+		Longitude:     input.Longitude,
+		SpringTypes:   requestSpringTypes(input.SpringTypes, input.SpringType),
+		WaterAnalysis: input.WaterAnalysis,
+		Features:      input.Features,
+		Tags:          input.Tags,
+		VisitDate:     visitDate,
+		Visit:         input.Visit,
+		Rating:        input.Rating,
+		RatingScores:  input.RatingScores,
+		Comment:       input.Comment,
+		Visibility:    input.Visibility,
 	}
 
 	// ユースケースを呼び出し
@@ -77,19 +124,7 @@ func (c *OnsenLogController) CreateOnsenLog(ctx *gin.Context) {
 	}
 
 	// レスポンスを返す
-	RespondWithSuccess(ctx, http.StatusCreated, gin.H{
-		"id":          onsenLog.ID,
-		"name":        onsenLog.Name,
-		"location":    onsenLog.Location,
-		"spring_type": onsenLog.SpringType,
-		"features":    onsenLog.Features,
-		"visit_date":  onsenLog.VisitDate.Format("2006-01-02"),
-		"rating":      onsenLog.Rating,
-		"comment":     onsenLog.Comment,
-		"created_at":  onsenLog.CreatedAt,
-		"updated_at":  onsenLog.UpdatedAt,
-		"images":      onsenLog.Images,
-	}, "温泉メモを作成しました")
+	RespondWithSuccess(ctx, http.StatusCreated, onsenLogResponse(onsenLog), "温泉メモを作成しました")
 }
 
 // GetOnsenLog は特定の温泉メモを取得します
@@ -119,19 +154,7 @@ func (c *OnsenLogController) GetOnsenLog(ctx *gin.Context) {
 	}
 
 	// レスポンスを返す
-	RespondWithSuccess(ctx, http.StatusOK, gin.H{
-		"id":          onsenLog.ID,
-		"name":        onsenLog.Name,
-		"location":    onsenLog.Location,
-		"spring_type": onsenLog.SpringType,
-		"features":    onsenLog.Features,
-		"visit_date":  onsenLog.VisitDate.Format("2006-01-02"),
-		"rating":      onsenLog.Rating,
-		"comment":     onsenLog.Comment,
-		"created_at":  onsenLog.CreatedAt,
-		"updated_at":  onsenLog.UpdatedAt,
-		"images":      onsenLog.Images,
-	}, "温泉メモを取得しました")
+	RespondWithSuccess(ctx, http.StatusOK, onsenLogResponse(onsenLog), "温泉メモを取得しました")
 }
 
 // GetOnsenLogs はユーザーの温泉メモリストを取得します
@@ -157,13 +180,24 @@ func (c *OnsenLogController) GetOnsenLogs(ctx *gin.Context) {
 		limit = 10
 	}
 
-	// ユースケースを呼び出し
-	result, err := c.onsenLogUseCase.GetOnsenLogs(
-		ctx.Request.Context(),
-		userID,
-		page,
-		limit,
-	)
+	// タグが指定された場合はタグで絞り込む
+	var result port.OnsenLogsOutputData
+	if tags, tagMatch := parseTagsQuery(ctx); len(tags) > 0 {
+		result, err = c.onsenLogUseCase.GetFilteredOnsenLogs(ctx.Request.Context(), port.FilterOnsenLogsInput{
+			UserID:   userID,
+			Tags:     tags,
+			TagMatch: tagMatch,
+			Page:     page,
+			Limit:    limit,
+		})
+	} else {
+		result, err = c.onsenLogUseCase.GetOnsenLogs(
+			ctx.Request.Context(),
+			userID,
+			page,
+			limit,
+		)
+	}
 
 	if err != nil {
 		RespondWithAppError(ctx, err)
@@ -187,9 +221,37 @@ func (c *OnsenLogController) GetFilteredOnsenLogs(ctx *gin.Context) {
 		return
 	}
 
+	// クエリパラメータから絞り込み条件を取得
+	filterInput := parseFilterQuery(ctx, userID)
+
+	// ユースケースを呼び出し
+	result, err := c.onsenLogUseCase.GetFilteredOnsenLogs(
+		ctx.Request.Context(),
+		filterInput,
+	)
+
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	// レスポンスを返す
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"onsen_logs":  result.OnsenLogs,
+		"total_count": result.TotalCount,
+		"page":        result.Page,
+		"limit":       result.Limit,
+	}, "フィルタリングされた温泉メモリストを取得しました")
+}
+
+// parseFilterQuery はクエリパラメータから温泉メモの絞り込み条件を取得します
+// 絞り込み（/filter）と統計（/stats）で同じパラメータを使用します
+func parseFilterQuery(ctx *gin.Context, userID string) port.FilterOnsenLogsInput {
 	// クエリパラメータを取得
-	springTypeStr := ctx.Query("spring_type")
+	springTypes := parseSpringTypesQuery(ctx)
 	location := ctx.Query("location")
+	query := strings.TrimSpace(ctx.Query("q"))
+	tags, tagMatch := parseTagsQuery(ctx)
 	minRatingStr := ctx.DefaultQuery("min_rating", "0")
 	startDateStr := ctx.Query("start_date")
 	endDateStr := ctx.Query("end_date")
@@ -197,11 +259,20 @@ func (c *OnsenLogController) GetFilteredOnsenLogs(ctx *gin.Context) {
 	limitStr := ctx.DefaultQuery("limit", "10")
 
 	// 数値に変換
-	minRating, err := strconv.Atoi(minRatingStr)
+	minRating, err := strconv.ParseFloat(minRatingStr, 64)
 	if err != nil || minRating < 0 || minRating > 5 {
 		minRating = 0
 	}
 
+	// 項目別評価の最低値（min_water_quality=4 など）
+	minScores := make(map[entity.RatingCriterion]int)
+	for _, criterion := range entity.RatingCriteria {
+		minScore, err := strconv.Atoi(ctx.Query("min_" + string(criterion)))
+		if err == nil && minScore > 0 && minScore <= 5 {
+			minScores[criterion] = minScore
+		}
+	}
+
 	page, err := strconv.Atoi(pageStr)
 	if err != nil || page < 1 {
 		page = 1
@@ -228,28 +299,51 @@ func (c *OnsenLogController) GetFilteredOnsenLogs(ctx *gin.Context) {
 		}
 	}
 
-	// SpringTypeを変換
-	var springType entity.SpringType
-	if springTypeStr != "" {
-		springType = entity.SpringType(springTypeStr)
+	return port.FilterOnsenLogsInput{
+		UserID:         userID,
+		SpringTypes:    springTypes,
+		Location:       location,
+		Prefectures:    parseListQuery(ctx, "prefecture"),
+		MinRating:      minRating,
+		MinScores:      minScores,
+		MinPH:          parseFloatQuery(ctx, "min_ph"),
+		MaxPH:          parseFloatQuery(ctx, "max_ph"),
+		MinTemperature: parseFloatQuery(ctx, "min_temperature"),
+		MaxTemperature: parseFloatQuery(ctx, "max_temperature"),
+		Tonicity:       entity.Tonicity(ctx.Query("tonicity")),
+		StartDate:      startDate,
+		EndDate:        endDate,
+		StayType:       entity.StayType(ctx.Query("stay_type")),
+		Companions:     parseCompanionsQuery(ctx),
+		ArrivalFrom:    ctx.Query("arrival_from"),
+		ArrivalTo:      ctx.Query("arrival_to"),
+		MinDuration:    parseFloatQuery(ctx, "min_duration"),
+		MaxDuration:    parseFloatQuery(ctx, "max_duration"),
+		MinTotalCost:   parseFloatQuery(ctx, "min_total_cost"),
+		MaxTotalCost:   parseFloatQuery(ctx, "max_total_cost"),
+		Currency:       ctx.Query("currency"),
+		Query:          query,
+		Tags:           tags,
+		TagMatch:       tagMatch,
+		SortBy:         ctx.Query("sort_by"),
+		SortOrder:      ctx.Query("sort_order"),
+		Page:           page,
+		Limit:          limit,
 	}
+}
 
-	// 入力データを作成
-	filterInput := port.FilterOnsenLogsInput{
-		UserID:     userID,
-		SpringType: springType,
-		Location:   location,
-		MinRating:  minRating,
-		StartDate:  startDate,
-		EndDate:    endDate,
-		Page:       page,
-		Limit:      limit,
+// GetOnsenLogStats は絞り込み条件に一致する温泉メモの統計を取得します
+func (c *OnsenLogController) GetOnsenLogStats(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
 	}
 
 	// ユースケースを呼び出し
-	result, err := c.onsenLogUseCase.GetFilteredOnsenLogs(
+	stats, err := c.onsenLogUseCase.GetOnsenLogStats(
 		ctx.Request.Context(),
-		filterInput,
+		parseFilterQuery(ctx, userID),
 	)
 
 	if err != nil {
@@ -257,13 +351,114 @@ func (c *OnsenLogController) GetFilteredOnsenLogs(ctx *gin.Context) {
 		return
 	}
 
+	// レスポンスを返す
+	RespondWithSuccess(ctx, http.StatusOK, stats, "温泉メモの統計を取得しました")
+}
+
+// parseTagsQuery はクエリパラメータからタグと一致条件を取得します
+func parseTagsQuery(ctx *gin.Context) ([]string, entity.TagMatchMode) {
+	return parseListQuery(ctx, "tags"), entity.ParseTagMatchMode(ctx.Query("tag_match"))
+}
+
+// parseSpringTypesQuery はクエリパラメータから泉質を取得します（以前の spring_type も受け付けます）
+func parseSpringTypesQuery(ctx *gin.Context) []entity.SpringType {
+	var springTypes []entity.SpringType
+	for _, value := range parseListQuery(ctx, "spring_types") {
+		springTypes = append(springTypes, entity.SpringType(value))
+	}
+	return requestSpringTypes(springTypes, entity.SpringType(ctx.Query("spring_type")))
+}
+
+// parseCompanionsQuery はクエリパラメータから同行者の区分を取得します
+func parseCompanionsQuery(ctx *gin.Context) []entity.Companion {
+	var companions []entity.Companion
+	for _, value := range parseListQuery(ctx, "companions") {
+		companions = append(companions, entity.Companion(value))
+	}
+	return companions
+}
+
+// requestSpringTypes はリクエストの泉質を決定します
+// spring_types が空で以前の形式の spring_type が指定された場合は、療養泉の泉質に変換して使用します
+func requestSpringTypes(springTypes []entity.SpringType, legacy entity.SpringType) []entity.SpringType {
+	if len(springTypes) > 0 || legacy == "" {
+		return springTypes
+	}
+	if springType, ok := entity.SpringTypeFromLegacy(string(legacy)); ok {
+		return []entity.SpringType{springType}
+	}
+	return []entity.SpringType{legacy}
+}
+
+// parseListQuery は値のリストを取得します
+// name=a,b のカンマ区切りと name=a&name=b の繰り返し指定に対応します
+func parseListQuery(ctx *gin.Context, name string) []string {
+	var values []string
+	for _, value := range ctx.QueryArray(name) {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
+
+// parseFloatQuery は数値のクエリパラメータを取得します（未指定・不正な値の場合はnil）
+func parseFloatQuery(ctx *gin.Context, name string) *float64 {
+	value, err := strconv.ParseFloat(ctx.Query(name), 64)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// GetNearbyOnsenLogs は指定地点の周辺にある温泉メモを距離の近い順に取得します
+func (c *OnsenLogController) GetNearbyOnsenLogs(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// 緯度・経度は必須
+	latitude, errLat := strconv.ParseFloat(ctx.Query("lat"), 64)
+	longitude, errLng := strconv.ParseFloat(ctx.Query("lng"), 64)
+	if errLat != nil || errLng != nil {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_COORDINATES", "lat と lng を数値で指定してください")
+		return
+	}
+
+	// 検索半径と件数を取得
+	radiusKm, err := strconv.ParseFloat(ctx.DefaultQuery("radius_km", "10"), 64)
+	if err != nil {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_RADIUS", "radius_km を数値で指定してください")
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	// ユースケースを呼び出し
+	nearbyLogs, err := c.onsenLogUseCase.GetNearbyOnsenLogs(ctx.Request.Context(), port.NearbyOnsenLogsInput{
+		UserID:    userID,
+		Latitude:  latitude,
+		Longitude: longitude,
+		RadiusKm:  radiusKm,
+		Limit:     limit,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
 	// レスポンスを返す
 	RespondWithSuccess(ctx, http.StatusOK, gin.H{
-		"onsen_logs":  result.OnsenLogs,
-		"total_count": result.TotalCount,
-		"page":        result.Page,
-		"limit":       result.Limit,
-	}, "フィルタリングされた温泉メモリストを取得しました")
+		"onsen_logs": nearbyLogs,
+		"radius_km":  radiusKm,
+	}, "周辺の温泉メモを取得しました")
 }
 
 // UpdateOnsenLog は温泉メモを更新します
@@ -282,13 +477,22 @@ func (c *OnsenLogController) UpdateOnsenLog(ctx *gin.Context) {
 
 	// リクエストボディをバインド
 	var input struct {
-		Name       string            `json:"name" binding:"required"`
-		Location   string            `json:"location" binding:"required"`
-		SpringType entity.SpringType `json:"spring_type" binding:"required"`
-		Features   []entity.Feature  `json:"features"`
-		VisitDate  string            `json:"visit_date" binding:"required"`
-		Rating     int               `json:"rating" binding:"required,min=1,max=5"`
-		Comment    string            `json:"comment"`
+		OnsenID       string               `json:"onsen_id"`
+		Name          string               `json:"name" binding:"required"`
+		Location      string               `json:"location" binding:"required"`
+		Latitude      *float64             `json:"latitude"`
+		Longitude     *float64             `json:"longitude"`
+		SpringTypes   []entity.SpringType  `json:"spring_types"`
+		SpringType    entity.SpringType    `json:"spring_type"` // 以前の形式（単一の泉質）
+		WaterAnalysis entity.WaterAnalysis `json:"water_analysis"`
+		Features      []entity.Feature     `json:"features"`
+		Tags          []string             `json:"tags"`
+		VisitDate     string               `json:"visit_date" binding:"required"`
+		Visit         entity.VisitDetails  `json:"visit"`
+		Rating        *float64             `json:"rating" binding:"omitempty,min=1,max=5"`
+		RatingScores  entity.RatingScores  `json:"rating_scores"`
+		Comment       string               `json:"comment"`
+		Visibility    entity.Visibility    `json:"visibility"`
 	}
 
 	if !ValidateBindJSON(ctx, &input) {
@@ -304,15 +508,23 @@ func (c *OnsenLogController) UpdateOnsenLog(ctx *gin.Context) {
 
 	// 入力データを作成
 	updateInput := port.UpdateOnsenLogInput{
-		ID:         id,
-		UserID:     userID,
-		Name:       input.Name,
-		Location:   input.Location,
-		SpringType: input.SpringType,
-		Features:   input.Features,
-		VisitDate:  visitDate,
-		Rating:     input.Rating,
-		Comment:    input.Comment,
+		ID:            id,
+		UserID:        userID,
+		OnsenID:       input.OnsenID,
+		Name:          input.Name,
+		Location:      input.Location,
+		Latitude:      input.Latitude,
+		Longitude:     input.Longitude,
+		SpringTypes:   requestSpringTypes(input.SpringTypes, input.SpringType),
+		WaterAnalysis: input.WaterAnalysis,
+		Features:      input.Features,
+		Tags:          input.Tags,
+		VisitDate:     visitDate,
+		Visit:         input.Visit,
+		Rating:        input.Rating,
+		RatingScores:  input.RatingScores,
+		Comment:       input.Comment,
+		Visibility:    input.Visibility,
 	}
 
 	// ユースケースを呼び出し
@@ -327,19 +539,7 @@ func (c *OnsenLogController) UpdateOnsenLog(ctx *gin.Context) {
 	}
 
 	// レスポンスを返す
-	RespondWithSuccess(ctx, http.StatusOK, gin.H{
-		"id":          onsenLog.ID,
-		"name":        onsenLog.Name,
-		"location":    onsenLog.Location,
-		"spring_type": onsenLog.SpringType,
-		"features":    onsenLog.Features,
-		"visit_date":  onsenLog.VisitDate.Format("2006-01-02"),
-		"rating":      onsenLog.Rating,
-		"comment":     onsenLog.Comment,
-		"created_at":  onsenLog.CreatedAt,
-		"updated_at":  onsenLog.UpdatedAt,
-		"images":      onsenLog.Images,
-	}, "温泉メモを更新しました")
+	RespondWithSuccess(ctx, http.StatusOK, onsenLogResponse(onsenLog), "温泉メモを更新しました")
 }
 
 // DeleteOnsenLog は温泉メモを削除します
@@ -376,36 +576,329 @@ func (c *OnsenLogController) ExportOnsenLogs(ctx *gin.Context) {
 
 	// クエリパラメータからフォーマットを取得
 	format := ctx.DefaultQuery("format", "json")
+	exportFormat, ok := exportFormats[format]
+	if !ok {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_FORMAT", "サポートされているフォーマットは json, csv, geojson, kml, gpx, xlsx, zip です")
+		return
+	}
+
+	// クエリパラメータからCSVの出力オプションを取得
+	csvOptions, ok := parseCSVExportOptions(ctx, ctx.Query)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し（レスポンスに直接書き出す）
+	streamExport(ctx, exportContentType(format, exportFormat, csvOptions), exportFormat.filename, func(w io.Writer) error {
+		return c.onsenLogUseCase.ExportOnsenLogs(
+			ctx.Request.Context(),
+			port.ExportOnsenLogsInput{
+				UserID: userID,
+				Format: format,
+				CSV:    csvOptions,
+			},
+			w,
+		)
+	})
+}
+
+// ExportYearbook は温泉メモを印刷用の温泉アルバム（HTMLまたはPDF）としてエクスポートします
+// yearを指定した場合はその年の温泉メモのみを含めます
+func (c *OnsenLogController) ExportYearbook(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// クエリパラメータからフォーマットを取得
+	format := ctx.DefaultQuery("format", "html")
+	contentType, ok := yearbookContentTypes[format]
+	if !ok {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_FORMAT", "サポートされているフォーマットは html, pdf です")
+		return
+	}
+
+	// クエリパラメータから年を取得
+	year := 0
+	filename := "onsen_yearbook." + format
+	if value := ctx.Query("year"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > 9999 {
+			RespondWithError(ctx, http.StatusBadRequest, "INVALID_YEAR", "year は西暦の年で指定してください")
+			return
+		}
+		year = parsed
+		filename = fmt.Sprintf("onsen_yearbook_%d.%s", year, format)
+	}
+
+	// ユースケースを呼び出し（レスポンスに直接書き出す）
+	streamExport(ctx, contentType, filename, func(w io.Writer) error {
+		return c.onsenLogUseCase.ExportYearbook(
+			ctx.Request.Context(),
+			port.ExportYearbookInput{
+				UserID: userID,
+				Format: format,
+				Year:   year,
+			},
+			w,
+		)
+	})
+}
+
+// parseCSVExportOptions はCSVの出力オプションを取得します
+// 各オプションの値はvalueで取得します（クエリパラメータの場合はctx.Query）
+// 無効な値がある場合はエラーレスポンスを返してfalseを返します
+func parseCSVExportOptions(ctx *gin.Context, value func(key string) string) (port.CSVExportOptions, bool) {
+	var options port.CSVExportOptions
+
+	// 文字コード
+	encoding, ok := entity.ParseCSVEncoding(value("encoding"))
+	if !ok {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_ENCODING", "encoding は utf-8, utf-8-bom, shift_jis のいずれかで指定してください")
+		return options, false
+	}
+	options.Encoding = encoding
+
+	// 区切り文字
+	delimiter, ok := entity.ParseCSVDelimiter(value("delimiter"))
+	if !ok {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_DELIMITER", "delimiter は comma, tab, semicolon, pipe のいずれかで指定してください")
+		return options, false
+	}
+	options.Delimiter = delimiter
+
+	// 列と列の順序
+	if columns := value("columns"); columns != "" {
+		for _, value := range strings.Split(columns, ",") {
+			column := entity.CSVColumn(strings.TrimSpace(value))
+			if !column.IsValid() {
+				RespondWithError(ctx, http.StatusBadRequest, "INVALID_COLUMNS", "columns に無効な列が含まれています: "+string(column))
+				return options, false
+			}
+			options.Columns = append(options.Columns, column)
+		}
+	}
+
+	// 日付の書式
+	dateLayout, ok := entity.ParseDateFormat(value("date_format"))
+	if !ok {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_DATE_FORMAT", "date_format は年・月・日を YYYY・MM・DD などの記号で指定してください（例: YYYY/MM/DD）")
+		return options, false
+	}
+	options.DateLayout = dateLayout
+
+	// ヘッダーの言語
+	options.HeaderLanguage = entity.HeaderLanguage(value("header"))
+	if !options.HeaderLanguage.IsValid() {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_HEADER", "header は ja または en で指定してください")
+		return options, false
+	}
+
+	return options, true
+}
+
+// exportContentType はエクスポートのContent-Typeを返します（CSVの場合は文字コードを付けます）
+func exportContentType(format string, exportFormat exportFormat, csvOptions port.CSVExportOptions) string {
+	if format != "csv" {
+		return exportFormat.contentType
+	}
+	if csvOptions.Encoding == entity.CSVEncodingShiftJIS {
+		return exportFormat.contentType + "; charset=Shift_JIS"
+	}
+	return exportFormat.contentType + "; charset=utf-8"
+}
+
+// exportResponseWriter はエクスポートをレスポンスに直接書き出すライターです
+// 最初に書き込んだ時点でレスポンスヘッダーを送信するため、書き込み前のエラーは通常のエラーレスポンスで返せます
+type exportResponseWriter struct {
+	ctx         *gin.Context
+	contentType string
+	filename    string
+	written     bool
+}
+
+// Write はレスポンスヘッダーを送信していなければ送信してから、データを書き込みます
+// Content-Lengthは設定しないため、レスポンスはチャンク形式で送信されます
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.written = true
+		w.ctx.Header("Content-Type", w.contentType)
+		w.ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", w.filename))
+		w.ctx.Status(http.StatusOK)
+	}
+	return w.ctx.Writer.Write(p)
+}
+
+// streamExport はエクスポートをレスポンスに直接書き出します
+// 書き出しを始めた後にエラーになった場合はステータスを変更できないため、ログに記録して接続を切断します
+func streamExport(ctx *gin.Context, contentType, filename string, export func(w io.Writer) error) {
+	w := &exportResponseWriter{ctx: ctx, contentType: contentType, filename: filename}
+	if err := export(w); err != nil {
+		if !w.written {
+			RespondWithAppError(ctx, err)
+			return
+		}
+		log.Printf("Failed to stream export: %v", err)
+		abortConnection(ctx)
+	}
+}
+
+// abortConnection はレスポンスを正常に終了させずに接続を切断します
+// チャンク形式の終端を送らないため、クライアントはダウンロードが途中で失敗したことを検知できます
+// 接続を切断できない場合（HTTP/2など）はhttp.ErrAbortHandlerでpanicし、net/httpにストリームを中断させます
+// （ルーターのリカバリーはhttp.ErrAbortHandlerを復帰させずにnet/httpへ伝えます）
+func abortConnection(ctx *gin.Context) {
+	ctx.Abort()
+	conn, _, err := ctx.Writer.Hijack()
+	if err != nil {
+		panic(http.ErrAbortHandler)
+	}
+	if err := conn.Close(); err != nil {
+		log.Printf("Failed to close connection: %v", err)
+	}
+}
+
+// ImportOnsenLogs はエクスポートと同じ形式のJSONまたはCSVから温泉メモをインポートします
+// ファイルはmultipart/form-dataの file フィールドか、リクエストボディで受け取ります
+func (c *OnsenLogController) ImportOnsenLogs(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ファイルを読み取り
+	data, filename, err := readImportFile(ctx)
+	if err != nil {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_FILE", err.Error())
+		return
+	}
+
+	// フォーマットを判定（クエリパラメータ、ファイルの拡張子、Content-Typeの順）
+	format := strings.ToLower(ctx.Query("format"))
+	if format == "" {
+		format = detectImportFormat(filename, ctx.ContentType())
+	}
 	if format != "json" && format != "csv" {
-		RespondWithError(ctx, http.StatusBadRequest, "INVALID_FORMAT", "サポートされているフォーマットは json または csv です")
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_FORMAT", "サポートされているフォーマットは json, csv です")
 		return
 	}
 
-	// ユースケースを呼び出し
-	data, err := c.onsenLogUseCase.ExportOnsenLogs(
-		ctx.Request.Context(),
-		userID,
-		format,
-	)
+	// ドライランと重複時の扱いを取得
+	dryRun, err := strconv.ParseBool(ctx.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_DRY_RUN", "dry_run は true または false で指定してください")
+		return
+	}
+	policy := entity.ImportPolicy(ctx.Query("on_duplicate"))
+	if !policy.IsValid() {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_POLICY", "on_duplicate は skip・overwrite・merge のいずれかで指定してください")
+		return
+	}
 
+	// エクスポート時に指定した訪問日の書式を取得（空の場合は標準の形式のみ読み取る）
+	var dateLayout string
+	if dateFormat := ctx.Query("date_format"); dateFormat != "" {
+		dateLayout, ok = entity.ParseDateFormat(dateFormat)
+		if !ok {
+			RespondWithError(ctx, http.StatusBadRequest, "INVALID_DATE_FORMAT", "date_format は年・月・日を YYYY・MM・DD などの記号で指定してください（例: YYYY/MM/DD）")
+			return
+		}
+	}
+
+	// ユースケースを呼び出し
+	result, err := c.onsenLogUseCase.ImportOnsenLogs(ctx.Request.Context(), port.ImportOnsenLogsInput{
+		UserID:     userID,
+		Format:     format,
+		Data:       data,
+		Policy:     policy,
+		DryRun:     dryRun,
+		DateLayout: dateLayout,
+	})
 	if err != nil {
 		RespondWithAppError(ctx, err)
 		return
 	}
 
-	// Content-Typeを設定
-	var contentType string
-	var filename string
-	switch format {
-	case "json":
-		contentType = "application/json"
-		filename = "onsen_logs.json"
-	case "csv":
-		contentType = "text/csv"
-		filename = "onsen_logs.csv"
+	message := "温泉メモをインポートしました"
+	if dryRun {
+		message = "インポートの検証が完了しました"
+	}
+	RespondWithSuccess(ctx, http.StatusOK, result, message)
+}
+
+// readImportFile はインポートするファイルの内容とファイル名を読み取ります
+func readImportFile(ctx *gin.Context) ([]byte, string, error) {
+	var reader io.Reader = ctx.Request.Body
+	filename := ""
+	if strings.HasPrefix(ctx.ContentType(), "multipart/form-data") {
+		file, header, err := ctx.Request.FormFile("file")
+		if err != nil {
+			return nil, "", errors.New("インポートするファイルを file フィールドで指定してください")
+		}
+		defer file.Close()
+		reader, filename = file, header.Filename
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxImportFileSize+1))
+	if err != nil {
+		return nil, "", errors.New("ファイルを読み取れません")
+	}
+	if len(data) == 0 {
+		return nil, "", errors.New("インポートするファイルが空です")
+	}
+	if len(data) > maxImportFileSize {
+		return nil, "", errors.New("インポートできるファイルは5MBまでです")
+	}
+	return data, filename, nil
+}
+
+// detectImportFormat はファイルの拡張子またはContent-Typeからインポートのフォーマットを判定します
+func detectImportFormat(filename, contentType string) string {
+	switch {
+	case strings.HasSuffix(strings.ToLower(filename), ".csv"), strings.Contains(contentType, "csv"):
+		return "csv"
+	case strings.HasSuffix(strings.ToLower(filename), ".json"), strings.Contains(contentType, "json"):
+		return "json"
+	default:
+		return ""
 	}
+}
 
-	// レスポンスヘッダーを設定
-	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
-	ctx.Data(http.StatusOK, contentType, data)
+// onsenLogResponse は温泉メモの出力データをレスポンス用に整形します
+// 移行前の泉質は移行で残した値がある場合のみ含めます
+func onsenLogResponse(onsenLog port.OnsenLogOutputData) gin.H {
+	response := gin.H{
+		"id":                onsenLog.ID,
+		"onsen_id":          onsenLog.OnsenID,
+		"name":              onsenLog.Name,
+		"location":          onsenLog.Location,
+		"area":              onsenLog.Area,
+		"latitude":          onsenLog.Latitude,
+		"longitude":         onsenLog.Longitude,
+		"spring_types":      onsenLog.SpringTypes,
+		"water_analysis":    onsenLog.WaterAnalysis,
+		"liquid_class":      onsenLog.LiquidClass,
+		"temperature_class": onsenLog.TemperatureClass,
+		"features":          onsenLog.Features,
+		"tags":              onsenLog.Tags,
+		"visit_date":        onsenLog.VisitDate.Format("2006-01-02"),
+		"visit":             onsenLog.Visit,
+		"time_of_day":       onsenLog.TimeOfDay,
+		"rating":            onsenLog.Rating,
+		"rating_scores":     onsenLog.RatingScores,
+		"rating_overridden": onsenLog.RatingOverridden,
+		"comment":           onsenLog.Comment,
+		"visibility":        onsenLog.Visibility,
+		"wishlist_item_id":  onsenLog.WishlistItemID,
+		"created_at":        onsenLog.CreatedAt,
+		"updated_at":        onsenLog.UpdatedAt,
+		"images":            onsenLog.Images,
+		"new_achievements":  onsenLog.NewAchievements,
+	}
+	if onsenLog.LegacySpringType != "" {
+		response["legacy_spring_type"] = onsenLog.LegacySpringType
+	}
+	return response
 }
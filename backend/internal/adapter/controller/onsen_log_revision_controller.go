@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// OnsenLogRevisionController は温泉メモの変更履歴関連のコントローラーです
+type OnsenLogRevisionController struct {
+	revisionUseCase port.OnsenLogRevisionInputPort
+}
+
+// NewOnsenLogRevisionController は新しい温泉メモの変更履歴コントローラーを作成します
+func NewOnsenLogRevisionController(revisionUseCase port.OnsenLogRevisionInputPort) *OnsenLogRevisionController {
+	return &OnsenLogRevisionController{
+		revisionUseCase: revisionUseCase,
+	}
+}
+
+// GetRevisions は温泉メモの版を新しい順に取得します
+func (c *OnsenLogRevisionController) GetRevisions(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉メモIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	revisions, err := c.revisionUseCase.GetRevisions(ctx.Request.Context(), id, userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"revisions": revisions,
+	}, "変更履歴を取得しました")
+}
+
+// DiffRevisions は温泉メモの2つの版を項目ごとに比較します
+func (c *OnsenLogRevisionController) DiffRevisions(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉メモIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// クエリパラメータから比較する版を取得
+	from, to := ctx.Query("from"), ctx.Query("to")
+	if from == "" || to == "" {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_REVISION", "比較する版を from と to で指定してください")
+		return
+	}
+
+	// ユースケースを呼び出し
+	diff, err := c.revisionUseCase.DiffRevisions(ctx.Request.Context(), port.DiffOnsenLogRevisionsInput{
+		OnsenLogID: id,
+		UserID:     userID,
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, diff, "版の差分を取得しました")
+}
+
+// RevertOnsenLog は温泉メモを以前の版の状態に戻します
+func (c *OnsenLogRevisionController) RevertOnsenLog(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉メモIDが指定されていません")
+	if !ok {
+		return
+	}
+	revisionID, ok := ValidatePathParam(ctx, "revision_id", "版が指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	onsenLog, err := c.revisionUseCase.RevertOnsenLog(ctx.Request.Context(), port.RevertOnsenLogInput{
+		OnsenLogID: id,
+		RevisionID: revisionID,
+		UserID:     userID,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, onsenLogResponse(onsenLog), "温泉メモを以前の版に戻しました")
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// PrefectureController は都道府県関連のコントローラーです
+type PrefectureController struct {
+	prefectureUseCase port.PrefectureInputPort
+}
+
+// NewPrefectureController は新しい都道府県コントローラーを作成します
+func NewPrefectureController(prefectureUseCase port.PrefectureInputPort) *PrefectureController {
+	return &PrefectureController{
+		prefectureUseCase: prefectureUseCase,
+	}
+}
+
+// GetPrefectures は47都道府県の一覧を取得します
+func (c *PrefectureController) GetPrefectures(ctx *gin.Context) {
+	// ユースケースを呼び出し
+	prefectures, err := c.prefectureUseCase.GetPrefectures(ctx.Request.Context())
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"prefectures": prefectures,
+	}, "都道府県一覧を取得しました")
+}
+
+// GetCompletionMap はユーザーの47都道府県の制覇状況を取得します
+func (c *PrefectureController) GetCompletionMap(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	completionMap, err := c.prefectureUseCase.GetCompletionMap(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, completionMap, "都道府県の制覇状況を取得しました")
+}
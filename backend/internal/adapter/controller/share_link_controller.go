@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// ShareLinkController は温泉メモの共有リンク関連のコントローラーです
+type ShareLinkController struct {
+	shareLinkUseCase port.ShareLinkInputPort
+}
+
+// NewShareLinkController は新しい共有リンクコントローラーを作成します
+func NewShareLinkController(shareLinkUseCase port.ShareLinkInputPort) *ShareLinkController {
+	return &ShareLinkController{
+		shareLinkUseCase: shareLinkUseCase,
+	}
+}
+
+// CreateShareLink は温泉メモの共有リンクを作成します
+// expires_in_hours を省略するか0を指定すると無期限の共有リンクになります
+func (c *ShareLinkController) CreateShareLink(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉メモIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド（ボディは省略可能）
+	var input struct {
+		ExpiresInHours int `json:"expires_in_hours" binding:"omitempty,min=0,max=8760"`
+	}
+
+	if ctx.Request.ContentLength != 0 && !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// ユースケースを呼び出し
+	shareLink, err := c.shareLinkUseCase.CreateShareLink(ctx.Request.Context(), port.CreateShareLinkInput{
+		OnsenLogID: id,
+		UserID:     userID,
+		ExpiresIn:  time.Duration(input.ExpiresInHours) * time.Hour,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusCreated, shareLinkResponse(shareLink), "共有リンクを作成しました")
+}
+
+// GetShareLinks はユーザーの有効な共有リンクを取得します
+func (c *ShareLinkController) GetShareLinks(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	shareLinks, err := c.shareLinkUseCase.GetShareLinks(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	// レスポンスを整形
+	response := make([]gin.H, len(shareLinks))
+	for i, shareLink := range shareLinks {
+		response[i] = shareLinkResponse(shareLink)
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"share_links": response,
+	}, "共有リンクを取得しました")
+}
+
+// RevokeShareLink は共有リンクを取り消します
+func (c *ShareLinkController) RevokeShareLink(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "共有リンクIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.shareLinkUseCase.RevokeShareLink(ctx.Request.Context(), id, userID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "共有リンクを取り消しました")
+}
+
+// GetPublicOnsenLog は共有リンクで公開されている温泉メモを取得します
+// 認証は不要で、ログインしている場合は閲覧者が作成者かどうかを返します
+func (c *ShareLinkController) GetPublicOnsenLog(ctx *gin.Context) {
+	// パスパラメータから共有トークンを取得
+	token, ok := ValidatePathParam(ctx, "token", "共有トークンが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ログインしている場合は閲覧者のユーザーIDを取得
+	viewerID := ctx.GetString("userID")
+
+	// ユースケースを呼び出し
+	onsenLog, err := c.shareLinkUseCase.GetPublicOnsenLog(ctx.Request.Context(), port.GetPublicOnsenLogInput{
+		Token:    token,
+		ViewerID: viewerID,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"name":              onsenLog.Name,
+		"location":          onsenLog.Location,
+		"area":              onsenLog.Area,
+		"latitude":          onsenLog.Latitude,
+		"longitude":         onsenLog.Longitude,
+		"spring_types":      onsenLog.SpringTypes,
+		"water_analysis":    onsenLog.WaterAnalysis,
+		"liquid_class":      onsenLog.LiquidClass,
+		"temperature_class": onsenLog.TemperatureClass,
+		"features":          onsenLog.Features,
+		"tags":              onsenLog.Tags,
+		"visit_date":        onsenLog.VisitDate.Format("2006-01-02"),
+		"time_of_day":       onsenLog.TimeOfDay,
+		"rating":            onsenLog.Rating,
+		"rating_scores":     onsenLog.RatingScores,
+		"comment":           onsenLog.Comment,
+		"images":            onsenLog.Images,
+		"expires_at":        onsenLog.ExpiresAt,
+		"is_owner":          onsenLog.IsOwner,
+	}, "共有された温泉メモを取得しました")
+}
+
+// shareLinkResponse は共有リンクの出力データをレスポンス用に整形します
+func shareLinkResponse(shareLink port.ShareLinkOutputData) gin.H {
+	return gin.H{
+		"id":           shareLink.ID,
+		"onsen_log_id": shareLink.OnsenLogID,
+		"token":        shareLink.Token,
+		"path":         "/api/public/logs/" + shareLink.Token,
+		"expires_at":   shareLink.ExpiresAt,
+		"created_at":   shareLink.CreatedAt,
+	}
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// TagController はタグ管理関連のコントローラーです
+type TagController struct {
+	tagUseCase port.TagInputPort
+}
+
+// NewTagController は新しいタグコントローラーを作成します
+func NewTagController(tagUseCase port.TagInputPort) *TagController {
+	return &TagController{
+		tagUseCase: tagUseCase,
+	}
+}
+
+// GetTags はユーザーのタグ一覧を使用回数付きで取得します
+func (c *TagController) GetTags(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	tags, err := c.tagUseCase.GetTags(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"tags": tags,
+	}, "タグ一覧を取得しました")
+}
+
+// RenameTag はタグの名前を変更します
+func (c *TagController) RenameTag(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからタグ名を取得
+	name, ok := ValidatePathParam(ctx, "name", "タグ名が必要です")
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input struct {
+		Name string `json:"name" binding:"required"`
+	}
+
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// ユースケースを呼び出し
+	result, err := c.tagUseCase.RenameTag(ctx.Request.Context(), port.RenameTagInput{
+		UserID:  userID,
+		Name:    name,
+		NewName: input.Name,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, result, "タグ名を変更しました")
+}
+
+// MergeTags は統合元のタグを統合先のタグに統合します
+func (c *TagController) MergeTags(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input struct {
+		Source string `json:"source" binding:"required"`
+		Target string `json:"target" binding:"required"`
+	}
+
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// ユースケースを呼び出し
+	result, err := c.tagUseCase.MergeTags(ctx.Request.Context(), port.MergeTagsInput{
+		UserID: userID,
+		Source: input.Source,
+		Target: input.Target,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, result, "タグを統合しました")
+}
+
+// DeleteTag はすべての温泉メモからタグを削除します
+func (c *TagController) DeleteTag(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからタグ名を取得
+	name, ok := ValidatePathParam(ctx, "name", "タグ名が指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	result, err := c.tagUseCase.DeleteTag(ctx.Request.Context(), userID, name)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, result, "タグを削除しました")
+}
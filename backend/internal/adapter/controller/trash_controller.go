@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// TrashController はゴミ箱関連のコントローラーです
+type TrashController struct {
+	trashUseCase port.TrashInputPort
+}
+
+// NewTrashController は新しいゴミ箱コントローラーを作成します
+func NewTrashController(trashUseCase port.TrashInputPort) *TrashController {
+	return &TrashController{
+		trashUseCase: trashUseCase,
+	}
+}
+
+// GetTrash はゴミ箱の温泉メモと画像を取得します
+func (c *TrashController) GetTrash(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	trash, err := c.trashUseCase.GetTrash(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, trash, "ゴミ箱を取得しました")
+}
+
+// RestoreOnsenLog はゴミ箱の温泉メモを復元します
+func (c *TrashController) RestoreOnsenLog(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉メモIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	onsenLog, err := c.trashUseCase.RestoreOnsenLog(ctx.Request.Context(), id, userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, onsenLogResponse(onsenLog), "温泉メモを復元しました")
+}
+
+// PurgeOnsenLog はゴミ箱の温泉メモを完全に削除します
+func (c *TrashController) PurgeOnsenLog(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "温泉メモIDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.trashUseCase.PurgeOnsenLog(ctx.Request.Context(), id, userID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "温泉メモを完全に削除しました")
+}
+
+// RestoreImage はゴミ箱の温泉画像を復元します
+func (c *TrashController) RestoreImage(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータから画像IDを取得
+	imageID, ok := ValidatePathParam(ctx, "image_id", "画像IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	image, err := c.trashUseCase.RestoreImage(ctx.Request.Context(), imageID, userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, image, "画像を復元しました")
+}
+
+// PurgeImage はゴミ箱の温泉画像を完全に削除します
+func (c *TrashController) PurgeImage(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータから画像IDを取得
+	imageID, ok := ValidatePathParam(ctx, "image_id", "画像IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.trashUseCase.PurgeImage(ctx.Request.Context(), imageID, userID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "画像を完全に削除しました")
+}
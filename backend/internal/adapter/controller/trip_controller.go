@@ -0,0 +1,266 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// tripRequest は旅行の作成・更新のリクエストボディです
+type tripRequest struct {
+	Title       string   `json:"title" binding:"required"`
+	StartDate   string   `json:"start_date" binding:"required"`
+	EndDate     string   `json:"end_date" binding:"required"`
+	Notes       string   `json:"notes"`
+	OnsenLogIDs []string `json:"onsen_log_ids"`
+}
+
+// TripController は旅行関連のコントローラーです
+type TripController struct {
+	tripUseCase port.TripInputPort
+}
+
+// NewTripController は新しい旅行コントローラーを作成します
+func NewTripController(tripUseCase port.TripInputPort) *TripController {
+	return &TripController{
+		tripUseCase: tripUseCase,
+	}
+}
+
+// CreateTrip は新しい旅行を作成します
+func (c *TripController) CreateTrip(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input tripRequest
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// 日付をパース
+	startDate, endDate, ok := parseTripDates(ctx, input)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	trip, err := c.tripUseCase.CreateTrip(ctx.Request.Context(), port.CreateTripInput{
+		UserID:      userID,
+		Title:       input.Title,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Notes:       input.Notes,
+		OnsenLogIDs: input.OnsenLogIDs,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusCreated, tripResponse(trip), "旅行を作成しました")
+}
+
+// GetTrips はユーザーの旅行リストを取得します
+func (c *TripController) GetTrips(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	trips, err := c.tripUseCase.GetTrips(ctx.Request.Context(), userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	// レスポンスを整形
+	response := make([]gin.H, len(trips))
+	for i, trip := range trips {
+		response[i] = tripResponse(trip)
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"trips": response,
+	}, "旅行リストを取得しました")
+}
+
+// GetTrip は特定の旅行を温泉メモと統計付きで取得します
+func (c *TripController) GetTrip(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "旅行IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	trip, err := c.tripUseCase.GetTrip(ctx.Request.Context(), id, userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, tripResponse(trip), "旅行を取得しました")
+}
+
+// UpdateTrip は旅行を更新します
+func (c *TripController) UpdateTrip(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "旅行IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input tripRequest
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// 日付をパース
+	startDate, endDate, ok := parseTripDates(ctx, input)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	trip, err := c.tripUseCase.UpdateTrip(ctx.Request.Context(), port.UpdateTripInput{
+		ID:          id,
+		UserID:      userID,
+		Title:       input.Title,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Notes:       input.Notes,
+		OnsenLogIDs: input.OnsenLogIDs,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, tripResponse(trip), "旅行を更新しました")
+}
+
+// DeleteTrip は旅行を削除します
+func (c *TripController) DeleteTrip(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "旅行IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.tripUseCase.DeleteTrip(ctx.Request.Context(), id, userID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "旅行の削除に成功しました")
+}
+
+// ExportTrip は旅行に含まれる温泉メモを温泉メモのエクスポートと同じ形式でエクスポートします
+func (c *TripController) ExportTrip(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "旅行IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// クエリパラメータからフォーマットを取得
+	format := ctx.DefaultQuery("format", "json")
+	exportFormat, ok := exportFormats[format]
+	if !ok {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_FORMAT", "サポートされているフォーマットは json, csv, geojson, kml, gpx, xlsx, zip です")
+		return
+	}
+
+	// クエリパラメータからCSVの出力オプションを取得
+	csvOptions, ok := parseCSVExportOptions(ctx, ctx.Query)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し（レスポンスに直接書き出す）
+	streamExport(ctx, exportContentType(format, exportFormat, csvOptions), "trip_"+exportFormat.filename, func(w io.Writer) error {
+		return c.tripUseCase.ExportTrip(ctx.Request.Context(), port.ExportTripInput{
+			ID:     id,
+			UserID: userID,
+			Format: format,
+			CSV:    csvOptions,
+		}, w)
+	})
+}
+
+// parseTripDates は旅行の開始日と終了日をパースします
+// 形式が無効な場合はエラーレスポンスを返してfalseを返します
+func parseTripDates(ctx *gin.Context, input tripRequest) (time.Time, time.Time, bool) {
+	startDate, err := time.Parse("2006-01-02", input.StartDate)
+	if err != nil {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_DATE", "日付の形式が無効です（YYYY-MM-DD）")
+		return time.Time{}, time.Time{}, false
+	}
+	endDate, err := time.Parse("2006-01-02", input.EndDate)
+	if err != nil {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_DATE", "日付の形式が無効です（YYYY-MM-DD）")
+		return time.Time{}, time.Time{}, false
+	}
+	return startDate, endDate, true
+}
+
+// tripResponse は旅行の出力データをレスポンス用に整形します
+// 温泉メモと統計は旅行の詳細・作成・更新でのみ含めます
+func tripResponse(trip port.TripOutputData) gin.H {
+	response := gin.H{
+		"id":            trip.ID,
+		"title":         trip.Title,
+		"start_date":    trip.StartDate.Format("2006-01-02"),
+		"end_date":      trip.EndDate.Format("2006-01-02"),
+		"notes":         trip.Notes,
+		"onsen_log_ids": trip.OnsenLogIDs,
+		"created_at":    trip.CreatedAt,
+		"updated_at":    trip.UpdatedAt,
+	}
+
+	if trip.Stats != nil {
+		onsenLogs := make([]gin.H, len(trip.OnsenLogs))
+		for i, onsenLog := range trip.OnsenLogs {
+			onsenLogs[i] = onsenLogResponse(onsenLog)
+		}
+		response["onsen_logs"] = onsenLogs
+		response["stats"] = trip.Stats
+	}
+
+	return response
+}
@@ -0,0 +1,255 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// WishlistController は行きたい温泉関連のコントローラーです
+type WishlistController struct {
+	wishlistUseCase port.WishlistInputPort
+}
+
+// NewWishlistController は新しい行きたい温泉コントローラーを作成します
+func NewWishlistController(wishlistUseCase port.WishlistInputPort) *WishlistController {
+	return &WishlistController{
+		wishlistUseCase: wishlistUseCase,
+	}
+}
+
+// CreateWishlistItem は新しい行きたい温泉を作成します
+func (c *WishlistController) CreateWishlistItem(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input struct {
+		Name         string                  `json:"name" binding:"required"`
+		Location     string                  `json:"location"`
+		Notes        string                  `json:"notes"`
+		Priority     entity.WishlistPriority `json:"priority" binding:"omitempty,min=1,max=3"`
+		TargetSeason entity.Season           `json:"target_season"`
+	}
+
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// ユースケースを呼び出し
+	item, err := c.wishlistUseCase.CreateWishlistItem(ctx.Request.Context(), port.CreateWishlistItemInput{
+		UserID:       userID,
+		Name:         input.Name,
+		Location:     input.Location,
+		Notes:        input.Notes,
+		Priority:     input.Priority,
+		TargetSeason: input.TargetSeason,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusCreated, item, "行きたい温泉を作成しました")
+}
+
+// GetWishlist はユーザーの行きたい温泉リストを取得します
+// status（all / pending / visited）と season（行きたい季節）で絞り込めます
+func (c *WishlistController) GetWishlist(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	items, err := c.wishlistUseCase.GetWishlist(ctx.Request.Context(), port.GetWishlistInput{
+		UserID:       userID,
+		Status:       entity.ParseWishlistStatus(ctx.Query("status")),
+		TargetSeason: entity.Season(ctx.Query("season")),
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, gin.H{
+		"wishlist_items": items,
+	}, "行きたい温泉リストを取得しました")
+}
+
+// GetWishlistItem は特定の行きたい温泉を取得します
+func (c *WishlistController) GetWishlistItem(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "行きたい温泉IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	item, err := c.wishlistUseCase.GetWishlistItem(ctx.Request.Context(), id, userID)
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, item, "行きたい温泉を取得しました")
+}
+
+// UpdateWishlistItem は行きたい温泉を更新します
+func (c *WishlistController) UpdateWishlistItem(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "行きたい温泉IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input struct {
+		Name         string                  `json:"name" binding:"required"`
+		Location     string                  `json:"location"`
+		Notes        string                  `json:"notes"`
+		Priority     entity.WishlistPriority `json:"priority" binding:"omitempty,min=1,max=3"`
+		TargetSeason entity.Season           `json:"target_season"`
+	}
+
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// ユースケースを呼び出し
+	item, err := c.wishlistUseCase.UpdateWishlistItem(ctx.Request.Context(), port.UpdateWishlistItemInput{
+		ID:           id,
+		UserID:       userID,
+		Name:         input.Name,
+		Location:     input.Location,
+		Notes:        input.Notes,
+		Priority:     input.Priority,
+		TargetSeason: input.TargetSeason,
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, item, "行きたい温泉を更新しました")
+}
+
+// DeleteWishlistItem は行きたい温泉を削除します
+func (c *WishlistController) DeleteWishlistItem(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "行きたい温泉IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// ユースケースを呼び出し
+	if err := c.wishlistUseCase.DeleteWishlistItem(ctx.Request.Context(), id, userID); err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusOK, nil, "行きたい温泉の削除に成功しました")
+}
+
+// ConvertToOnsenLog は訪問した行きたい温泉を温泉メモに変換します
+// リクエストボディは温泉メモの作成と同じ形式で、温泉名と所在地を省略すると行きたい温泉の値を使用します
+func (c *WishlistController) ConvertToOnsenLog(ctx *gin.Context) {
+	// ユーザーIDを取得
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	// パスパラメータからIDを取得
+	id, ok := ValidatePathParam(ctx, "id", "行きたい温泉IDが指定されていません")
+	if !ok {
+		return
+	}
+
+	// リクエストボディをバインド
+	var input struct {
+		OnsenID       string               `json:"onsen_id"`
+		Name          string               `json:"name"`
+		Location      string               `json:"location"`
+		Latitude      *float64             `json:"latitude"`
+		Longitude     *float64             `json:"longitude"`
+		SpringTypes   []entity.SpringType  `json:"spring_types"`
+		WaterAnalysis entity.WaterAnalysis `json:"water_analysis"`
+		Features      []entity.Feature     `json:"features"`
+		Tags          []string             `json:"tags"`
+		VisitDate     string               `json:"visit_date" binding:"required"`
+		Visit         entity.VisitDetails  `json:"visit"`
+		Rating        *float64             `json:"rating" binding:"omitempty,min=1,max=5"`
+		RatingScores  entity.RatingScores  `json:"rating_scores"`
+		Comment       string               `json:"comment"`
+		Visibility    entity.Visibility    `json:"visibility"`
+	}
+
+	if !ValidateBindJSON(ctx, &input) {
+		return
+	}
+
+	// 日付をパース
+	visitDate, err := time.Parse("2006-01-02", input.VisitDate)
+	if err != nil {
+		RespondWithError(ctx, http.StatusBadRequest, "INVALID_DATE", "日付の形式が無効です（YYYY-MM-DD）")
+		return
+	}
+
+	// ユースケースを呼び出し
+	result, err := c.wishlistUseCase.ConvertToOnsenLog(ctx.Request.Context(), port.ConvertWishlistItemInput{
+		ID:     id,
+		UserID: userID,
+		OnsenLog: port.CreateOnsenLogInput{
+			OnsenID:       input.OnsenID,
+			Name:          input.Name,
+			Location:      input.Location,
+			Latitude:      input.Latitude,
+			Longitude:     input.Longitude,
+			SpringTypes:   input.SpringTypes,
+			WaterAnalysis: input.WaterAnalysis,
+			Features:      input.Features,
+			Tags:          input.Tags,
+			VisitDate:     visitDate,
+			Visit:         input.Visit,
+			Rating:        input.Rating,
+			RatingScores:  input.RatingScores,
+			Comment:       input.Comment,
+			Visibility:    input.Visibility,
+		},
+	})
+	if err != nil {
+		RespondWithAppError(ctx, err)
+		return
+	}
+
+	RespondWithSuccess(ctx, http.StatusCreated, gin.H{
+		"wishlist_item": result.WishlistItem,
+		"onsen_log":     onsenLogResponse(result.OnsenLog),
+	}, "行きたい温泉を温泉メモに変換しました")
+}
@@ -30,5 +30,10 @@ func (r *LocalStorageRepository) Delete(ctx context.Context, fileURL string) err
 	return r.storage.Delete(ctx, fileURL)
 }
 
+// Open はファイルを読み取り用に開きます
+func (r *LocalStorageRepository) Open(ctx context.Context, fileURL string) (io.ReadCloser, error) {
+	return r.storage.Open(ctx, fileURL)
+}
+
 // Ensure LocalStorageRepository implements StorageRepository
 var _ repository.StorageRepository = (*LocalStorageRepository)(nil)
@@ -0,0 +1,98 @@
+package gateway
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoAchievementRepository はMongoDBを使用した獲得済み実績リポジトリの実装です
+type MongoAchievementRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	userAchievementsCollection = "user_achievements"
+	userAchievementIndex       = "user_achievement_idx"
+)
+
+// NewMongoAchievementRepository は新しいMongoDBの獲得済み実績リポジトリを作成します
+func NewMongoAchievementRepository(db *mongo.Database) *MongoAchievementRepository {
+	repo := &MongoAchievementRepository{
+		collection: db.Collection(userAchievementsCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoAchievementRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// ユーザーごとに同じ実績は一度だけ獲得できる
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "achievement_id", Value: 1}},
+			Options: options.Index().SetName(userAchievementIndex).SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は獲得した実績を保存します（獲得済みの実績は無視します）
+func (r *MongoAchievementRepository) Create(ctx context.Context, achievement *entity.UserAchievement) error {
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, achievement)
+	if err != nil {
+		// 同時に評価された場合など、既に獲得済みの実績は保存しない
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		achievement.ID = oid
+	}
+
+	return nil
+}
+
+// FindByUserID はユーザーIDに紐づく獲得済みの実績を獲得日時の昇順で検索します
+func (r *MongoAchievementRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.UserAchievement, error) {
+	// 検索条件を作成
+	filter := bson.M{"user_id": userID}
+
+	// ソート条件を作成（獲得日時の昇順）
+	opts := options.Find().SetSort(bson.D{{Key: "unlocked_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var achievements []*entity.UserAchievement
+	if err := cursor.All(ctx, &achievements); err != nil {
+		return nil, err
+	}
+
+	return achievements, nil
+}
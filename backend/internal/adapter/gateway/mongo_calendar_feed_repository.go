@@ -0,0 +1,127 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoCalendarFeedRepository はMongoDBを使用したカレンダーフィードリポジトリの実装です
+type MongoCalendarFeedRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	calendarFeedsCollection = "calendar_feeds"
+	calendarFeedUserIndex   = "user_id_idx"
+	calendarFeedTokenIndex  = "token_idx"
+)
+
+// NewMongoCalendarFeedRepository は新しいMongoDBのカレンダーフィードリポジトリを作成します
+func NewMongoCalendarFeedRepository(db *mongo.Database) *MongoCalendarFeedRepository {
+	repo := &MongoCalendarFeedRepository{
+		collection: db.Collection(calendarFeedsCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoCalendarFeedRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// ユーザーIDのユニークインデックス（ユーザーごとに1件）
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName(calendarFeedUserIndex).SetUnique(true),
+		},
+		// トークンのユニークインデックス（フィードの配信用）
+		{
+			Keys:    bson.D{{Key: "token", Value: 1}},
+			Options: options.Index().SetName(calendarFeedTokenIndex).SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は新しいカレンダーフィードを作成します
+func (r *MongoCalendarFeedRepository) Create(ctx context.Context, feed *entity.CalendarFeed) error {
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, feed)
+	if err != nil {
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		feed.ID = oid
+	}
+
+	return nil
+}
+
+// FindByUserID はユーザーIDでカレンダーフィードを検索します
+func (r *MongoCalendarFeedRepository) FindByUserID(ctx context.Context, userID string) (*entity.CalendarFeed, error) {
+	return r.findOne(ctx, bson.M{"user_id": userID})
+}
+
+// FindByToken はトークンでカレンダーフィードを検索します
+func (r *MongoCalendarFeedRepository) FindByToken(ctx context.Context, token string) (*entity.CalendarFeed, error) {
+	return r.findOne(ctx, bson.M{"token": token})
+}
+
+// findOne は条件に一致するカレンダーフィードを1件検索します
+func (r *MongoCalendarFeedRepository) findOne(ctx context.Context, filter bson.M) (*entity.CalendarFeed, error) {
+	var feed entity.CalendarFeed
+
+	err := r.collection.FindOne(ctx, filter).Decode(&feed)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("カレンダーフィードが見つかりません")
+		}
+		return nil, err
+	}
+
+	return &feed, nil
+}
+
+// Update はカレンダーフィードを更新します
+func (r *MongoCalendarFeedRepository) Update(ctx context.Context, feed *entity.CalendarFeed) error {
+	// MongoDBを更新
+	filter := bson.M{"_id": feed.ID}
+	update := bson.M{"$set": feed}
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// DeleteByUserID はユーザーIDに紐づくカレンダーフィードを削除します
+func (r *MongoCalendarFeedRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	// MongoDBから削除
+	result, err := r.collection.DeleteOne(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return err
+	}
+
+	// 削除されたドキュメントがない場合
+	if result.DeletedCount == 0 {
+		return errors.New("カレンダーフィードが見つかりません")
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoExportJobRepository はMongoDBを使用したエクスポートジョブリポジトリの実装です
+type MongoExportJobRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	exportJobsCollection       = "export_jobs"
+	exportJobUUIDIndex         = "uuid_idx"
+	exportJobUserIndex         = "user_created_at_idx"
+	exportJobStatusIndex       = "status_next_attempt_at_idx"
+	exportJobDownloadIndex     = "download_token_idx"
+	exportJobStatusExpireIndex = "status_download_expires_at_idx"
+)
+
+// NewMongoExportJobRepository は新しいMongoDBのエクスポートジョブリポジトリを作成します
+func NewMongoExportJobRepository(db *mongo.Database) *MongoExportJobRepository {
+	repo := &MongoExportJobRepository{
+		collection: db.Collection(exportJobsCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoExportJobRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// UUIDのユニークインデックス
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetName(exportJobUUIDIndex).SetUnique(true),
+		},
+		// ユーザーID+作成日時の複合インデックス（一覧表示用）
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName(exportJobUserIndex),
+		},
+		// 状態+実行時刻の複合インデックス（実行待ちのジョブの取得用）
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+			Options: options.Index().SetName(exportJobStatusIndex),
+		},
+		// ダウンロードトークンのユニークインデックス（トークンのないジョブは含めない）
+		{
+			Keys:    bson.D{{Key: "download_token", Value: 1}},
+			Options: options.Index().SetName(exportJobDownloadIndex).SetUnique(true).SetSparse(true),
+		},
+		// 状態+ダウンロードの有効期限の複合インデックス（期限切れのファイルの削除用）
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "download_expires_at", Value: 1}},
+			Options: options.Index().SetName(exportJobStatusExpireIndex),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は新しいエクスポートジョブを作成します
+func (r *MongoExportJobRepository) Create(ctx context.Context, job *entity.ExportJob) error {
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		job.ID = oid
+	}
+
+	return nil
+}
+
+// FindByID はIDでエクスポートジョブを検索します
+func (r *MongoExportJobRepository) FindByID(ctx context.Context, id string) (*entity.ExportJob, error) {
+	var job entity.ExportJob
+
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで検索
+		err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&job)
+		if err == nil {
+			return &job, nil
+		}
+	}
+
+	// UUIDで検索
+	err = r.collection.FindOne(ctx, bson.M{"uuid": id}).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("エクスポートジョブが見つかりません")
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// FindByUserID はユーザーIDに紐づくエクスポートジョブを作成日時の新しい順に検索します
+func (r *MongoExportJobRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.ExportJob, error) {
+	// ソート条件を作成（作成日時の降順）
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var jobs []*entity.ExportJob
+	if err := cursor.All(ctx, &jobs); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// CountActiveByUserID はユーザーIDに紐づく未完了のエクスポートジョブの件数を返します
+func (r *MongoExportJobRepository) CountActiveByUserID(ctx context.Context, userID string) (int, error) {
+	filter := bson.M{
+		"user_id": userID,
+		"status": bson.M{"$in": []entity.ExportJobStatus{
+			entity.ExportJobStatusPending,
+			entity.ExportJobStatusRunning,
+		}},
+	}
+
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// ClaimNext は実行時刻を過ぎた実行待ちのエクスポートジョブを1件取得し、実行中にします
+// 取得と更新を1回の操作で行うため、複数のサーバーで実行しても同じジョブを重複して取得しません
+func (r *MongoExportJobRepository) ClaimNext(ctx context.Context, now time.Time) (*entity.ExportJob, error) {
+	filter := bson.M{
+		"status":          entity.ExportJobStatusPending,
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     entity.ExportJobStatusRunning,
+			"started_at": now,
+			"updated_at": now,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job entity.ExportJob
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// UpdateProgress はエクスポートジョブの処理済みの件数と更新日時を更新します
+func (r *MongoExportJobRepository) UpdateProgress(ctx context.Context, id string, processed int) error {
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"uuid": id},
+		bson.M{"$set": bson.M{"processed": processed, "updated_at": time.Now()}},
+	)
+	return err
+}
+
+// Touch は実行中のエクスポートジョブの更新日時を更新します
+func (r *MongoExportJobRepository) Touch(ctx context.Context, id string, now time.Time) error {
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"uuid": id, "status": entity.ExportJobStatusRunning},
+		bson.M{"$set": bson.M{"updated_at": now}},
+	)
+	return err
+}
+
+// RequeueStale は更新日時がstaleBeforeより古い実行中のエクスポートジョブを実行待ちに戻し、戻した件数を返します
+// 実行中のジョブは定期的に更新日時を更新するため、他のサーバーで実行中のジョブは戻しません
+func (r *MongoExportJobRepository) RequeueStale(ctx context.Context, now, staleBefore time.Time) (int, error) {
+	result, err := r.collection.UpdateMany(ctx,
+		bson.M{
+			"status":     entity.ExportJobStatusRunning,
+			"updated_at": bson.M{"$lt": staleBefore},
+		},
+		bson.M{"$set": bson.M{
+			"status":          entity.ExportJobStatusPending,
+			"processed":       0,
+			"next_attempt_at": now,
+			"updated_at":      now,
+		}},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.ModifiedCount), nil
+}
+
+// FindExpired はダウンロードの有効期限が切れた完了済みのエクスポートジョブを有効期限の古い順に検索します
+func (r *MongoExportJobRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]*entity.ExportJob, error) {
+	filter := bson.M{
+		"status":              entity.ExportJobStatusCompleted,
+		"download_expires_at": bson.M{"$lte": now},
+	}
+
+	// ソート条件を作成（有効期限の昇順）
+	opts := options.Find().
+		SetSort(bson.M{"download_expires_at": 1}).
+		SetLimit(int64(limit))
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var jobs []*entity.ExportJob
+	if err := cursor.All(ctx, &jobs); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// Update はエクスポートジョブを更新します
+// ダウンロードトークンを削除できるよう、ドキュメント全体を置き換えます
+func (r *MongoExportJobRepository) Update(ctx context.Context, job *entity.ExportJob) error {
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": job.ID}, job)
+	return err
+}
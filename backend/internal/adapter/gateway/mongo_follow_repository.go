@@ -0,0 +1,178 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoFollowRepository はMongoDBを使用したフォロー関係リポジトリの実装です
+type MongoFollowRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	followsCollection   = "follows"
+	followPairIndex     = "follower_followee_idx"
+	followFolloweeIndex = "followee_created_at_idx"
+)
+
+// NewMongoFollowRepository は新しいMongoDBのフォロー関係リポジトリを作成します
+func NewMongoFollowRepository(db *mongo.Database) *MongoFollowRepository {
+	repo := &MongoFollowRepository{
+		collection: db.Collection(followsCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoFollowRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// フォローする側+される側のユニークインデックス（重複フォローの防止とフォロー中の一覧用）
+		{
+			Keys:    bson.D{{Key: "follower_id", Value: 1}, {Key: "followee_id", Value: 1}},
+			Options: options.Index().SetName(followPairIndex).SetUnique(true),
+		},
+		// フォローされる側+作成日時の複合インデックス（フォロワーの一覧用）
+		{
+			Keys:    bson.D{{Key: "followee_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName(followFolloweeIndex),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は新しいフォロー関係を作成します（既にフォローしている場合は何もしません）
+func (r *MongoFollowRepository) Create(ctx context.Context, follow *entity.Follow) error {
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, follow)
+	if err != nil {
+		// 既にフォローしている場合は保存しない
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		follow.ID = oid
+	}
+
+	return nil
+}
+
+// Find はフォロー関係を状態を問わず検索します（存在しない場合はnilを返します）
+func (r *MongoFollowRepository) Find(ctx context.Context, followerID, followeeID string) (*entity.Follow, error) {
+	var follow entity.Follow
+	err := r.collection.FindOne(ctx, bson.M{"follower_id": followerID, "followee_id": followeeID}).Decode(&follow)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &follow, nil
+}
+
+// Approve は承認待ちのフォロー関係を承認済みにします
+func (r *MongoFollowRepository) Approve(ctx context.Context, followerID, followeeID string) error {
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"follower_id": followerID, "followee_id": followeeID, "status": entity.FollowStatusPending},
+		bson.M{"$set": bson.M{"status": entity.FollowStatusApproved}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("フォローリクエストが見つかりません")
+	}
+
+	return nil
+}
+
+// Delete はフォロー関係を削除します（承認待ちのフォローリクエストを含みます）
+func (r *MongoFollowRepository) Delete(ctx context.Context, followerID, followeeID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"follower_id": followerID, "followee_id": followeeID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("このユーザーをフォローしていません")
+	}
+
+	return nil
+}
+
+// FindByFollowerID はユーザーがフォローしているフォロー関係をフォローした日時の新しい順に検索します
+func (r *MongoFollowRepository) FindByFollowerID(ctx context.Context, followerID string) ([]*entity.Follow, error) {
+	return r.find(ctx, approvedFollow(bson.M{"follower_id": followerID}))
+}
+
+// FindByFolloweeID はユーザーをフォローしているフォロー関係をフォローされた日時の新しい順に検索します
+func (r *MongoFollowRepository) FindByFolloweeID(ctx context.Context, followeeID string) ([]*entity.Follow, error) {
+	return r.find(ctx, approvedFollow(bson.M{"followee_id": followeeID}))
+}
+
+// FindPendingByFolloweeID はユーザーへの承認待ちのフォローリクエストを新しい順に検索します
+func (r *MongoFollowRepository) FindPendingByFolloweeID(ctx context.Context, followeeID string) ([]*entity.Follow, error) {
+	return r.find(ctx, bson.M{"followee_id": followeeID, "status": entity.FollowStatusPending})
+}
+
+// Exists は承認済みのフォロー関係が存在するかどうかを返します
+func (r *MongoFollowRepository) Exists(ctx context.Context, followerID, followeeID string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, approvedFollow(bson.M{"follower_id": followerID, "followee_id": followeeID}))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// find は条件に一致するフォロー関係を作成日時の新しい順に検索します
+func (r *MongoFollowRepository) find(ctx context.Context, filter bson.M) ([]*entity.Follow, error) {
+	// ソート条件を作成（作成日時の降順）
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var follows []*entity.Follow
+	if err := cursor.All(ctx, &follows); err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+// approvedFollow は検索条件に承認済みのフォロー関係のみに一致する条件を追加します
+// 状態のないフォロー関係（フォローリクエストの導入前のもの）にも一致します
+func approvedFollow(filter bson.M) bson.M {
+	filter["status"] = bson.M{"$ne": entity.FollowStatusPending}
+	return filter
+}
@@ -53,14 +53,14 @@ func (r *MongoOnsenImageRepository) FindByID(ctx context.Context, id string) (*e
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err == nil {
 		// ObjectIDで検索
-		err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&image)
+		err = r.collection.FindOne(ctx, notDeleted(bson.M{"_id": objectID})).Decode(&image)
 		if err == nil {
 			return &image, nil
 		}
 	}
 
 	// UUIDで検索
-	err = r.collection.FindOne(ctx, bson.M{"uuid": id}).Decode(&image)
+	err = r.collection.FindOne(ctx, notDeleted(bson.M{"uuid": id})).Decode(&image)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, errors.New("温泉画像が見つかりません")
@@ -74,7 +74,7 @@ func (r *MongoOnsenImageRepository) FindByID(ctx context.Context, id string) (*e
 // FindByOnsenID は温泉IDに紐づく画像を検索します
 func (r *MongoOnsenImageRepository) FindByOnsenID(ctx context.Context, onsenID string) ([]*entity.OnsenImage, error) {
 	// 検索条件を作成
-	filter := bson.M{"onsen_id": onsenID}
+	filter := notDeleted(bson.M{"onsen_id": onsenID})
 
 	// ソート条件を作成（作成日時の降順）
 	opts := options.Find().SetSort(bson.M{"created_at": -1})
@@ -98,10 +98,10 @@ func (r *MongoOnsenImageRepository) FindByOnsenID(ctx context.Context, onsenID s
 // FindByOnsenIDAndUserID は温泉IDとユーザーIDに紐づく画像を検索します
 func (r *MongoOnsenImageRepository) FindByOnsenIDAndUserID(ctx context.Context, onsenID, userID string) ([]*entity.OnsenImage, error) {
 	// 検索条件を作成
-	filter := bson.M{
+	filter := notDeleted(bson.M{
 		"onsen_id": onsenID,
 		"user_id":  userID,
-	}
+	})
 
 	// ソート条件を作成（作成日時の降順）
 	opts := options.Find().SetSort(bson.M{"created_at": -1})
@@ -133,7 +133,7 @@ func (r *MongoOnsenImageRepository) Update(ctx context.Context, image *entity.On
 	return err
 }
 
-// Delete は温泉画像を削除します
+// Delete は温泉画像を完全に削除します（ゴミ箱の画像を含みます）
 func (r *MongoOnsenImageRepository) Delete(ctx context.Context, id string) error {
 	// IDがObjectIDの場合
 	objectID, err := primitive.ObjectIDFromHex(id)
@@ -158,7 +158,147 @@ func (r *MongoOnsenImageRepository) Delete(ctx context.Context, id string) error
 	return nil
 }
 
-// DeleteByOnsenID は温泉IDに紐づく画像をすべて削除します
+// FindDeletedByID はIDでゴミ箱の画像を検索します
+func (r *MongoOnsenImageRepository) FindDeletedByID(ctx context.Context, id string) (*entity.OnsenImage, error) {
+	var image entity.OnsenImage
+
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで検索
+		err = r.collection.FindOne(ctx, deleted(bson.M{"_id": objectID})).Decode(&image)
+		if err == nil {
+			return &image, nil
+		}
+	}
+
+	// UUIDで検索
+	err = r.collection.FindOne(ctx, deleted(bson.M{"uuid": id})).Decode(&image)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("ゴミ箱に温泉画像が見つかりません")
+		}
+		return nil, err
+	}
+
+	return &image, nil
+}
+
+// FindDeletedByUserID はユーザーIDに紐づくゴミ箱の画像を削除日時の新しい順に検索します
+func (r *MongoOnsenImageRepository) FindDeletedByUserID(ctx context.Context, userID string) ([]*entity.OnsenImage, error) {
+	// ソート条件を作成（削除日時の降順）
+	opts := options.Find().SetSort(bson.M{"deleted_at": -1})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, deleted(bson.M{"user_id": userID}), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var images []*entity.OnsenImage
+	if err := cursor.All(ctx, &images); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// FindDeletedBefore は指定日時より前に削除されたゴミ箱の画像を削除日時の古い順に検索します
+func (r *MongoOnsenImageRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.OnsenImage, error) {
+	// ソート条件を作成（削除日時の昇順）
+	opts := options.Find().
+		SetSort(bson.M{"deleted_at": 1}).
+		SetLimit(int64(limit))
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, bson.M{"deleted_at": bson.M{"$lt": before}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var images []*entity.OnsenImage
+	if err := cursor.All(ctx, &images); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// SoftDelete は温泉画像に削除日時を設定してゴミ箱に移動します
+func (r *MongoOnsenImageRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	result, err := r.collection.UpdateOne(ctx,
+		notDeleted(bson.M{"uuid": id}),
+		bson.M{"$set": bson.M{"deleted_at": deletedAt}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("温泉画像が見つかりません")
+	}
+
+	return nil
+}
+
+// Restore はゴミ箱の温泉画像の削除日時を取り除いて復元します
+func (r *MongoOnsenImageRepository) Restore(ctx context.Context, id string) error {
+	result, err := r.collection.UpdateOne(ctx,
+		deleted(bson.M{"uuid": id}),
+		bson.M{"$set": bson.M{"deleted_at": nil}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("ゴミ箱に温泉画像が見つかりません")
+	}
+
+	return nil
+}
+
+// FindDeletedByOnsenID は温泉IDに紐づくゴミ箱の画像を検索します
+func (r *MongoOnsenImageRepository) FindDeletedByOnsenID(ctx context.Context, onsenID string) ([]*entity.OnsenImage, error) {
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, deleted(bson.M{"onsen_id": onsenID}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var images []*entity.OnsenImage
+	if err := cursor.All(ctx, &images); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// SoftDeleteByOnsenID は温泉IDに紐づく画像に削除日時を設定してゴミ箱に移動します
+func (r *MongoOnsenImageRepository) SoftDeleteByOnsenID(ctx context.Context, onsenID string, deletedAt time.Time) error {
+	_, err := r.collection.UpdateMany(ctx,
+		notDeleted(bson.M{"onsen_id": onsenID}),
+		bson.M{"$set": bson.M{"deleted_at": deletedAt}},
+	)
+	return err
+}
+
+// RestoreByOnsenID は温泉IDに紐づく画像のうち指定日時に削除されたものを復元します
+func (r *MongoOnsenImageRepository) RestoreByOnsenID(ctx context.Context, onsenID string, deletedAt time.Time) error {
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"onsen_id": onsenID, "deleted_at": deletedAt},
+		bson.M{"$set": bson.M{"deleted_at": nil}},
+	)
+	return err
+}
+
+// DeleteByOnsenID は温泉IDに紐づく画像をすべて削除します（ゴミ箱の画像を含みます）
 func (r *MongoOnsenImageRepository) DeleteByOnsenID(ctx context.Context, onsenID string) error {
 	// 温泉IDで削除
 	_, err := r.collection.DeleteMany(ctx, bson.M{"onsen_id": onsenID})
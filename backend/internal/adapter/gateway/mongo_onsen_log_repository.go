@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -24,12 +25,29 @@ const (
 	userIDIndex         = "user_id_idx"
 	visitDateIndex      = "visit_date_idx"
 	userVisitDateIndex  = "user_visit_date_idx"
-	userSpringTypeIndex = "user_spring_type_idx"
-	userLocationIndex   = "user_location_idx"
+	userSpringTypeIndex = "user_spring_types_idx"
+	userSearchIndex     = "user_search_terms_idx"
+	userTagsIndex       = "user_tags_idx"
 	userRatingIndex     = "user_rating_idx"
-	compoundFilterIndex = "user_filter_compound_idx"
+	compoundFilterIndex = "user_filter_compound_v2_idx"
+	userOnsenIndex      = "user_onsen_idx"
+	userPrefectureIndex = "user_prefecture_idx"
+	coordinatesIndex    = "coordinates_2dsphere_idx"
+	userFeedIndex       = "user_visibility_created_at_idx"
+	deletedAtIndex      = "deleted_at_idx"
 )
 
+// onsenLogCursorBatchSize は温泉メモを1件ずつ読み取るときに1回で取得する件数です
+const onsenLogCursorBatchSize = 200
+
+// obsoleteOnsenLogIndexes は不要になったインデックスの名前です
+// 所在地のテキストインデックスは使用されておらず、泉質のインデックスは複数泉質への移行で置き換えました
+var obsoleteOnsenLogIndexes = []string{
+	"user_location_idx",
+	"user_spring_type_idx",
+	"user_filter_compound_idx",
+}
+
 // NewMongoOnsenLogRepository は新しいMongoDBの温泉メモリポジトリを作成します
 func NewMongoOnsenLogRepository(db *mongo.Database) *MongoOnsenLogRepository {
 	// リポジトリインスタンスを作成
@@ -69,14 +87,20 @@ func (r *MongoOnsenLogRepository) ensureIndexes(ctx context.Context) {
 
 	// ユーザーID+泉質の複合インデックス
 	userSpringTypeIdx := mongo.IndexModel{
-		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "spring_type", Value: 1}},
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "spring_types", Value: 1}},
 		Options: options.Index().SetName(userSpringTypeIndex),
 	}
 
-	// ユーザーID+所在地の複合インデックス（テキスト検索の高速化）
-	userLocationIdx := mongo.IndexModel{
-		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "location", Value: "text"}},
-		Options: options.Index().SetName(userLocationIndex),
+	// ユーザーID+検索用N-gramの複合インデックス（キーワード検索用）
+	userSearchIdx := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "search_terms", Value: 1}},
+		Options: options.Index().SetName(userSearchIndex),
+	}
+
+	// ユーザーID+タグの複合インデックス（タグでの絞り込み・集計用）
+	userTagsIdx := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "tags", Value: 1}},
+		Options: options.Index().SetName(userTagsIndex),
 	}
 
 	// ユーザーID+評価の複合インデックス
@@ -89,22 +113,70 @@ func (r *MongoOnsenLogRepository) ensureIndexes(ctx context.Context) {
 	filterIdx := mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "user_id", Value: 1},
-			{Key: "spring_type", Value: 1},
+			{Key: "spring_types", Value: 1},
 			{Key: "rating", Value: -1},
 			{Key: "visit_date", Value: -1},
 		},
 		Options: options.Index().SetName(compoundFilterIndex),
 	}
 
+	// ユーザーID+温泉施設IDの複合インデックス（施設ごとの集計用）
+	userOnsenIdx := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "onsen_id", Value: 1}},
+		Options: options.Index().SetName(userOnsenIndex),
+	}
+
+	// ユーザーID+都道府県コードの複合インデックス（都道府県での絞り込み・集計用）
+	userPrefectureIdx := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "area.prefecture_code", Value: 1}},
+		Options: options.Index().SetName(userPrefectureIndex),
+	}
+
+	// 位置情報の地理空間インデックス（周辺検索用）
+	coordinatesIdx := mongo.IndexModel{
+		Keys:    bson.D{{Key: "coordinates", Value: "2dsphere"}, {Key: "user_id", Value: 1}},
+		Options: options.Index().SetName(coordinatesIndex),
+	}
+
+	// ユーザーID+公開範囲+作成日時の複合インデックス（フィード用）
+	userFeedIdx := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "visibility", Value: 1},
+			{Key: "created_at", Value: -1},
+			{Key: "_id", Value: -1},
+		},
+		Options: options.Index().SetName(userFeedIndex),
+	}
+
+	// 削除日時のインデックス（ゴミ箱の一覧・完全削除用、ゴミ箱の温泉メモのみを対象とする）
+	deletedAtIdx := mongo.IndexModel{
+		Keys: bson.D{{Key: "deleted_at", Value: 1}},
+		Options: options.Index().
+			SetName(deletedAtIndex).
+			SetPartialFilterExpression(bson.M{"deleted_at": bson.M{"$type": "date"}}),
+	}
+
+	// 不要になったインデックスを削除（存在しない場合は無視する）
+	for _, name := range obsoleteOnsenLogIndexes {
+		_, _ = r.collection.Indexes().DropOne(ctx, name)
+	}
+
 	// すべてのインデックスを一括で作成（存在する場合は無視される）
 	indexes := []mongo.IndexModel{
 		userIDIdx,
 		visitDateIdx,
 		userVisitDateIdx,
 		userSpringTypeIdx,
-		userLocationIdx,
+		userSearchIdx,
+		userTagsIdx,
 		userRatingIdx,
 		filterIdx,
+		userOnsenIdx,
+		userPrefectureIdx,
+		coordinatesIdx,
+		userFeedIdx,
+		deletedAtIdx,
 	}
 
 	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
@@ -142,14 +214,14 @@ func (r *MongoOnsenLogRepository) FindByID(ctx context.Context, id string) (*ent
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err == nil {
 		// ObjectIDで検索
-		err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&onsenLog)
+		err = r.collection.FindOne(ctx, notDeleted(bson.M{"_id": objectID})).Decode(&onsenLog)
 		if err == nil {
 			return &onsenLog, nil
 		}
 	}
 
 	// UUIDで検索
-	err = r.collection.FindOne(ctx, bson.M{"uuid": id}).Decode(&onsenLog)
+	err = r.collection.FindOne(ctx, notDeleted(bson.M{"uuid": id})).Decode(&onsenLog)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, errors.New("温泉メモが見つかりません")
@@ -163,7 +235,7 @@ func (r *MongoOnsenLogRepository) FindByID(ctx context.Context, id string) (*ent
 // FindByUserID はユーザーIDに紐づく温泉メモを検索します
 func (r *MongoOnsenLogRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.OnsenLog, error) {
 	// 検索条件を作成
-	filter := bson.M{"user_id": userID}
+	filter := notDeleted(bson.M{"user_id": userID})
 
 	// ソート条件を作成（訪問日の降順）
 	opts := options.Find().SetSort(bson.M{"visit_date": -1})
@@ -184,6 +256,161 @@ func (r *MongoOnsenLogRepository) FindByUserID(ctx context.Context, userID strin
 	return onsenLogs, nil
 }
 
+// CountByUserID はユーザーIDに紐づく温泉メモの件数を返します
+func (r *MongoOnsenLogRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, notDeleted(bson.M{"user_id": userID}))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// EachByUserID はユーザーIDに紐づく温泉メモを訪問日の新しい順に1件ずつ読み取り、fnを呼び出します
+func (r *MongoOnsenLogRepository) EachByUserID(ctx context.Context, userID string, fn func(onsenLog *entity.OnsenLog) error) error {
+	// 検索条件を作成
+	filter := notDeleted(bson.M{"user_id": userID})
+
+	// ソート条件とバッチサイズを設定（訪問日の降順）
+	opts := options.Find().
+		SetSort(bson.M{"visit_date": -1}).
+		SetBatchSize(onsenLogCursorBatchSize)
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	// 1件ずつ読み取り
+	for cursor.Next(ctx) {
+		var onsenLog entity.OnsenLog
+		if err := cursor.Decode(&onsenLog); err != nil {
+			return err
+		}
+		if err := fn(&onsenLog); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// FindByUserIDAndVisitDateRange はユーザーIDに紐づく温泉メモのうち訪問日がfrom以上to未満のものを訪問日の古い順に検索します
+func (r *MongoOnsenLogRepository) FindByUserIDAndVisitDateRange(ctx context.Context, userID string, from, to *time.Time) ([]*entity.OnsenLog, error) {
+	// 検索条件を作成（ユーザーID+訪問日のインデックスを使用）
+	filter := notDeleted(bson.M{"user_id": userID})
+	dateFilter := bson.M{}
+	if from != nil {
+		dateFilter["$gte"] = *from
+	}
+	if to != nil {
+		dateFilter["$lt"] = *to
+	}
+	if len(dateFilter) > 0 {
+		filter["visit_date"] = dateFilter
+	}
+
+	// ソート条件を作成（訪問日の昇順）
+	opts := options.Find().SetSort(bson.M{"visit_date": 1})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var onsenLogs []*entity.OnsenLog
+	if err := cursor.All(ctx, &onsenLogs); err != nil {
+		return nil, err
+	}
+
+	return onsenLogs, nil
+}
+
+// FindByUserIDAndIDs はユーザーIDに紐づく温泉メモのうちIDに一致するものを指定したIDの順に検索します
+// 見つからないIDは結果に含めません
+func (r *MongoOnsenLogRepository) FindByUserIDAndIDs(ctx context.Context, userID string, ids []string) ([]*entity.OnsenLog, error) {
+	if len(ids) == 0 {
+		return []*entity.OnsenLog{}, nil
+	}
+
+	// 検索条件を作成
+	filter := notDeleted(bson.M{"user_id": userID, "uuid": bson.M{"$in": ids}})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var found []*entity.OnsenLog
+	if err := cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+
+	// 指定したIDの順に並べ替え
+	byID := make(map[string]*entity.OnsenLog, len(found))
+	for _, onsenLog := range found {
+		byID[onsenLog.UUID] = onsenLog
+	}
+	onsenLogs := make([]*entity.OnsenLog, 0, len(found))
+	for _, id := range ids {
+		if onsenLog, ok := byID[id]; ok {
+			onsenLogs = append(onsenLogs, onsenLog)
+		}
+	}
+
+	return onsenLogs, nil
+}
+
+// FindVisibleByUserIDs は指定したユーザーの温泉メモのうち公開範囲が一致するものを作成日時の新しい順に検索します
+// cursorを指定した場合はその位置より後の温泉メモを検索します
+func (r *MongoOnsenLogRepository) FindVisibleByUserIDs(ctx context.Context, userIDs []string, visibilities []entity.Visibility, cursor *entity.FeedCursor, limit int) ([]*entity.OnsenLog, error) {
+	if len(userIDs) == 0 || len(visibilities) == 0 {
+		return []*entity.OnsenLog{}, nil
+	}
+
+	// 検索条件を作成
+	filter := bson.M{
+		"user_id":    bson.M{"$in": userIDs},
+		"visibility": bson.M{"$in": visibilities},
+		"deleted_at": nil,
+	}
+	if cursor != nil {
+		// 作成日時が同じ場合はIDで順序を決める
+		filter["$or"] = []bson.M{
+			{"created_at": bson.M{"$lt": cursor.CreatedAt}},
+			{"created_at": cursor.CreatedAt, "_id": bson.M{"$lt": cursor.ID}},
+		}
+	}
+
+	// ソート条件を作成（作成日時の降順、IDの降順）
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+
+	// 検索を実行
+	result, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close(ctx)
+
+	// 結果を取得
+	var onsenLogs []*entity.OnsenLog
+	if err := result.All(ctx, &onsenLogs); err != nil {
+		return nil, err
+	}
+
+	return onsenLogs, nil
+}
+
 // FindByUserIDWithPagination はユーザーIDに紐づく温泉メモをページネーションで検索します
 func (r *MongoOnsenLogRepository) FindByUserIDWithPagination(ctx context.Context, userID string, page, limit int) ([]*entity.OnsenLog, int, error) {
 	// タイムアウト設定
@@ -191,7 +418,7 @@ func (r *MongoOnsenLogRepository) FindByUserIDWithPagination(ctx context.Context
 	defer cancel()
 
 	// 検索条件を作成
-	filter := bson.M{"user_id": userID}
+	filter := notDeleted(bson.M{"user_id": userID})
 
 	// パイプラインを使って効率的にクエリを実行
 	pipeline := mongo.Pipeline{
@@ -246,72 +473,557 @@ func (r *MongoOnsenLogRepository) FindByUserIDWithPagination(ctx context.Context
 }
 
 // FindByUserIDAndFilter はユーザーIDと条件に紐づく温泉メモを検索します
-func (r *MongoOnsenLogRepository) FindByUserIDAndFilter(ctx context.Context, userID string, springType entity.SpringType, location string, minRating int, startDate, endDate *time.Time, page, limit int) ([]*entity.OnsenLog, int, error) {
+// キーワードが指定された場合は関連度の高い順、それ以外は訪問日の降順で返します
+func (r *MongoOnsenLogRepository) FindByUserIDAndFilter(ctx context.Context, userID string, filter repository.OnsenLogFilter, page, limit int) ([]*entity.OnsenLog, int, error) {
 	// 検索条件を作成
-	filter := bson.M{"user_id": userID}
+	query := buildOnsenLogFilterQuery(userID, filter)
+
+	// キーワード検索は関連度を計算するため集計パイプラインで実行
+	if variants := entity.SearchQueryVariants(filter.Query); len(variants) > 0 {
+		return r.searchByKeyword(ctx, query, variants, filter.Sort, page, limit)
+	}
+
+	// 総件数を取得
+	totalCount, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// ページネーション条件を作成
+	skip := (page - 1) * limit
+	opts := options.Find().
+		SetSort(buildOnsenLogSort(filter.Sort)).
+		SetSkip(int64(skip)).
+		SetLimit(int64(limit))
 
-	// 泉質でフィルタリング
-	if springType != "" {
-		filter["spring_type"] = springType
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var onsenLogs []*entity.OnsenLog
+	if err := cursor.All(ctx, &onsenLogs); err != nil {
+		return nil, 0, err
+	}
+
+	return onsenLogs, int(totalCount), nil
+}
+
+// buildOnsenLogFilterQuery は検索条件からMongoDBのクエリを作成します（キーワードは含みません）
+func buildOnsenLogFilterQuery(userID string, filter repository.OnsenLogFilter) bson.M {
+	query := notDeleted(bson.M{"user_id": userID})
+
+	// 泉質でフィルタリング（いずれかを含む）
+	if len(filter.SpringTypes) > 0 {
+		query["spring_types"] = bson.M{"$in": filter.SpringTypes}
+	}
+
+	// 温泉分析書の測定値でフィルタリング
+	if rangeFilter := buildRangeFilter(filter.MinPH, filter.MaxPH); rangeFilter != nil {
+		query["water_analysis.ph"] = rangeFilter
+	}
+	if rangeFilter := buildRangeFilter(filter.MinTemperature, filter.MaxTemperature); rangeFilter != nil {
+		query["water_analysis.source_temperature"] = rangeFilter
+	}
+	if filter.Tonicity != "" {
+		query["water_analysis.tonicity"] = filter.Tonicity
 	}
 
 	// 所在地でフィルタリング
-	if location != "" {
-		filter["location"] = bson.M{"$regex": location, "$options": "i"}
+	if filter.Location != "" {
+		query["location"] = bson.M{"$regex": filter.Location, "$options": "i"}
+	}
+	if len(filter.PrefectureCodes) > 0 {
+		query["area.prefecture_code"] = bson.M{"$in": filter.PrefectureCodes}
+	}
+
+	// タグでフィルタリング
+	if len(filter.Tags) > 0 {
+		if filter.TagMatch == entity.TagMatchAll {
+			query["tags"] = bson.M{"$all": filter.Tags}
+		} else {
+			query["tags"] = bson.M{"$in": filter.Tags}
+		}
 	}
 
 	// 評価でフィルタリング
-	if minRating > 0 {
-		filter["rating"] = bson.M{"$gte": minRating}
+	if filter.MinRating > 0 {
+		query["rating"] = bson.M{"$gte": filter.MinRating}
+	}
+
+	// 項目別評価でフィルタリング
+	for criterion, minScore := range filter.MinScores {
+		if minScore > 0 {
+			query["rating_scores."+string(criterion)] = bson.M{"$gte": minScore}
+		}
+	}
+
+	// 訪問時の状況でフィルタリング
+	if filter.StayType != "" {
+		query["visit.stay_type"] = filter.StayType
+	}
+	if len(filter.Companions) > 0 {
+		query["visit.companions"] = bson.M{"$in": filter.Companions}
+	}
+	if filter.ArrivalFrom != "" || filter.ArrivalTo != "" {
+		// 到着時刻は "HH:MM" 形式で保存しているため文字列で比較できる（未記録の空文字は除外）
+		arrivalFilter := bson.M{"$gt": ""}
+		if filter.ArrivalFrom != "" {
+			arrivalFilter = bson.M{"$gte": filter.ArrivalFrom}
+		}
+		if filter.ArrivalTo != "" {
+			arrivalFilter["$lte"] = filter.ArrivalTo
+		}
+		query["visit.arrival_time"] = arrivalFilter
+	}
+	if rangeFilter := buildRangeFilter(filter.MinDurationMinutes, filter.MaxDurationMinutes); rangeFilter != nil {
+		query["visit.duration_minutes"] = rangeFilter
+	}
+	if rangeFilter := buildRangeFilter(filter.MinTotalCost, filter.MaxTotalCost); rangeFilter != nil {
+		query["visit.total_cost"] = rangeFilter
+	}
+	if filter.Currency != "" {
+		query["visit.currency"] = filter.Currency
 	}
 
 	// 訪問日でフィルタリング
-	if startDate != nil || endDate != nil {
+	if filter.StartDate != nil || filter.EndDate != nil {
 		dateFilter := bson.M{}
-		if startDate != nil {
-			dateFilter["$gte"] = startDate
+		if filter.StartDate != nil {
+			dateFilter["$gte"] = filter.StartDate
 		}
-		if endDate != nil {
-			dateFilter["$lte"] = endDate
+		if filter.EndDate != nil {
+			dateFilter["$lte"] = filter.EndDate
 		}
-		filter["visit_date"] = dateFilter
+		query["visit_date"] = dateFilter
 	}
 
-	// 総件数を取得
-	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	return query
+}
+
+// buildRangeFilter は最小値・最大値から範囲の条件を作成します（どちらも未指定の場合はnil）
+func buildRangeFilter(min, max *float64) bson.M {
+	if min == nil && max == nil {
+		return nil
+	}
+	rangeFilter := bson.M{}
+	if min != nil {
+		rangeFilter["$gte"] = *min
+	}
+	if max != nil {
+		rangeFilter["$lte"] = *max
+	}
+	return rangeFilter
+}
+
+// buildOnsenLogSort は並び順からMongoDBのソート条件を作成します
+// 同じ値の場合は訪問日の新しい順に並べます
+func buildOnsenLogSort(sort repository.OnsenLogSort) bson.D {
+	direction := -1
+	if sort.Ascending {
+		direction = 1
+	}
+
+	switch field := sort.Field; {
+	case field == "" || field == repository.OnsenLogSortVisitDate:
+		return bson.D{{Key: "visit_date", Value: direction}}
+	case field == repository.OnsenLogSortRating:
+		return bson.D{{Key: "rating", Value: direction}, {Key: "visit_date", Value: -1}}
+	default:
+		return bson.D{{Key: "rating_scores." + field, Value: direction}, {Key: "visit_date", Value: -1}}
+	}
+}
+
+// searchByKeyword はN-gramの一致率で関連度を計算し、関連度の高い順に温泉メモを検索します
+// いずれかの表記（元の表記・読み仮名）のN-gramの半数以上に一致するものを対象とし、
+// 温泉名に一致する場合は関連度を加算します（並び順が指定された場合はそちらを優先）
+func (r *MongoOnsenLogRepository) searchByKeyword(ctx context.Context, query bson.M, variants [][]string, sort repository.OnsenLogSort, page, limit int) ([]*entity.OnsenLog, int, error) {
+	// タイムアウト設定
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 並び順が指定されていなければ関連度順
+	sortOrder := bson.D{{Key: "search_score", Value: -1}, {Key: "visit_date", Value: -1}}
+	if sort.Field != "" {
+		sortOrder = buildOnsenLogSort(sort)
+	}
+
+	pipeline := append(keywordMatchStages(query, variants),
+		bson.D{{Key: "$sort", Value: sortOrder}},
+		bson.D{{Key: "$facet", Value: bson.M{
+			"metadata": mongo.Pipeline{
+				{{Key: "$count", Value: "total"}},
+			},
+			"data": mongo.Pipeline{
+				{{Key: "$skip", Value: (page - 1) * limit}},
+				{{Key: "$limit", Value: limit}},
+			},
+		}}},
+	)
+
+	// パイプラインを実行
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, 0, err
 	}
+	defer cursor.Close(ctx)
 
-	// ページネーション条件を作成
-	skip := (page - 1) * limit
+	// 結果構造体
+	var results []struct {
+		Metadata []struct {
+			Total int `bson:"total"`
+		} `bson:"metadata"`
+		Data []*entity.OnsenLog `bson:"data"`
+	}
+
+	// 結果を取得
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, 0, err
+	}
+
+	// 結果が空の場合
+	if len(results) == 0 {
+		return []*entity.OnsenLog{}, 0, nil
+	}
+
+	// カウントを取得
+	totalCount := 0
+	if len(results[0].Metadata) > 0 {
+		totalCount = results[0].Metadata[0].Total
+	}
+
+	return results[0].Data, totalCount, nil
+}
+
+// keywordMatchStages はキーワードに一致する温泉メモを絞り込むパイプラインのステージを作成します
+// 一致したN-gramの割合（search_coverage）が半分以上のものを残し、関連度（search_score）を付与します
+func keywordMatchStages(query bson.M, variants [][]string) mongo.Pipeline {
+	// インデックスで候補を絞り込むため、いずれかのN-gramを含むことを条件に追加
+	var allTerms bson.A
+	coverages := bson.A{}
+	scores := bson.A{}
+	for _, terms := range variants {
+		for _, term := range terms {
+			allTerms = append(allTerms, term)
+		}
+		termCount := float64(len(terms))
+		coverage := bson.M{"$size": bson.M{"$setIntersection": bson.A{"$search_terms", terms}}}
+		nameCoverage := bson.M{"$size": bson.M{"$setIntersection": bson.A{
+			bson.M{"$ifNull": bson.A{"$name_terms", bson.A{}}}, terms,
+		}}}
+		coverages = append(coverages, bson.M{"$divide": bson.A{coverage, termCount}})
+		scores = append(scores, bson.M{"$divide": bson.A{bson.M{"$add": bson.A{coverage, nameCoverage}}, termCount}})
+	}
+	query["search_terms"] = bson.M{"$in": allTerms}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: query}},
+		{{Key: "$addFields", Value: bson.M{
+			"search_coverage": bson.M{"$max": coverages},
+			"search_score":    bson.M{"$max": scores},
+		}}},
+		{{Key: "$match", Value: bson.M{"search_coverage": bson.M{"$gte": 0.5}}}},
+	}
+}
+
+// FindNearby はユーザーIDに紐づく温泉メモのうち指定地点から半径内のものを距離の近い順に検索します
+func (r *MongoOnsenLogRepository) FindNearby(ctx context.Context, userID string, latitude, longitude, radiusKm float64, limit int) ([]*entity.NearbyOnsenLog, error) {
+	// $geoNearは距離順にソートし、距離（メートル）を付与する
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: bson.M{
+			"near":          entity.NewGeoPoint(latitude, longitude),
+			"distanceField": "distance",
+			"maxDistance":   radiusKm * 1000,
+			"query":         notDeleted(bson.M{"user_id": userID}),
+			"key":           "coordinates",
+			"spherical":     true,
+		}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	// パイプラインを実行
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var results []struct {
+		entity.OnsenLog `bson:",inline"`
+		Distance        float64 `bson:"distance"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	nearbyLogs := make([]*entity.NearbyOnsenLog, len(results))
+	for i := range results {
+		nearbyLogs[i] = &entity.NearbyOnsenLog{
+			OnsenLog:   &results[i].OnsenLog,
+			DistanceKm: results[i].Distance / 1000,
+		}
+	}
+
+	return nearbyLogs, nil
+}
+
+// FindByOnsenID は温泉施設IDに紐づく温泉メモを検索します
+func (r *MongoOnsenLogRepository) FindByOnsenID(ctx context.Context, onsenID string) ([]*entity.OnsenLog, error) {
+	// 検索条件を作成
+	filter := notDeleted(bson.M{"onsen_id": onsenID})
+
+	// ソート条件を作成（訪問日の降順）
+	opts := options.Find().SetSort(bson.M{"visit_date": -1})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var onsenLogs []*entity.OnsenLog
+	if err := cursor.All(ctx, &onsenLogs); err != nil {
+		return nil, err
+	}
+
+	return onsenLogs, nil
+}
+
+// SummarizeVisitsByPrefecture はユーザーの温泉メモを都道府県ごとに集計します
+// 都道府県を判定できなかった温泉メモは都道府県コードが空の集計になります
+func (r *MongoOnsenLogRepository) SummarizeVisitsByPrefecture(ctx context.Context, userID string) ([]*entity.PrefectureVisitSummary, error) {
+	// 都道府県コードごとに訪問回数・初回/最終訪問日を集計
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: notDeleted(bson.M{"user_id": userID})}},
+		{{Key: "$group", Value: bson.M{
+			"_id":         bson.M{"$ifNull": bson.A{"$area.prefecture_code", ""}},
+			"visit_count": bson.M{"$sum": 1},
+			"first_visit": bson.M{"$min": "$visit_date"},
+			"last_visit":  bson.M{"$max": "$visit_date"},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+
+	// パイプラインを実行
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var summaries []*entity.PrefectureVisitSummary
+	if err := cursor.All(ctx, &summaries); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// SummarizeVisitsByOnsen はユーザーの温泉メモを温泉施設ごとに集計します
+func (r *MongoOnsenLogRepository) SummarizeVisitsByOnsen(ctx context.Context, userID string) ([]*entity.OnsenVisitSummary, error) {
+	// 施設IDごとに訪問回数・初回/最終訪問日・平均評価を集計
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: notDeleted(bson.M{
+			"user_id":  userID,
+			"onsen_id": bson.M{"$nin": bson.A{nil, ""}},
+		})}},
+		{{Key: "$group", Value: bson.M{
+			"_id":            "$onsen_id",
+			"visit_count":    bson.M{"$sum": 1},
+			"first_visit":    bson.M{"$min": "$visit_date"},
+			"last_visit":     bson.M{"$max": "$visit_date"},
+			"average_rating": bson.M{"$avg": "$rating"},
+		}}},
+		{{Key: "$sort", Value: bson.M{"last_visit": -1}}},
+	}
+
+	// パイプラインを実行
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var summaries []*entity.OnsenVisitSummary
+	if err := cursor.All(ctx, &summaries); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// FindTagsByUserID はユーザーが使用しているタグを使用回数付きで取得します
+func (r *MongoOnsenLogRepository) FindTagsByUserID(ctx context.Context, userID string) ([]*entity.TagUsage, error) {
+	// タグごとに使用回数と最終使用日（訪問日）を集計し、使用回数の多い順に並べる
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: notDeleted(bson.M{"user_id": userID})}},
+		{{Key: "$unwind", Value: "$tags"}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          "$tags",
+			"count":        bson.M{"$sum": 1},
+			"last_used_at": bson.M{"$max": "$visit_date"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+
+	// パイプラインを実行
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	tags := []*entity.TagUsage{}
+	if err := cursor.All(ctx, &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// FindByUserIDAndTag はユーザーIDに紐づく温泉メモのうちタグが付いているものを訪問日の新しい順に検索します
+func (r *MongoOnsenLogRepository) FindByUserIDAndTag(ctx context.Context, userID, tag string) ([]*entity.OnsenLog, error) {
+	// 検索条件を作成
+	filter := notDeleted(bson.M{"user_id": userID, "tags": tag})
+
+	// ソート条件を作成（訪問日の降順）
+	opts := options.Find().SetSort(bson.M{"visit_date": -1})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var onsenLogs []*entity.OnsenLog
+	if err := cursor.All(ctx, &onsenLogs); err != nil {
+		return nil, err
+	}
+
+	return onsenLogs, nil
+}
+
+// FindDeletedByID はIDでゴミ箱の温泉メモを検索します
+func (r *MongoOnsenLogRepository) FindDeletedByID(ctx context.Context, id string) (*entity.OnsenLog, error) {
+	var onsenLog entity.OnsenLog
+
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで検索
+		err = r.collection.FindOne(ctx, deleted(bson.M{"_id": objectID})).Decode(&onsenLog)
+		if err == nil {
+			return &onsenLog, nil
+		}
+	}
+
+	// UUIDで検索
+	err = r.collection.FindOne(ctx, deleted(bson.M{"uuid": id})).Decode(&onsenLog)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("ゴミ箱に温泉メモが見つかりません")
+		}
+		return nil, err
+	}
+
+	return &onsenLog, nil
+}
+
+// FindDeletedByUserID はユーザーIDに紐づくゴミ箱の温泉メモを削除日時の新しい順に検索します
+func (r *MongoOnsenLogRepository) FindDeletedByUserID(ctx context.Context, userID string) ([]*entity.OnsenLog, error) {
+	// ソート条件を作成（削除日時の降順）
+	opts := options.Find().SetSort(bson.M{"deleted_at": -1})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, deleted(bson.M{"user_id": userID}), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var onsenLogs []*entity.OnsenLog
+	if err := cursor.All(ctx, &onsenLogs); err != nil {
+		return nil, err
+	}
+
+	return onsenLogs, nil
+}
+
+// FindDeletedBefore は指定日時より前に削除されたゴミ箱の温泉メモを削除日時の古い順に検索します
+func (r *MongoOnsenLogRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.OnsenLog, error) {
+	// ソート条件を作成（削除日時の昇順）
 	opts := options.Find().
-		SetSort(bson.M{"visit_date": -1}).
-		SetSkip(int64(skip)).
+		SetSort(bson.M{"deleted_at": 1}).
 		SetLimit(int64(limit))
 
 	// 検索を実行
-	cursor, err := r.collection.Find(ctx, filter, opts)
+	cursor, err := r.collection.Find(ctx, bson.M{"deleted_at": bson.M{"$lt": before}}, opts)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer cursor.Close(ctx)
 
 	// 結果を取得
 	var onsenLogs []*entity.OnsenLog
 	if err := cursor.All(ctx, &onsenLogs); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return onsenLogs, int(totalCount), nil
+	return onsenLogs, nil
+}
+
+// SoftDelete は温泉メモに削除日時を設定してゴミ箱に移動します
+func (r *MongoOnsenLogRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	result, err := r.collection.UpdateOne(ctx,
+		notDeleted(bson.M{"uuid": id}),
+		bson.M{"$set": bson.M{"deleted_at": deletedAt}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("温泉メモが見つかりません")
+	}
+
+	return nil
+}
+
+// Restore はゴミ箱の温泉メモの削除日時を取り除いて復元します
+func (r *MongoOnsenLogRepository) Restore(ctx context.Context, id string) error {
+	result, err := r.collection.UpdateOne(ctx,
+		deleted(bson.M{"uuid": id}),
+		bson.M{"$set": bson.M{"deleted_at": nil, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("ゴミ箱に温泉メモが見つかりません")
+	}
+
+	return nil
 }
 
 // Update は温泉メモを更新します
 func (r *MongoOnsenLogRepository) Update(ctx context.Context, onsenLog *entity.OnsenLog) error {
 	onsenLog.UpdatedAt = time.Now()
 
-	// MongoDBを更新
-	filter := bson.M{"_id": onsenLog.ID}
+	// MongoDBを更新（ゴミ箱の温泉メモは更新しない）
+	filter := notDeleted(bson.M{"_id": onsenLog.ID})
 	update := bson.M{"$set": onsenLog}
 	_, err := r.collection.UpdateOne(ctx, filter, update)
 	return err
@@ -348,3 +1060,16 @@ func (r *MongoOnsenLogRepository) DeleteByUserID(ctx context.Context, userID str
 	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID})
 	return err
 }
+
+// notDeleted は検索条件にゴミ箱にないドキュメントのみに一致する条件を追加します
+// 削除日時のないドキュメント（ソフトデリートの導入前のものを含む）に一致します
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = nil
+	return filter
+}
+
+// deleted は検索条件にゴミ箱にあるドキュメントのみに一致する条件を追加します
+func deleted(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$ne": nil}
+	return filter
+}
@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoOnsenLogRevisionRepository はMongoDBを使用した温泉メモの変更履歴リポジトリの実装です
+type MongoOnsenLogRevisionRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	onsenLogRevisionsCollection  = "onsen_log_revisions"
+	onsenLogRevisionVersionIndex = "onsen_log_version_idx"
+)
+
+// NewMongoOnsenLogRevisionRepository は新しいMongoDBの温泉メモの変更履歴リポジトリを作成します
+func NewMongoOnsenLogRevisionRepository(db *mongo.Database) *MongoOnsenLogRevisionRepository {
+	repo := &MongoOnsenLogRevisionRepository{
+		collection: db.Collection(onsenLogRevisionsCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoOnsenLogRevisionRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// 温泉メモID+版番号のユニークインデックス（履歴の一覧表示と版番号の重複防止用）
+		{
+			Keys:    bson.D{{Key: "onsen_log_id", Value: 1}, {Key: "version", Value: -1}},
+			Options: options.Index().SetName(onsenLogRevisionVersionIndex).SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は新しい版を作成します
+func (r *MongoOnsenLogRevisionRepository) Create(ctx context.Context, revision *entity.OnsenLogRevision) error {
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, revision)
+	if err != nil {
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		revision.ID = oid
+	}
+
+	return nil
+}
+
+// FindByOnsenLogID は温泉メモの版を新しい順に検索します
+func (r *MongoOnsenLogRevisionRepository) FindByOnsenLogID(ctx context.Context, onsenLogID string) ([]*entity.OnsenLogRevision, error) {
+	// ソート条件を作成（版番号の降順）
+	opts := options.Find().SetSort(bson.D{{Key: "version", Value: -1}})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, bson.M{"onsen_log_id": onsenLogID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var revisions []*entity.OnsenLogRevision
+	if err := cursor.All(ctx, &revisions); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// FindLatestByOnsenLogID は温泉メモの最新の版を検索します（版がない場合はnilを返します）
+func (r *MongoOnsenLogRevisionRepository) FindLatestByOnsenLogID(ctx context.Context, onsenLogID string) (*entity.OnsenLogRevision, error) {
+	var revision entity.OnsenLogRevision
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
+	err := r.collection.FindOne(ctx, bson.M{"onsen_log_id": onsenLogID}, opts).Decode(&revision)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &revision, nil
+}
+
+// DeleteByOnsenLogID は温泉メモの版をすべて削除します
+func (r *MongoOnsenLogRevisionRepository) DeleteByOnsenLogID(ctx context.Context, onsenLogID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"onsen_log_id": onsenLogID})
+	return err
+}
@@ -0,0 +1,259 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// AggregateStats は条件に一致する温泉メモの統計を集計します
+// 並び順とページネーションは使用しません
+func (r *MongoOnsenLogRepository) AggregateStats(ctx context.Context, userID string, filter repository.OnsenLogFilter) (*entity.OnsenLogStats, error) {
+	// タイムアウト設定
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 絞り込み条件（キーワードが指定された場合は一致率で絞り込む）
+	query := buildOnsenLogFilterQuery(userID, filter)
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: query}}}
+	if variants := entity.SearchQueryVariants(filter.Query); len(variants) > 0 {
+		pipeline = keywordMatchStages(query, variants)
+	}
+
+	// 総合評価がある温泉メモのみを対象とする条件（0は未評価）
+	rated := bson.D{{Key: "$match", Value: bson.M{"rating": bson.M{"$gt": 0}}}}
+	countDesc := bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}}
+
+	pipeline = append(pipeline, bson.D{{Key: "$facet", Value: bson.M{
+		"summary": mongo.Pipeline{
+			{{Key: "$group", Value: bson.M{
+				"_id":         nil,
+				"total_count": bson.M{"$sum": 1},
+				// 未評価の温泉メモは平均に含めない（$avgはnullを無視する）
+				"average_rating": bson.M{"$avg": bson.M{"$cond": bson.A{
+					bson.M{"$gt": bson.A{"$rating", 0}}, "$rating", nil,
+				}}},
+				"first_visit": bson.M{"$min": "$visit_date"},
+				"last_visit":  bson.M{"$max": "$visit_date"},
+			}}},
+		},
+		"by_spring_type": mongo.Pipeline{
+			{{Key: "$unwind", Value: "$spring_types"}},
+			{{Key: "$group", Value: bson.M{"_id": "$spring_types", "count": bson.M{"$sum": 1}}}},
+			countDesc,
+		},
+		"by_prefecture": mongo.Pipeline{
+			{{Key: "$group", Value: bson.M{
+				"_id": bson.M{"$cond": bson.A{
+					bson.M{"$gt": bson.A{"$area.prefecture", ""}}, "$area.prefecture", entity.UnknownPrefecture,
+				}},
+				"count": bson.M{"$sum": 1},
+			}}},
+			countDesc,
+		},
+		"by_month": mongo.Pipeline{
+			{{Key: "$group", Value: bson.M{
+				"_id":   bson.M{"$dateToString": bson.M{"format": "%Y-%m", "date": "$visit_date"}},
+				"count": bson.M{"$sum": 1},
+			}}},
+			{{Key: "$sort", Value: bson.M{"_id": 1}}},
+		},
+		"by_year": mongo.Pipeline{
+			{{Key: "$group", Value: bson.M{
+				"_id":   bson.M{"$dateToString": bson.M{"format": "%Y", "date": "$visit_date"}},
+				"count": bson.M{"$sum": 1},
+			}}},
+			{{Key: "$sort", Value: bson.M{"_id": 1}}},
+		},
+		"rating_distribution": mongo.Pipeline{
+			rated,
+			// $roundは偶数丸めのため、0.5を足して切り捨てることで四捨五入する
+			{{Key: "$group", Value: bson.M{
+				"_id":   bson.M{"$toInt": bson.M{"$floor": bson.M{"$add": bson.A{"$rating", 0.5}}}},
+				"count": bson.M{"$sum": 1},
+			}}},
+			{{Key: "$sort", Value: bson.M{"_id": 1}}},
+		},
+		"rating_by_feature": mongo.Pipeline{
+			rated,
+			{{Key: "$unwind", Value: "$features"}},
+			{{Key: "$group", Value: bson.M{
+				"_id":            "$features",
+				"count":          bson.M{"$sum": 1},
+				"average_rating": bson.M{"$avg": "$rating"},
+			}}},
+			{{Key: "$sort", Value: bson.D{{Key: "average_rating", Value: -1}, {Key: "count", Value: -1}}}},
+		},
+		"spending": mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{"visit.currency": bson.M{"$nin": bson.A{nil, ""}}}}},
+			{{Key: "$group", Value: bson.M{
+				"_id":         "$visit.currency",
+				"total":       bson.M{"$sum": "$visit.total_cost"},
+				"visit_count": bson.M{"$sum": 1},
+			}}},
+			{{Key: "$sort", Value: bson.D{{Key: "visit_count", Value: -1}, {Key: "_id", Value: 1}}}},
+		},
+		"top_facilities": mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{"onsen_id": bson.M{"$nin": bson.A{nil, ""}}}}},
+			{{Key: "$sort", Value: bson.M{"visit_date": -1}}},
+			{{Key: "$group", Value: bson.M{
+				"_id":         "$onsen_id",
+				"name":        bson.M{"$first": "$name"},
+				"visit_count": bson.M{"$sum": 1},
+				"last_visit":  bson.M{"$first": "$visit_date"},
+			}}},
+			{{Key: "$sort", Value: bson.D{{Key: "visit_count", Value: -1}, {Key: "last_visit", Value: -1}}}},
+			{{Key: "$limit", Value: entity.MaxTopFacilities}},
+		},
+	}}})
+
+	// パイプラインを実行
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果構造体
+	var results []struct {
+		Summary []struct {
+			TotalCount    int        `bson:"total_count"`
+			AverageRating *float64   `bson:"average_rating"`
+			FirstVisit    *time.Time `bson:"first_visit"`
+			LastVisit     *time.Time `bson:"last_visit"`
+		} `bson:"summary"`
+		entity.OnsenLogStats `bson:",inline"`
+	}
+
+	// 結果を取得
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	stats := &entity.OnsenLogStats{}
+	if len(results) > 0 {
+		*stats = results[0].OnsenLogStats
+		if len(results[0].Summary) > 0 {
+			summary := results[0].Summary[0]
+			stats.TotalCount = summary.TotalCount
+			stats.FirstVisit = summary.FirstVisit
+			stats.LastVisit = summary.LastVisit
+			if summary.AverageRating != nil {
+				stats.AverageRating = *summary.AverageRating
+			}
+		}
+	}
+	stats.RoundAverages()
+
+	return stats, nil
+}
+
+// AggregateAchievementCounters はユーザーの温泉メモから実績の判定に使う集計値を計算します
+// 温泉メモを読み込まずに、件数・泉質と特徴ごとの件数・都道府県・訪問月・温泉名と所在地の組み合わせを集計します
+func (r *MongoOnsenLogRepository) AggregateAchievementCounters(ctx context.Context, userID string) (*entity.AchievementCounters, error) {
+	// タイムアウト設定
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: notDeleted(bson.M{"user_id": userID})}},
+		{{Key: "$facet", Value: bson.M{
+			"summary": mongo.Pipeline{
+				{{Key: "$group", Value: bson.M{"_id": nil, "visit_count": bson.M{"$sum": 1}}}},
+			},
+			"spring_type_counts": distinctElementCountStages("spring_types"),
+			"feature_counts":     distinctElementCountStages("features"),
+			"prefecture_codes": mongo.Pipeline{
+				{{Key: "$match", Value: bson.M{"area.prefecture_code": bson.M{"$nin": bson.A{nil, ""}}}}},
+				{{Key: "$group", Value: bson.M{"_id": "$area.prefecture_code"}}},
+			},
+			"visit_months": mongo.Pipeline{
+				{{Key: "$group", Value: bson.M{"_id": bson.M{"$month": "$visit_date"}}}},
+			},
+			"places": mongo.Pipeline{
+				{{Key: "$group", Value: bson.M{"_id": bson.M{
+					"name":            "$name",
+					"location":        "$location",
+					"prefecture_code": "$area.prefecture_code",
+				}}}},
+			},
+		}}},
+	}
+
+	// パイプラインを実行
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果構造体
+	var results []struct {
+		Summary []struct {
+			VisitCount int `bson:"visit_count"`
+		} `bson:"summary"`
+		SpringTypeCounts []entity.StatsCount `bson:"spring_type_counts"`
+		FeatureCounts    []entity.StatsCount `bson:"feature_counts"`
+		PrefectureCodes  []struct {
+			Code string `bson:"_id"`
+		} `bson:"prefecture_codes"`
+		VisitMonths []struct {
+			Month int `bson:"_id"`
+		} `bson:"visit_months"`
+		Places []struct {
+			Place entity.AchievementPlace `bson:"_id"`
+		} `bson:"places"`
+	}
+
+	// 結果を取得
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	counters := &entity.AchievementCounters{
+		SpringTypeCounts: make(map[entity.SpringType]int),
+		FeatureCounts:    make(map[entity.Feature]int),
+	}
+	if len(results) == 0 {
+		return counters, nil
+	}
+	result := results[0]
+	if len(result.Summary) > 0 {
+		counters.VisitCount = result.Summary[0].VisitCount
+	}
+	for _, count := range result.SpringTypeCounts {
+		counters.SpringTypeCounts[entity.SpringType(count.Key)] = count.Count
+	}
+	for _, count := range result.FeatureCounts {
+		counters.FeatureCounts[entity.Feature(count.Key)] = count.Count
+	}
+	for _, prefecture := range result.PrefectureCodes {
+		counters.PrefectureCodes = append(counters.PrefectureCodes, prefecture.Code)
+	}
+	for _, month := range result.VisitMonths {
+		counters.VisitMonths = append(counters.VisitMonths, time.Month(month.Month))
+	}
+	for _, place := range result.Places {
+		counters.Places = append(counters.Places, place.Place)
+	}
+
+	return counters, nil
+}
+
+// distinctElementCountStages は配列フィールドの要素ごとに、その要素を含む温泉メモの件数を集計するステージです
+// 1件の温泉メモで同じ要素を重複して数えないように、配列の重複を除いてから展開します（空文字は数えません）
+func distinctElementCountStages(field string) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$project", Value: bson.M{
+			field: bson.M{"$setUnion": bson.A{bson.M{"$ifNull": bson.A{"$" + field, bson.A{}}}, bson.A{}}},
+		}}},
+		{{Key: "$unwind", Value: "$" + field}},
+		{{Key: "$match", Value: bson.M{field: bson.M{"$ne": ""}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}}},
+	}
+}
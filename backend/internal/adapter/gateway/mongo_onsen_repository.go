@@ -0,0 +1,187 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoOnsenRepository はMongoDBを使用した温泉施設リポジトリの実装です
+type MongoOnsenRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	onsensCollection   = "onsens"
+	userOnsenKeyIndex  = "user_normalized_key_idx"
+	onsenUserNameIndex = "user_name_idx"
+)
+
+// NewMongoOnsenRepository は新しいMongoDBの温泉施設リポジトリを作成します
+func NewMongoOnsenRepository(db *mongo.Database) *MongoOnsenRepository {
+	repo := &MongoOnsenRepository{
+		collection: db.Collection(onsensCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoOnsenRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// ユーザーごとに照合キーは一意
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "normalized_key", Value: 1}},
+			Options: options.Index().SetName(userOnsenKeyIndex).SetUnique(true),
+		},
+		// ユーザーID+温泉名の複合インデックス（一覧表示用）
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "name", Value: 1}},
+			Options: options.Index().SetName(onsenUserNameIndex),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は新しい温泉施設を作成します
+func (r *MongoOnsenRepository) Create(ctx context.Context, onsen *entity.Onsen) error {
+	// ドキュメントを作成
+	now := time.Now()
+	onsen.CreatedAt = now
+	onsen.UpdatedAt = now
+
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, onsen)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("同じ名前と所在地の温泉施設が既に登録されています")
+		}
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		onsen.ID = oid
+	}
+
+	return nil
+}
+
+// FindByID はIDで温泉施設を検索します
+func (r *MongoOnsenRepository) FindByID(ctx context.Context, id string) (*entity.Onsen, error) {
+	var onsen entity.Onsen
+
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで検索
+		err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&onsen)
+		if err == nil {
+			return &onsen, nil
+		}
+	}
+
+	// UUIDで検索
+	err = r.collection.FindOne(ctx, bson.M{"uuid": id}).Decode(&onsen)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("温泉施設が見つかりません")
+		}
+		return nil, err
+	}
+
+	return &onsen, nil
+}
+
+// FindByUserID はユーザーIDに紐づく温泉施設を検索します
+func (r *MongoOnsenRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.Onsen, error) {
+	// ソート条件を作成（温泉名の昇順）
+	opts := options.Find().SetSort(bson.M{"name": 1})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var onsens []*entity.Onsen
+	if err := cursor.All(ctx, &onsens); err != nil {
+		return nil, err
+	}
+
+	return onsens, nil
+}
+
+// FindByUserIDAndKey はユーザーIDと照合キーで温泉施設を検索します（見つからない場合はnilを返します）
+func (r *MongoOnsenRepository) FindByUserIDAndKey(ctx context.Context, userID, normalizedKey string) (*entity.Onsen, error) {
+	var onsen entity.Onsen
+
+	filter := bson.M{"user_id": userID, "normalized_key": normalizedKey}
+	err := r.collection.FindOne(ctx, filter).Decode(&onsen)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &onsen, nil
+}
+
+// Update は温泉施設を更新します
+func (r *MongoOnsenRepository) Update(ctx context.Context, onsen *entity.Onsen) error {
+	onsen.UpdatedAt = time.Now()
+
+	// MongoDBを更新
+	filter := bson.M{"_id": onsen.ID}
+	update := bson.M{"$set": onsen}
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New("同じ名前と所在地の温泉施設が既に登録されています")
+	}
+	return err
+}
+
+// Delete は温泉施設を削除します
+func (r *MongoOnsenRepository) Delete(ctx context.Context, id string) error {
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで削除
+		result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+		if err == nil && result.DeletedCount > 0 {
+			return nil
+		}
+	}
+
+	// UUIDで削除
+	result, err := r.collection.DeleteOne(ctx, bson.M{"uuid": id})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("温泉施設が見つかりません")
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoShareLinkRepository はMongoDBを使用した共有リンクリポジトリの実装です
+type MongoShareLinkRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	shareLinksCollection = "share_links"
+	shareLinkTokenIndex  = "token_idx"
+	shareLinkUserIndex   = "user_created_at_idx"
+)
+
+// NewMongoShareLinkRepository は新しいMongoDBの共有リンクリポジトリを作成します
+func NewMongoShareLinkRepository(db *mongo.Database) *MongoShareLinkRepository {
+	repo := &MongoShareLinkRepository{
+		collection: db.Collection(shareLinksCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoShareLinkRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// 共有トークンのユニークインデックス（公開閲覧用）
+		{
+			Keys:    bson.D{{Key: "token", Value: 1}},
+			Options: options.Index().SetName(shareLinkTokenIndex).SetUnique(true),
+		},
+		// ユーザーID+作成日時の複合インデックス（一覧表示用）
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName(shareLinkUserIndex),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は新しい共有リンクを作成します
+func (r *MongoShareLinkRepository) Create(ctx context.Context, shareLink *entity.ShareLink) error {
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, shareLink)
+	if err != nil {
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		shareLink.ID = oid
+	}
+
+	return nil
+}
+
+// FindByID はIDで共有リンクを検索します
+func (r *MongoShareLinkRepository) FindByID(ctx context.Context, id string) (*entity.ShareLink, error) {
+	var shareLink entity.ShareLink
+
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで検索
+		err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&shareLink)
+		if err == nil {
+			return &shareLink, nil
+		}
+	}
+
+	// UUIDで検索
+	err = r.collection.FindOne(ctx, bson.M{"uuid": id}).Decode(&shareLink)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("共有リンクが見つかりません")
+		}
+		return nil, err
+	}
+
+	return &shareLink, nil
+}
+
+// FindByToken は共有トークンで共有リンクを検索します
+func (r *MongoShareLinkRepository) FindByToken(ctx context.Context, token string) (*entity.ShareLink, error) {
+	var shareLink entity.ShareLink
+
+	err := r.collection.FindOne(ctx, bson.M{"token": token}).Decode(&shareLink)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("共有リンクが見つかりません")
+		}
+		return nil, err
+	}
+
+	return &shareLink, nil
+}
+
+// FindActiveByUserID はユーザーIDに紐づく有効な共有リンクを作成日時の新しい順に検索します
+func (r *MongoShareLinkRepository) FindActiveByUserID(ctx context.Context, userID string, now time.Time) ([]*entity.ShareLink, error) {
+	// 検索条件を作成（取り消されておらず、無期限または有効期限内のもの）
+	filter := bson.M{
+		"user_id":    userID,
+		"revoked_at": nil,
+		"$or": []bson.M{
+			{"expires_at": nil},
+			{"expires_at": bson.M{"$gt": now}},
+		},
+	}
+
+	// ソート条件を作成（作成日時の降順）
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var shareLinks []*entity.ShareLink
+	if err := cursor.All(ctx, &shareLinks); err != nil {
+		return nil, err
+	}
+
+	return shareLinks, nil
+}
+
+// Update は共有リンクを更新します
+func (r *MongoShareLinkRepository) Update(ctx context.Context, shareLink *entity.ShareLink) error {
+	// MongoDBを更新
+	filter := bson.M{"_id": shareLink.ID}
+	update := bson.M{"$set": shareLink}
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
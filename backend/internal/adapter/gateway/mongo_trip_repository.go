@@ -0,0 +1,162 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoTripRepository はMongoDBを使用した旅行リポジトリの実装です
+type MongoTripRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	tripsCollection = "trips"
+	tripUserIndex   = "user_start_date_idx"
+)
+
+// NewMongoTripRepository は新しいMongoDBの旅行リポジトリを作成します
+func NewMongoTripRepository(db *mongo.Database) *MongoTripRepository {
+	repo := &MongoTripRepository{
+		collection: db.Collection(tripsCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoTripRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// ユーザーID+開始日の複合インデックス（一覧表示用）
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "start_date", Value: -1}},
+			Options: options.Index().SetName(tripUserIndex),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は新しい旅行を作成します
+func (r *MongoTripRepository) Create(ctx context.Context, trip *entity.Trip) error {
+	// ドキュメントを作成
+	now := time.Now()
+	trip.CreatedAt = now
+	trip.UpdatedAt = now
+
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, trip)
+	if err != nil {
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		trip.ID = oid
+	}
+
+	return nil
+}
+
+// FindByID はIDで旅行を検索します
+func (r *MongoTripRepository) FindByID(ctx context.Context, id string) (*entity.Trip, error) {
+	var trip entity.Trip
+
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで検索
+		err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&trip)
+		if err == nil {
+			return &trip, nil
+		}
+	}
+
+	// UUIDで検索
+	err = r.collection.FindOne(ctx, bson.M{"uuid": id}).Decode(&trip)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("旅行が見つかりません")
+		}
+		return nil, err
+	}
+
+	return &trip, nil
+}
+
+// FindByUserID はユーザーIDに紐づく旅行を開始日の新しい順に検索します
+func (r *MongoTripRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.Trip, error) {
+	// 検索条件を作成
+	filter := bson.M{"user_id": userID}
+
+	// ソート条件を作成（開始日の降順、作成日時の降順）
+	opts := options.Find().SetSort(bson.D{{Key: "start_date", Value: -1}, {Key: "created_at", Value: -1}})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var trips []*entity.Trip
+	if err := cursor.All(ctx, &trips); err != nil {
+		return nil, err
+	}
+
+	return trips, nil
+}
+
+// Update は旅行を更新します
+func (r *MongoTripRepository) Update(ctx context.Context, trip *entity.Trip) error {
+	trip.UpdatedAt = time.Now()
+
+	// MongoDBを更新
+	filter := bson.M{"_id": trip.ID}
+	update := bson.M{"$set": trip}
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// Delete は旅行を削除します
+func (r *MongoTripRepository) Delete(ctx context.Context, id string) error {
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで削除
+		_, err = r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+		if err == nil {
+			return nil
+		}
+	}
+
+	// UUIDで削除
+	result, err := r.collection.DeleteOne(ctx, bson.M{"uuid": id})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("旅行が見つかりません")
+	}
+
+	return nil
+}
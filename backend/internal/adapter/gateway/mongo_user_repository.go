@@ -76,6 +76,28 @@ func (r *MongoUserRepository) FindByID(ctx context.Context, id string) (*entity.
 	return &user, nil
 }
 
+// FindByIDs はIDに一致するユーザーを検索します（見つからないIDは結果に含めません）
+func (r *MongoUserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	if len(ids) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, bson.M{"uuid": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var users []*entity.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
 // FindByEmail はメールアドレスでユーザーを検索します
 func (r *MongoUserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
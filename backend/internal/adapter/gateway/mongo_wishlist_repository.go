@@ -0,0 +1,172 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoWishlistRepository はMongoDBを使用した行きたい温泉リポジトリの実装です
+type MongoWishlistRepository struct {
+	collection *mongo.Collection
+}
+
+// コレクション名とインデックス名
+const (
+	wishlistItemsCollection = "wishlist_items"
+	wishlistUserIndex       = "user_priority_idx"
+)
+
+// NewMongoWishlistRepository は新しいMongoDBの行きたい温泉リポジトリを作成します
+func NewMongoWishlistRepository(db *mongo.Database) *MongoWishlistRepository {
+	repo := &MongoWishlistRepository{
+		collection: db.Collection(wishlistItemsCollection),
+	}
+
+	// 必要なインデックスを初期化
+	go repo.ensureIndexes(context.Background())
+
+	return repo
+}
+
+// ensureIndexes は必要なインデックスを設定します
+func (r *MongoWishlistRepository) ensureIndexes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	indexes := []mongo.IndexModel{
+		// ユーザーID+優先度の複合インデックス（一覧表示用）
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "priority", Value: -1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName(wishlistUserIndex),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+}
+
+// Create は新しい行きたい温泉を作成します
+func (r *MongoWishlistRepository) Create(ctx context.Context, item *entity.WishlistItem) error {
+	// ドキュメントを作成
+	now := time.Now()
+	item.CreatedAt = now
+	item.UpdatedAt = now
+
+	// MongoDBに保存
+	result, err := r.collection.InsertOne(ctx, item)
+	if err != nil {
+		return err
+	}
+
+	// 生成されたIDを設定
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		item.ID = oid
+	}
+
+	return nil
+}
+
+// FindByID はIDで行きたい温泉を検索します
+func (r *MongoWishlistRepository) FindByID(ctx context.Context, id string) (*entity.WishlistItem, error) {
+	var item entity.WishlistItem
+
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで検索
+		err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&item)
+		if err == nil {
+			return &item, nil
+		}
+	}
+
+	// UUIDで検索
+	err = r.collection.FindOne(ctx, bson.M{"uuid": id}).Decode(&item)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("行きたい温泉が見つかりません")
+		}
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// FindByUserID はユーザーIDと条件に紐づく行きたい温泉を優先度の高い順に検索します
+func (r *MongoWishlistRepository) FindByUserID(ctx context.Context, userID string, filter repository.WishlistFilter) ([]*entity.WishlistItem, error) {
+	// 検索条件を作成
+	query := bson.M{"user_id": userID}
+	switch filter.Status {
+	case entity.WishlistStatusPending:
+		query["onsen_log_id"] = bson.M{"$in": bson.A{nil, ""}}
+	case entity.WishlistStatusVisited:
+		query["onsen_log_id"] = bson.M{"$nin": bson.A{nil, ""}}
+	}
+	if filter.TargetSeason != "" {
+		query["target_season"] = bson.M{"$in": bson.A{filter.TargetSeason, ""}}
+	}
+
+	// ソート条件を作成（優先度の降順、作成日時の降順）
+	opts := options.Find().SetSort(bson.D{{Key: "priority", Value: -1}, {Key: "created_at", Value: -1}})
+
+	// 検索を実行
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 結果を取得
+	var items []*entity.WishlistItem
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// Update は行きたい温泉を更新します
+func (r *MongoWishlistRepository) Update(ctx context.Context, item *entity.WishlistItem) error {
+	item.UpdatedAt = time.Now()
+
+	// MongoDBを更新
+	filter := bson.M{"_id": item.ID}
+	update := bson.M{"$set": item}
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// Delete は行きたい温泉を削除します
+func (r *MongoWishlistRepository) Delete(ctx context.Context, id string) error {
+	// IDがObjectIDの場合
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err == nil {
+		// ObjectIDで削除
+		_, err = r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+		if err == nil {
+			return nil
+		}
+	}
+
+	// UUIDで削除
+	result, err := r.collection.DeleteOne(ctx, bson.M{"uuid": id})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("行きたい温泉が見つかりません")
+	}
+
+	return nil
+}
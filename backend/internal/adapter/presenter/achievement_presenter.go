@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// AchievementPresenter は実績関連のレスポンスを整形するプレゼンターです
+type AchievementPresenter struct{}
+
+// NewAchievementPresenter は新しいAchievementPresenterインスタンスを作成します
+func NewAchievementPresenter() port.AchievementPresenterPort {
+	return &AchievementPresenter{}
+}
+
+// PresentAchievements は実績の達成状況のレスポンスを整形します
+func (p *AchievementPresenter) PresentAchievements(data port.AchievementsOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"earned":       data.Earned,
+		"in_progress":  data.InProgress,
+		"earned_count": data.EarnedCount,
+		"total_count":  data.TotalCount,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *AchievementPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// CalendarFeedPresenter はカレンダーフィード関連のレスポンスを整形するプレゼンターです
+type CalendarFeedPresenter struct{}
+
+// NewCalendarFeedPresenter は新しいCalendarFeedPresenterインスタンスを作成します
+func NewCalendarFeedPresenter() port.CalendarFeedPresenterPort {
+	return &CalendarFeedPresenter{}
+}
+
+// PresentCalendarFeed はカレンダーフィードのレスポンスを整形します
+func (p *CalendarFeedPresenter) PresentCalendarFeed(data port.CalendarFeedOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"calendar_feed": data,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *CalendarFeedPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// ExportJobPresenter はエクスポートジョブ関連のレスポンスを整形するプレゼンターです
+type ExportJobPresenter struct{}
+
+// NewExportJobPresenter は新しいExportJobPresenterインスタンスを作成します
+func NewExportJobPresenter() port.ExportJobPresenterPort {
+	return &ExportJobPresenter{}
+}
+
+// PresentExportJob はエクスポートジョブのレスポンスを整形します
+func (p *ExportJobPresenter) PresentExportJob(data port.ExportJobOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"export": data,
+	}
+}
+
+// PresentExportJobs はエクスポートジョブのリストレスポンスを整形します
+func (p *ExportJobPresenter) PresentExportJobs(data []port.ExportJobOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"exports": data,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *ExportJobPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
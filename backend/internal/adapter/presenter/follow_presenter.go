@@ -0,0 +1,36 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// FollowPresenter はフォローとフィード関連のレスポンスを整形するプレゼンターです
+type FollowPresenter struct{}
+
+// NewFollowPresenter は新しいFollowPresenterインスタンスを作成します
+func NewFollowPresenter() port.FollowPresenterPort {
+	return &FollowPresenter{}
+}
+
+// PresentFollowUsers はフォロー中・フォロワーのユーザーのリストレスポンスを整形します
+func (p *FollowPresenter) PresentFollowUsers(data []port.FollowUserOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"users": data,
+	}
+}
+
+// PresentFeed はフィードのレスポンスを整形します
+func (p *FollowPresenter) PresentFeed(data port.FeedOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"items":       data.Items,
+		"next_cursor": data.NextCursor,
+		"has_more":    data.HasMore,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *FollowPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
@@ -69,17 +69,25 @@ func (p *OnsenLogPresenter) PresentError(err error) map[string]interface{} {
 // formatOnsenLog は温泉メモエンティティをレスポンス用のマップに変換します
 func formatOnsenLog(onsenLog *entity.OnsenLog) map[string]interface{} {
 	return map[string]interface{}{
-		"id":          onsenLog.ID.Hex(),
-		"uuid":        onsenLog.UUID,
-		"user_id":     onsenLog.UserID,
-		"name":        onsenLog.Name,
-		"location":    onsenLog.Location,
-		"spring_type": onsenLog.SpringType,
-		"features":    onsenLog.Features,
-		"visit_date":  onsenLog.VisitDate,
-		"rating":      onsenLog.Rating,
-		"comment":     onsenLog.Comment,
-		"created_at":  onsenLog.CreatedAt,
-		"updated_at":  onsenLog.UpdatedAt,
+		"id":                onsenLog.ID.Hex(),
+		"uuid":              onsenLog.UUID,
+		"user_id":           onsenLog.UserID,
+		"onsen_id":          onsenLog.OnsenID,
+		"name":              onsenLog.Name,
+		"location":          onsenLog.Location,
+		"area":              onsenLog.Area,
+		"coordinates":       onsenLog.Coordinates,
+		"spring_types":      onsenLog.SpringTypes,
+		"water_analysis":    onsenLog.WaterAnalysis,
+		"features":          onsenLog.Features,
+		"tags":              onsenLog.Tags,
+		"visit_date":        onsenLog.VisitDate,
+		"visit":             onsenLog.Visit,
+		"rating":            onsenLog.Rating,
+		"rating_scores":     onsenLog.RatingScores,
+		"rating_overridden": onsenLog.RatingOverridden,
+		"comment":           onsenLog.Comment,
+		"created_at":        onsenLog.CreatedAt,
+		"updated_at":        onsenLog.UpdatedAt,
 	}
 }
@@ -0,0 +1,36 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// OnsenLogRevisionPresenter は温泉メモの変更履歴関連のレスポンスを整形するプレゼンターです
+type OnsenLogRevisionPresenter struct{}
+
+// NewOnsenLogRevisionPresenter は新しいOnsenLogRevisionPresenterインスタンスを作成します
+func NewOnsenLogRevisionPresenter() port.OnsenLogRevisionPresenterPort {
+	return &OnsenLogRevisionPresenter{}
+}
+
+// PresentRevisions は温泉メモの版のリストレスポンスを整形します
+func (p *OnsenLogRevisionPresenter) PresentRevisions(data []port.OnsenLogRevisionOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"revisions": data,
+	}
+}
+
+// PresentRevisionDiff は温泉メモの版の比較結果のレスポンスを整形します
+func (p *OnsenLogRevisionPresenter) PresentRevisionDiff(data port.OnsenLogRevisionDiffOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"from":    data.From,
+		"to":      data.To,
+		"changes": data.Changes,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *OnsenLogRevisionPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// OnsenPresenter は温泉施設関連のレスポンスを整形するプレゼンターです
+type OnsenPresenter struct{}
+
+// NewOnsenPresenter は新しいOnsenPresenterインスタンスを作成します
+func NewOnsenPresenter() port.OnsenPresenterPort {
+	return &OnsenPresenter{}
+}
+
+// PresentOnsen は単一の温泉施設レスポンスを整形します
+func (p *OnsenPresenter) PresentOnsen(onsen *entity.Onsen) map[string]interface{} {
+	return map[string]interface{}{
+		"onsen": onsen,
+	}
+}
+
+// PresentOnsens は複数の温泉施設レスポンスを整形します
+func (p *OnsenPresenter) PresentOnsens(onsens []*entity.Onsen) map[string]interface{} {
+	return map[string]interface{}{
+		"onsens": onsens,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *OnsenPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
@@ -64,8 +64,23 @@ func (a *OnsenLogOutputAdapter) PresentOnsenLogs(ctx context.Context, data port.
 	return nil
 }
 
-// PresentExportedData はエクスポートされたデータを表示します
-func (a *OnsenLogOutputAdapter) PresentExportedData(ctx context.Context, data []byte, format string) error {
+// PresentOnsenLogStats は温泉メモの統計を表示します
+func (a *OnsenLogOutputAdapter) PresentOnsenLogStats(ctx context.Context, data port.OnsenLogStatsOutputData) error {
+	return nil
+}
+
+// PresentNearbyOnsenLogs は周辺の温泉メモのリストを表示します
+func (a *OnsenLogOutputAdapter) PresentNearbyOnsenLogs(ctx context.Context, data []port.NearbyOnsenLogOutputData) error {
+	return nil
+}
+
+// PresentExportedData はエクスポートの結果（形式と件数）を表示します
+func (a *OnsenLogOutputAdapter) PresentExportedData(ctx context.Context, format string, count int) error {
+	return nil
+}
+
+// PresentImportResult はインポートの処理結果を表示します
+func (a *OnsenLogOutputAdapter) PresentImportResult(ctx context.Context, data port.ImportOnsenLogsOutputData) error {
 	return nil
 }
 
@@ -100,3 +115,337 @@ func (a *OnsenImageOutputAdapter) PresentImages(ctx context.Context, data []port
 func (a *OnsenImageOutputAdapter) PresentError(ctx context.Context, err error) error {
 	return nil
 }
+
+// OnsenOutputAdapter はOnsenPresenterをOnsenOutputPortに適応させるアダプターです
+type OnsenOutputAdapter struct {
+	Presenter port.OnsenPresenterPort
+}
+
+// NewOnsenOutputAdapter は新しいOnsenOutputAdapterインスタンスを作成します
+func NewOnsenOutputAdapter(presenter port.OnsenPresenterPort) port.OnsenOutputPort {
+	return &OnsenOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentOnsen は温泉施設を表示します
+func (a *OnsenOutputAdapter) PresentOnsen(ctx context.Context, data port.OnsenOutputData) error {
+	return nil
+}
+
+// PresentOnsens は温泉施設のリストを表示します
+func (a *OnsenOutputAdapter) PresentOnsens(ctx context.Context, data []port.OnsenOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *OnsenOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// TagOutputAdapter はTagPresenterをTagOutputPortに適応させるアダプターです
+type TagOutputAdapter struct {
+	Presenter port.TagPresenterPort
+}
+
+// NewTagOutputAdapter は新しいTagOutputAdapterインスタンスを作成します
+func NewTagOutputAdapter(presenter port.TagPresenterPort) port.TagOutputPort {
+	return &TagOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentTags はタグのリストを表示します
+func (a *TagOutputAdapter) PresentTags(ctx context.Context, data []port.TagOutputData) error {
+	return nil
+}
+
+// PresentTagUpdate はタグの更新結果を表示します
+func (a *TagOutputAdapter) PresentTagUpdate(ctx context.Context, data port.TagUpdateOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *TagOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// PrefectureOutputAdapter はPrefecturePresenterをPrefectureOutputPortに適応させるアダプターです
+type PrefectureOutputAdapter struct {
+	Presenter port.PrefecturePresenterPort
+}
+
+// NewPrefectureOutputAdapter は新しいPrefectureOutputAdapterインスタンスを作成します
+func NewPrefectureOutputAdapter(presenter port.PrefecturePresenterPort) port.PrefectureOutputPort {
+	return &PrefectureOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentPrefectures は都道府県のリストを表示します
+func (a *PrefectureOutputAdapter) PresentPrefectures(ctx context.Context, data []port.PrefectureOutputData) error {
+	return nil
+}
+
+// PresentCompletionMap は都道府県の制覇状況を表示します
+func (a *PrefectureOutputAdapter) PresentCompletionMap(ctx context.Context, data port.PrefectureCompletionOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *PrefectureOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// AchievementOutputAdapter はAchievementPresenterをAchievementOutputPortに適応させるアダプターです
+type AchievementOutputAdapter struct {
+	Presenter port.AchievementPresenterPort
+}
+
+// NewAchievementOutputAdapter は新しいAchievementOutputAdapterインスタンスを作成します
+func NewAchievementOutputAdapter(presenter port.AchievementPresenterPort) port.AchievementOutputPort {
+	return &AchievementOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentAchievements は実績の達成状況を表示します
+func (a *AchievementOutputAdapter) PresentAchievements(ctx context.Context, data port.AchievementsOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *AchievementOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// WishlistOutputAdapter はWishlistPresenterをWishlistOutputPortに適応させるアダプターです
+type WishlistOutputAdapter struct {
+	Presenter port.WishlistPresenterPort
+}
+
+// NewWishlistOutputAdapter は新しいWishlistOutputAdapterインスタンスを作成します
+func NewWishlistOutputAdapter(presenter port.WishlistPresenterPort) port.WishlistOutputPort {
+	return &WishlistOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentWishlistItem は行きたい温泉を表示します
+func (a *WishlistOutputAdapter) PresentWishlistItem(ctx context.Context, data port.WishlistItemOutputData) error {
+	return nil
+}
+
+// PresentWishlist は行きたい温泉のリストを表示します
+func (a *WishlistOutputAdapter) PresentWishlist(ctx context.Context, data []port.WishlistItemOutputData) error {
+	return nil
+}
+
+// PresentConversion は温泉メモへの変換結果を表示します
+func (a *WishlistOutputAdapter) PresentConversion(ctx context.Context, data port.ConvertWishlistItemOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *WishlistOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// TripOutputAdapter はTripPresenterをTripOutputPortに適応させるアダプターです
+type TripOutputAdapter struct {
+	Presenter port.TripPresenterPort
+}
+
+// NewTripOutputAdapter は新しいTripOutputAdapterインスタンスを作成します
+func NewTripOutputAdapter(presenter port.TripPresenterPort) port.TripOutputPort {
+	return &TripOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentTrip は旅行を表示します
+func (a *TripOutputAdapter) PresentTrip(ctx context.Context, data port.TripOutputData) error {
+	return nil
+}
+
+// PresentTrips は旅行のリストを表示します
+func (a *TripOutputAdapter) PresentTrips(ctx context.Context, data []port.TripOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *TripOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// ShareLinkOutputAdapter はShareLinkPresenterをShareLinkOutputPortに適応させるアダプターです
+type ShareLinkOutputAdapter struct {
+	Presenter port.ShareLinkPresenterPort
+}
+
+// NewShareLinkOutputAdapter は新しいShareLinkOutputAdapterインスタンスを作成します
+func NewShareLinkOutputAdapter(presenter port.ShareLinkPresenterPort) port.ShareLinkOutputPort {
+	return &ShareLinkOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentShareLink は共有リンクを表示します
+func (a *ShareLinkOutputAdapter) PresentShareLink(ctx context.Context, data port.ShareLinkOutputData) error {
+	return nil
+}
+
+// PresentShareLinks は共有リンクのリストを表示します
+func (a *ShareLinkOutputAdapter) PresentShareLinks(ctx context.Context, data []port.ShareLinkOutputData) error {
+	return nil
+}
+
+// PresentPublicOnsenLog は公開されている温泉メモを表示します
+func (a *ShareLinkOutputAdapter) PresentPublicOnsenLog(ctx context.Context, data port.PublicOnsenLogOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *ShareLinkOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// FollowOutputAdapter はFollowPresenterをFollowOutputPortに適応させるアダプターです
+type FollowOutputAdapter struct {
+	Presenter port.FollowPresenterPort
+}
+
+// NewFollowOutputAdapter は新しいFollowOutputAdapterインスタンスを作成します
+func NewFollowOutputAdapter(presenter port.FollowPresenterPort) port.FollowOutputPort {
+	return &FollowOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentFollowUser はフォローしたユーザーを表示します
+func (a *FollowOutputAdapter) PresentFollowUser(ctx context.Context, data port.FollowUserOutputData) error {
+	return nil
+}
+
+// PresentFollowUsers はフォロー中・フォロワーのユーザーのリストを表示します
+func (a *FollowOutputAdapter) PresentFollowUsers(ctx context.Context, data []port.FollowUserOutputData) error {
+	return nil
+}
+
+// PresentFeed はフィードを表示します
+func (a *FollowOutputAdapter) PresentFeed(ctx context.Context, data port.FeedOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *FollowOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// TrashOutputAdapter はTrashPresenterをTrashOutputPortに適応させるアダプターです
+type TrashOutputAdapter struct {
+	Presenter port.TrashPresenterPort
+}
+
+// NewTrashOutputAdapter は新しいTrashOutputAdapterインスタンスを作成します
+func NewTrashOutputAdapter(presenter port.TrashPresenterPort) port.TrashOutputPort {
+	return &TrashOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentTrash はゴミ箱の温泉メモと画像のリストを表示します
+func (a *TrashOutputAdapter) PresentTrash(ctx context.Context, data port.TrashOutputData) error {
+	return nil
+}
+
+// PresentRestoredOnsenLog は復元した温泉メモを表示します
+func (a *TrashOutputAdapter) PresentRestoredOnsenLog(ctx context.Context, data port.OnsenLogOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *TrashOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// OnsenLogRevisionOutputAdapter はOnsenLogRevisionPresenterをOnsenLogRevisionOutputPortに適応させるアダプターです
+type OnsenLogRevisionOutputAdapter struct {
+	Presenter port.OnsenLogRevisionPresenterPort
+}
+
+// NewOnsenLogRevisionOutputAdapter は新しいOnsenLogRevisionOutputAdapterインスタンスを作成します
+func NewOnsenLogRevisionOutputAdapter(presenter port.OnsenLogRevisionPresenterPort) port.OnsenLogRevisionOutputPort {
+	return &OnsenLogRevisionOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentRevisions は温泉メモの版のリストを表示します
+func (a *OnsenLogRevisionOutputAdapter) PresentRevisions(ctx context.Context, data []port.OnsenLogRevisionOutputData) error {
+	return nil
+}
+
+// PresentRevisionDiff は温泉メモの版の比較結果を表示します
+func (a *OnsenLogRevisionOutputAdapter) PresentRevisionDiff(ctx context.Context, data port.OnsenLogRevisionDiffOutputData) error {
+	return nil
+}
+
+// PresentRevertedOnsenLog は以前の版の状態に戻した温泉メモを表示します
+func (a *OnsenLogRevisionOutputAdapter) PresentRevertedOnsenLog(ctx context.Context, data port.OnsenLogOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *OnsenLogRevisionOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// ExportJobOutputAdapter はExportJobPresenterをExportJobOutputPortに適応させるアダプターです
+type ExportJobOutputAdapter struct {
+	Presenter port.ExportJobPresenterPort
+}
+
+// NewExportJobOutputAdapter は新しいExportJobOutputAdapterインスタンスを作成します
+func NewExportJobOutputAdapter(presenter port.ExportJobPresenterPort) port.ExportJobOutputPort {
+	return &ExportJobOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentExportJob はエクスポートジョブを表示します
+func (a *ExportJobOutputAdapter) PresentExportJob(ctx context.Context, data port.ExportJobOutputData) error {
+	return nil
+}
+
+// PresentExportJobs はエクスポートジョブのリストを表示します
+func (a *ExportJobOutputAdapter) PresentExportJobs(ctx context.Context, data []port.ExportJobOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *ExportJobOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
+
+// CalendarFeedOutputAdapter はCalendarFeedPresenterをCalendarFeedOutputPortに適応させるアダプターです
+type CalendarFeedOutputAdapter struct {
+	Presenter port.CalendarFeedPresenterPort
+}
+
+// NewCalendarFeedOutputAdapter は新しいCalendarFeedOutputAdapterインスタンスを作成します
+func NewCalendarFeedOutputAdapter(presenter port.CalendarFeedPresenterPort) port.CalendarFeedOutputPort {
+	return &CalendarFeedOutputAdapter{
+		Presenter: presenter,
+	}
+}
+
+// PresentCalendarFeed はカレンダーフィードを表示します
+func (a *CalendarFeedOutputAdapter) PresentCalendarFeed(ctx context.Context, data port.CalendarFeedOutputData) error {
+	return nil
+}
+
+// PresentError はエラーを表示します
+func (a *CalendarFeedOutputAdapter) PresentError(ctx context.Context, err error) error {
+	return nil
+}
@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// PrefecturePresenter は都道府県関連のレスポンスを整形するプレゼンターです
+type PrefecturePresenter struct{}
+
+// NewPrefecturePresenter は新しいPrefecturePresenterインスタンスを作成します
+func NewPrefecturePresenter() port.PrefecturePresenterPort {
+	return &PrefecturePresenter{}
+}
+
+// PresentPrefectures は都道府県のリストレスポンスを整形します
+func (p *PrefecturePresenter) PresentPrefectures(prefectures []entity.Prefecture) map[string]interface{} {
+	return map[string]interface{}{
+		"prefectures": prefectures,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *PrefecturePresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
@@ -0,0 +1,42 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// ShareLinkPresenter は共有リンク関連のレスポンスを整形するプレゼンターです
+type ShareLinkPresenter struct{}
+
+// NewShareLinkPresenter は新しいShareLinkPresenterインスタンスを作成します
+func NewShareLinkPresenter() port.ShareLinkPresenterPort {
+	return &ShareLinkPresenter{}
+}
+
+// PresentShareLink は共有リンクのレスポンスを整形します
+func (p *ShareLinkPresenter) PresentShareLink(shareLink *entity.ShareLink) map[string]interface{} {
+	return map[string]interface{}{
+		"share_link": shareLink,
+	}
+}
+
+// PresentShareLinks は共有リンクのリストレスポンスを整形します
+func (p *ShareLinkPresenter) PresentShareLinks(shareLinks []*entity.ShareLink) map[string]interface{} {
+	return map[string]interface{}{
+		"share_links": shareLinks,
+	}
+}
+
+// PresentPublicOnsenLog は公開されている温泉メモのレスポンスを整形します
+func (p *ShareLinkPresenter) PresentPublicOnsenLog(data port.PublicOnsenLogOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"onsen_log": data,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *ShareLinkPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
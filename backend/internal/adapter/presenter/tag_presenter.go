@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// TagPresenter はタグ関連のレスポンスを整形するプレゼンターです
+type TagPresenter struct{}
+
+// NewTagPresenter は新しいTagPresenterインスタンスを作成します
+func NewTagPresenter() port.TagPresenterPort {
+	return &TagPresenter{}
+}
+
+// PresentTags はタグのリストレスポンスを整形します
+func (p *TagPresenter) PresentTags(tags []*entity.TagUsage) map[string]interface{} {
+	return map[string]interface{}{
+		"tags": tags,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *TagPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
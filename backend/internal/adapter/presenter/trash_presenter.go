@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// TrashPresenter はゴミ箱関連のレスポンスを整形するプレゼンターです
+type TrashPresenter struct{}
+
+// NewTrashPresenter は新しいTrashPresenterインスタンスを作成します
+func NewTrashPresenter() port.TrashPresenterPort {
+	return &TrashPresenter{}
+}
+
+// PresentTrash はゴミ箱の温泉メモと画像のリストレスポンスを整形します
+func (p *TrashPresenter) PresentTrash(data port.TrashOutputData) map[string]interface{} {
+	return map[string]interface{}{
+		"onsen_logs":   data.OnsenLogs,
+		"onsen_images": data.OnsenImages,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *TrashPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// TripPresenter は旅行関連のレスポンスを整形するプレゼンターです
+type TripPresenter struct{}
+
+// NewTripPresenter は新しいTripPresenterインスタンスを作成します
+func NewTripPresenter() port.TripPresenterPort {
+	return &TripPresenter{}
+}
+
+// PresentTrip は旅行のレスポンスを整形します
+func (p *TripPresenter) PresentTrip(trip *entity.Trip) map[string]interface{} {
+	return map[string]interface{}{
+		"trip": trip,
+	}
+}
+
+// PresentTrips は旅行のリストレスポンスを整形します
+func (p *TripPresenter) PresentTrips(trips []*entity.Trip) map[string]interface{} {
+	return map[string]interface{}{
+		"trips": trips,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *TripPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
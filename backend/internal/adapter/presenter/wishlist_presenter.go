@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// WishlistPresenter は行きたい温泉関連のレスポンスを整形するプレゼンターです
+type WishlistPresenter struct{}
+
+// NewWishlistPresenter は新しいWishlistPresenterインスタンスを作成します
+func NewWishlistPresenter() port.WishlistPresenterPort {
+	return &WishlistPresenter{}
+}
+
+// PresentWishlistItem は行きたい温泉のレスポンスを整形します
+func (p *WishlistPresenter) PresentWishlistItem(item *entity.WishlistItem) map[string]interface{} {
+	return map[string]interface{}{
+		"wishlist_item": item,
+	}
+}
+
+// PresentWishlist は行きたい温泉のリストレスポンスを整形します
+func (p *WishlistPresenter) PresentWishlist(items []*entity.WishlistItem) map[string]interface{} {
+	return map[string]interface{}{
+		"wishlist_items": items,
+	}
+}
+
+// PresentError はエラーレスポンスを整形します
+func (p *WishlistPresenter) PresentError(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"error": err.Error(),
+	}
+}
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeText は比較用に文字列を正規化します
+// 全角英数字・半角カナをNFKCで統一し、英字を小文字にして空白と記号を取り除きます
+func NormalizeText(s string) string {
+	normalized := strings.ToLower(norm.NFKC.String(s))
+
+	var sb strings.Builder
+	for _, r := range normalized {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// NormalizeSearchText は検索用に文字列を正規化します
+// NormalizeTextの正規化に加えて、カタカナをひらがなに揃えます
+func NormalizeSearchText(s string) string {
+	return FoldKana(NormalizeText(s))
+}
+
+// FoldKana はカタカナをひらがなに変換します
+// 長音符や、地名で使われる「ヶ」「ヵ」はそのまま残します
+func FoldKana(s string) string {
+	return strings.Map(func(r rune) rune {
+		// ァ(U+30A1)〜ヴ(U+30F4)はひらがなと同じ並びで対応している
+		if r >= 'ァ' && r <= 'ヴ' {
+			return r - ('ァ' - 'ぁ')
+		}
+		return r
+	}, s)
+}
+
+// NGrams は文字列をn文字ずつのN-gramに分割します（重複は除きます）
+// 文字列がn文字未満の場合は文字列全体を1つの要素として返します
+func NGrams(s string, n int) []string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return nil
+	}
+	if len(runes) < n {
+		return []string{s}
+	}
+
+	seen := make(map[string]bool)
+	grams := make([]string, 0, len(runes)-n+1)
+	for i := 0; i+n <= len(runes); i++ {
+		gram := string(runes[i : i+n])
+		if !seen[gram] {
+			seen[gram] = true
+			grams = append(grams, gram)
+		}
+	}
+
+	return grams
+}
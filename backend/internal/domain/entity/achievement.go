@@ -0,0 +1,209 @@
+package entity
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/yuroku/internal/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// achievementDataset は実績（バッジ）の判定ルールのデータです
+//
+//go:embed data/achievements.json
+var achievementDataset []byte
+
+// AchievementRuleType は実績の判定方法を表す型です
+type AchievementRuleType string
+
+// 実績の判定方法の定数
+const (
+	// AchievementRuleVisitCount は温泉メモの件数で判定します
+	AchievementRuleVisitCount AchievementRuleType = "visit_count"
+	// AchievementRuleSpringTypeCount は指定した泉質の温泉メモの件数で判定します
+	AchievementRuleSpringTypeCount AchievementRuleType = "spring_type_count"
+	// AchievementRuleSpringTypeVariety は入浴した泉質の種類数で判定します
+	AchievementRuleSpringTypeVariety AchievementRuleType = "spring_type_variety"
+	// AchievementRuleFeatureCount は指定した特徴のある温泉メモの件数で判定します
+	AchievementRuleFeatureCount AchievementRuleType = "feature_count"
+	// AchievementRulePrefectureCount は入浴した都道府県の数で判定します
+	AchievementRulePrefectureCount AchievementRuleType = "prefecture_count"
+	// AchievementRuleSeasonCount は入浴した季節（春夏秋冬）の数で判定します
+	AchievementRuleSeasonCount AchievementRuleType = "season_count"
+	// AchievementRuleOnsenSet は指定した温泉地のうち入浴した数で判定します
+	AchievementRuleOnsenSet AchievementRuleType = "onsen_set"
+)
+
+// AchievementRule は実績の判定ルールです
+// Targetは達成に必要な数で、onsen_setでは省略すると温泉地の数になります
+type AchievementRule struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Type        AchievementRuleType `json:"type"`
+	Target      int                 `json:"target"`
+	SpringType  SpringType          `json:"spring_type,omitempty"`
+	Feature     Feature             `json:"feature,omitempty"`
+	Onsens      []AchievementOnsen  `json:"onsens,omitempty"`
+}
+
+// AchievementOnsen は実績の対象となる温泉地です
+// 温泉名または所在地にキーワードのいずれかを含む温泉メモを対象とし、
+// 都道府県コードを指定した場合は都道府県が判定できた温泉メモのうち一致するもののみを対象にします
+type AchievementOnsen struct {
+	Name           string   `json:"name"`
+	Keywords       []string `json:"keywords"`
+	PrefectureCode string   `json:"prefecture_code,omitempty"`
+}
+
+// achievementRules は埋め込みデータから読み込んだ実績の判定ルールです
+var achievementRules []AchievementRule
+
+func init() {
+	var data struct {
+		Achievements []AchievementRule `json:"achievements"`
+	}
+	if err := json.Unmarshal(achievementDataset, &data); err != nil {
+		panic("実績データの読み込みに失敗しました: " + err.Error())
+	}
+
+	seen := make(map[string]bool)
+	for i := range data.Achievements {
+		rule := &data.Achievements[i]
+		if rule.Type == AchievementRuleOnsenSet && rule.Target == 0 {
+			rule.Target = len(rule.Onsens)
+		}
+		if rule.ID == "" || seen[rule.ID] || rule.Target <= 0 || !rule.Type.isValid() {
+			panic("実績データが不正です: " + rule.ID)
+		}
+		seen[rule.ID] = true
+	}
+	achievementRules = data.Achievements
+}
+
+// isValid は判定方法が定義済みかどうかを返します
+func (t AchievementRuleType) isValid() bool {
+	switch t {
+	case AchievementRuleVisitCount, AchievementRuleSpringTypeCount, AchievementRuleSpringTypeVariety,
+		AchievementRuleFeatureCount, AchievementRulePrefectureCount, AchievementRuleSeasonCount, AchievementRuleOnsenSet:
+		return true
+	default:
+		return false
+	}
+}
+
+// AchievementRules は実績の判定ルールを定義順に返します
+func AchievementRules() []AchievementRule {
+	rules := make([]AchievementRule, len(achievementRules))
+	copy(rules, achievementRules)
+	return rules
+}
+
+// AchievementCounters は実績の判定に使う温泉メモの集計値です
+// 温泉地の実績はキーワードを正規化して判定するため、温泉名・所在地・都道府県コードの組み合わせを重複なしで保持します
+type AchievementCounters struct {
+	VisitCount       int
+	SpringTypeCounts map[SpringType]int
+	FeatureCounts    map[Feature]int
+	PrefectureCodes  []string
+	VisitMonths      []time.Month
+	Places           []AchievementPlace
+}
+
+// AchievementPlace は温泉地の実績の判定に使う温泉メモの温泉名・所在地です
+type AchievementPlace struct {
+	Name           string `bson:"name"`
+	Location       string `bson:"location"`
+	PrefectureCode string `bson:"prefecture_code"`
+}
+
+// Evaluate は温泉メモの集計値から実績の達成状況（現在の数）を計算します
+func (r AchievementRule) Evaluate(counters *AchievementCounters) int {
+	switch r.Type {
+	case AchievementRuleVisitCount:
+		return counters.VisitCount
+	case AchievementRuleSpringTypeCount:
+		return counters.SpringTypeCounts[r.SpringType]
+	case AchievementRuleSpringTypeVariety:
+		return len(counters.SpringTypeCounts)
+	case AchievementRuleFeatureCount:
+		return counters.FeatureCounts[r.Feature]
+	case AchievementRulePrefectureCount:
+		return len(counters.PrefectureCodes)
+	case AchievementRuleSeasonCount:
+		seasons := make(map[Season]bool)
+		for _, month := range counters.VisitMonths {
+			seasons[SeasonOfMonth(month)] = true
+		}
+		return len(seasons)
+	case AchievementRuleOnsenSet:
+		visited := 0
+		for _, onsen := range r.Onsens {
+			for _, place := range counters.Places {
+				if onsen.matches(place) {
+					visited++
+					break
+				}
+			}
+		}
+		return visited
+	default:
+		return 0
+	}
+}
+
+// matches は温泉メモの温泉名・所在地が対象の温泉地のものかどうかを返します
+func (o AchievementOnsen) matches(place AchievementPlace) bool {
+	if o.PrefectureCode != "" && place.PrefectureCode != "" && o.PrefectureCode != place.PrefectureCode {
+		return false
+	}
+	text := common.NormalizeSearchText(place.Name + place.Location)
+	for _, keyword := range o.Keywords {
+		if strings.Contains(text, common.NormalizeSearchText(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
+// UserAchievement はユーザーが獲得した実績です
+type UserAchievement struct {
+	ID            primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID          string             `json:"uuid" bson:"uuid"`
+	UserID        string             `json:"user_id" bson:"user_id"`
+	AchievementID string             `json:"achievement_id" bson:"achievement_id"`
+	UnlockedAt    time.Time          `json:"unlocked_at" bson:"unlocked_at"`
+}
+
+// NewUserAchievement は新しい獲得済みの実績を作成します
+func NewUserAchievement(userID, achievementID string, unlockedAt time.Time) *UserAchievement {
+	return &UserAchievement{
+		UUID:          uuid.New().String(),
+		UserID:        userID,
+		AchievementID: achievementID,
+		UnlockedAt:    unlockedAt,
+	}
+}
+
+// AchievementProgress は実績の達成状況です（UnlockedAtは獲得済みの場合のみ設定されます）
+type AchievementProgress struct {
+	Rule       AchievementRule
+	Current    int
+	UnlockedAt *time.Time
+}
+
+// Unlocked は実績を獲得済みかどうかを返します
+func (p AchievementProgress) Unlocked() bool {
+	return p.UnlockedAt != nil
+}
+
+// Percent は達成率（%、0〜100の整数）を返します
+func (p AchievementProgress) Percent() int {
+	if p.Unlocked() || p.Current >= p.Rule.Target {
+		return 100
+	}
+	return p.Current * 100 / p.Rule.Target
+}
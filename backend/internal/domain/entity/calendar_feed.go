@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CalendarFeedTokenBytes はカレンダーフィードのトークンの乱数のバイト数です
+const CalendarFeedTokenBytes = 32
+
+// CalendarFeed は温泉メモの訪問日と旅行の予定をカレンダーアプリに配信するiCalendarフィードを表すエンティティです
+// ユーザーごとに1件で、Tokenを知っていれば認証なしでフィードを購読できます
+// トークンを再発行すると以前のトークンのフィードURLは使用できなくなります
+type CalendarFeed struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID      string             `json:"uuid" bson:"uuid"`
+	UserID    string             `json:"user_id" bson:"user_id"`
+	Token     string             `json:"token" bson:"token"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// NewCalendarFeed は新しいカレンダーフィードエンティティを作成します
+func NewCalendarFeed(userID string) (*CalendarFeed, error) {
+	token, err := generateCalendarFeedToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &CalendarFeed{
+		UUID:      uuid.New().String(),
+		UserID:    userID,
+		Token:     token,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// RotateToken はカレンダーフィードのトークンを再発行します
+func (f *CalendarFeed) RotateToken() error {
+	token, err := generateCalendarFeedToken()
+	if err != nil {
+		return err
+	}
+
+	f.Token = token
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+// generateCalendarFeedToken はURLに含められる推測困難なカレンダーフィードのトークンを生成します
+func generateCalendarFeedToken() (string, error) {
+	b := make([]byte, CalendarFeedTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
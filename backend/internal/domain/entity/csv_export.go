@@ -0,0 +1,213 @@
+package entity
+
+import (
+	"strings"
+)
+
+// CSVColumn はCSVエクスポートの列を表す型です
+type CSVColumn string
+
+// CSVの列の定数（評価項目の列はRatingCriterionの値を使用します）
+const (
+	CSVColumnID              CSVColumn = "id"
+	CSVColumnName            CSVColumn = "name"
+	CSVColumnLocation        CSVColumn = "location"
+	CSVColumnPrefecture      CSVColumn = "prefecture"
+	CSVColumnMunicipality    CSVColumn = "municipality"
+	CSVColumnLatitude        CSVColumn = "latitude"
+	CSVColumnLongitude       CSVColumn = "longitude"
+	CSVColumnSpringTypes     CSVColumn = "spring_types"
+	CSVColumnFeatures        CSVColumn = "features"
+	CSVColumnTags            CSVColumn = "tags"
+	CSVColumnVisitDate       CSVColumn = "visit_date"
+	CSVColumnArrivalTime     CSVColumn = "arrival_time"
+	CSVColumnDurationMinutes CSVColumn = "duration_minutes"
+	CSVColumnStayType        CSVColumn = "stay_type"
+	CSVColumnCompanions      CSVColumn = "companions"
+	CSVColumnCurrency        CSVColumn = "currency"
+	CSVColumnEntranceFee     CSVColumn = "entrance_fee"
+	CSVColumnOtherCosts      CSVColumn = "other_costs"
+	CSVColumnTotalCost       CSVColumn = "total_cost"
+	CSVColumnRating          CSVColumn = "rating"
+	CSVColumnComment         CSVColumn = "comment"
+	CSVColumnVisibility      CSVColumn = "visibility"
+	CSVColumnCreatedAt       CSVColumn = "created_at"
+	CSVColumnUpdatedAt       CSVColumn = "updated_at"
+)
+
+// csvColumnLabels はCSVの列ごとの日本語と英語のヘッダーです
+var csvColumnLabels = map[CSVColumn][2]string{
+	CSVColumnID:                             {"ID", "ID"},
+	CSVColumnName:                           {"温泉名", "Name"},
+	CSVColumnLocation:                       {"所在地", "Location"},
+	CSVColumnPrefecture:                     {"都道府県", "Prefecture"},
+	CSVColumnMunicipality:                   {"市区町村", "Municipality"},
+	CSVColumnLatitude:                       {"緯度", "Latitude"},
+	CSVColumnLongitude:                      {"経度", "Longitude"},
+	CSVColumnSpringTypes:                    {"泉質", "Spring Types"},
+	CSVColumnFeatures:                       {"特徴", "Features"},
+	CSVColumnTags:                           {"タグ", "Tags"},
+	CSVColumnVisitDate:                      {"訪問日", "Visit Date"},
+	CSVColumnArrivalTime:                    {"到着時刻", "Arrival Time"},
+	CSVColumnDurationMinutes:                {"滞在時間（分）", "Duration (min)"},
+	CSVColumnStayType:                       {"日帰り・宿泊", "Stay Type"},
+	CSVColumnCompanions:                     {"同行者", "Companions"},
+	CSVColumnCurrency:                       {"通貨", "Currency"},
+	CSVColumnEntranceFee:                    {"入浴料", "Entrance Fee"},
+	CSVColumnOtherCosts:                     {"その他の費用", "Other Costs"},
+	CSVColumnTotalCost:                      {"合計費用", "Total Cost"},
+	CSVColumnRating:                         {"評価", "Rating"},
+	CSVColumn(RatingCriterionWaterQuality):  {RatingCriterionWaterQuality.Label(), "Water Quality"},
+	CSVColumn(RatingCriterionCleanliness):   {RatingCriterionCleanliness.Label(), "Cleanliness"},
+	CSVColumn(RatingCriterionScenery):       {RatingCriterionScenery.Label(), "Scenery"},
+	CSVColumn(RatingCriterionCrowding):      {RatingCriterionCrowding.Label(), "Crowding"},
+	CSVColumn(RatingCriterionValueForMoney): {RatingCriterionValueForMoney.Label(), "Value for Money"},
+	CSVColumnComment:                        {"コメント", "Comment"},
+	CSVColumnVisibility:                     {"公開範囲", "Visibility"},
+	CSVColumnCreatedAt:                      {"作成日", "Created At"},
+	CSVColumnUpdatedAt:                      {"更新日", "Updated At"},
+}
+
+// DefaultCSVColumns は列を指定しない場合にエクスポートする列です（緯度・経度・公開範囲は指定した場合のみ出力します）
+func DefaultCSVColumns() []CSVColumn {
+	columns := []CSVColumn{
+		CSVColumnID, CSVColumnName, CSVColumnLocation, CSVColumnPrefecture, CSVColumnMunicipality,
+		CSVColumnSpringTypes, CSVColumnFeatures, CSVColumnTags, CSVColumnVisitDate,
+		CSVColumnArrivalTime, CSVColumnDurationMinutes, CSVColumnStayType, CSVColumnCompanions,
+		CSVColumnCurrency, CSVColumnEntranceFee, CSVColumnOtherCosts, CSVColumnTotalCost, CSVColumnRating,
+	}
+	for _, criterion := range RatingCriteria {
+		columns = append(columns, CSVColumn(criterion))
+	}
+	return append(columns, CSVColumnComment, CSVColumnCreatedAt, CSVColumnUpdatedAt)
+}
+
+// IsValid はCSVの列が有効かどうかを返します
+func (c CSVColumn) IsValid() bool {
+	_, ok := csvColumnLabels[c]
+	return ok
+}
+
+// Label はヘッダーの言語に応じたCSVの列名を返します
+func (c CSVColumn) Label(language HeaderLanguage) string {
+	labels, ok := csvColumnLabels[c]
+	if !ok {
+		return string(c)
+	}
+	if language == HeaderLanguageEnglish {
+		return labels[1]
+	}
+	return labels[0]
+}
+
+// CSVColumnFromHeader はCSVのヘッダーの列名から列を判定します
+// 日本語・英語の列名と列のキー（name など）を受け付けます
+func CSVColumnFromHeader(header string) (CSVColumn, bool) {
+	header = strings.TrimSpace(header)
+	if column := CSVColumn(header); column.IsValid() {
+		return column, true
+	}
+	for column, labels := range csvColumnLabels {
+		if header == labels[0] || strings.EqualFold(header, labels[1]) {
+			return column, true
+		}
+	}
+	return "", false
+}
+
+// HeaderLanguage はCSVのヘッダーの言語を表す型です
+type HeaderLanguage string
+
+// ヘッダーの言語の定数
+const (
+	HeaderLanguageJapanese HeaderLanguage = "ja"
+	HeaderLanguageEnglish  HeaderLanguage = "en"
+)
+
+// IsValid はヘッダーの言語が有効かどうかを返します（空の場合は日本語として扱うため有効です）
+func (l HeaderLanguage) IsValid() bool {
+	switch l {
+	case "", HeaderLanguageJapanese, HeaderLanguageEnglish:
+		return true
+	}
+	return false
+}
+
+// CSVEncoding はCSVの文字コードを表す型です
+type CSVEncoding string
+
+// 文字コードの定数
+const (
+	// CSVEncodingUTF8 はBOMなしのUTF-8です
+	CSVEncodingUTF8 CSVEncoding = "utf-8"
+	// CSVEncodingUTF8BOM はExcelで文字化けしないようにBOMを付けたUTF-8です
+	CSVEncodingUTF8BOM CSVEncoding = "utf-8-bom"
+	// CSVEncodingShiftJIS はShift_JIS（CP932）です
+	CSVEncodingShiftJIS CSVEncoding = "shift_jis"
+)
+
+// ParseCSVEncoding は文字コードの名前を解析します（大文字・小文字と一般的な別名を許容します）
+// 空の場合はUTF-8として扱います
+func ParseCSVEncoding(s string) (CSVEncoding, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "utf-8", "utf8":
+		return CSVEncodingUTF8, true
+	case "utf-8-bom", "utf8-bom", "utf-8bom", "utf8bom":
+		return CSVEncodingUTF8BOM, true
+	case "shift_jis", "shift-jis", "sjis", "cp932", "windows-31j":
+		return CSVEncodingShiftJIS, true
+	}
+	return "", false
+}
+
+// ParseCSVDelimiter は区切り文字の名前または文字を解析します
+// 空の場合はカンマとして扱います
+func ParseCSVDelimiter(s string) (rune, bool) {
+	switch strings.ToLower(s) {
+	case "", ",", "comma":
+		return ',', true
+	case "\\t", "\t", "tab":
+		return '\t', true
+	case ";", "semicolon":
+		return ';', true
+	case "|", "pipe":
+		return '|', true
+	}
+	return 0, false
+}
+
+// dateFormatTokens は日付の書式の記号とGoの日付レイアウトの対応です（長い記号を優先します）
+var dateFormatTokens = strings.NewReplacer(
+	"YYYY", "2006",
+	"YY", "06",
+	"MM", "01",
+	"DD", "02",
+	"M", "1",
+	"D", "2",
+)
+
+// ParseDateFormat は日付の書式（YYYY/MM/DD など）をGoの日付レイアウトに変換します
+// 使用できる記号はYYYY・YY・MM・M・DD・Dで、それ以外の英字や数字を含む場合と年・月・日のいずれかを含まない場合は無効です
+// 出力した日付をインポートで読み取れるよう、年・月・日はすべて必要です
+// 空の場合はYYYY-MM-DDとして扱います
+func ParseDateFormat(format string) (string, bool) {
+	if format == "" {
+		return "2006-01-02", true
+	}
+	if strings.ContainsAny(format, "0123456789") {
+		return "", false
+	}
+	if !strings.Contains(format, "Y") || !strings.Contains(format, "M") || !strings.Contains(format, "D") {
+		return "", false
+	}
+	layout := dateFormatTokens.Replace(format)
+	if layout == format {
+		return "", false
+	}
+	for _, r := range layout {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			return "", false
+		}
+	}
+	return layout, true
+}
@@ -0,0 +1,166 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ExportJobStatus はエクスポートジョブの状態を表す型です
+type ExportJobStatus string
+
+// エクスポートジョブの状態の定数
+const (
+	ExportJobStatusPending   ExportJobStatus = "pending"   // 実行待ち（再試行待ちを含む）
+	ExportJobStatusRunning   ExportJobStatus = "running"   // 実行中
+	ExportJobStatusCompleted ExportJobStatus = "completed" // 完了（ダウンロード可能）
+	ExportJobStatusFailed    ExportJobStatus = "failed"    // 再試行の上限に達して失敗
+	ExportJobStatusExpired   ExportJobStatus = "expired"   // ダウンロードの有効期限切れ（ファイルは削除済み）
+)
+
+// エクスポートジョブの設定値
+const (
+	// DefaultExportJobMaxAttempts はエクスポートジョブを実行する回数の上限です（初回を含みます）
+	DefaultExportJobMaxAttempts = 3
+	// DefaultExportRetention はエクスポートしたファイルをダウンロードできる既定の期間です
+	DefaultExportRetention = 24 * time.Hour
+	// MaxActiveExportJobs はユーザーごとに同時に受け付ける未完了のエクスポートジョブの上限です
+	MaxActiveExportJobs = 3
+	// ExportRetryDelay はエクスポートジョブを再試行するまでの基準の待ち時間です（試行回数に比例して長くなります）
+	ExportRetryDelay = time.Minute
+	// ExportDownloadTokenBytes はダウンロードトークンの乱数のバイト数です
+	ExportDownloadTokenBytes = 32
+	// ExportJobHeartbeatInterval は実行中のエクスポートジョブの更新日時を更新する間隔です
+	ExportJobHeartbeatInterval = 30 * time.Second
+	// ExportJobLeaseTimeout は実行中のエクスポートジョブが中断されたとみなすまでの時間です
+	// 更新日時がこの時間より古い実行中のジョブは、実行していたサーバーが停止したものとして実行待ちに戻します
+	ExportJobLeaseTimeout = 5 * time.Minute
+)
+
+// ExportJobCSVOptions はエクスポートジョブに保存するCSV・XLSXの出力オプションです
+// 値の意味はエクスポートAPIのクエリパラメータと同じで、ゼロ値は既定値を表します
+type ExportJobCSVOptions struct {
+	Encoding       CSVEncoding    `json:"encoding" bson:"encoding"`
+	Delimiter      rune           `json:"delimiter" bson:"delimiter"`
+	Columns        []CSVColumn    `json:"columns" bson:"columns"`
+	DateLayout     string         `json:"date_layout" bson:"date_layout"`
+	HeaderLanguage HeaderLanguage `json:"header_language" bson:"header_language"`
+}
+
+// ExportJob は温泉メモを非同期でエクスポートするジョブを表すエンティティです
+// TripIDを指定した場合は旅行に含まれる温泉メモのみを、空の場合はすべての温泉メモをエクスポートします
+// 完了したジョブのファイルはDownloadTokenを知っていればDownloadExpiresAtまでダウンロードできます
+type ExportJob struct {
+	ID                primitive.ObjectID  `json:"id" bson:"_id,omitempty"`
+	UUID              string              `json:"uuid" bson:"uuid"`
+	UserID            string              `json:"user_id" bson:"user_id"`
+	Format            string              `json:"format" bson:"format"`
+	TripID            string              `json:"trip_id" bson:"trip_id"`
+	CSV               ExportJobCSVOptions `json:"csv" bson:"csv"`
+	FileName          string              `json:"file_name" bson:"file_name"`
+	ContentType       string              `json:"content_type" bson:"content_type"`
+	Status            ExportJobStatus     `json:"status" bson:"status"`
+	Attempts          int                 `json:"attempts" bson:"attempts"`
+	MaxAttempts       int                 `json:"max_attempts" bson:"max_attempts"`
+	Processed         int                 `json:"processed" bson:"processed"`
+	Total             int                 `json:"total" bson:"total"`
+	Error             string              `json:"error" bson:"error"`
+	FileURL           string              `json:"file_url" bson:"file_url"`
+	Size              int64               `json:"size" bson:"size"`
+	DownloadToken     string              `json:"download_token" bson:"download_token,omitempty"`
+	DownloadExpiresAt *time.Time          `json:"download_expires_at" bson:"download_expires_at"`
+	NextAttemptAt     time.Time           `json:"next_attempt_at" bson:"next_attempt_at"`
+	CreatedAt         time.Time           `json:"created_at" bson:"created_at"`
+	StartedAt         *time.Time          `json:"started_at" bson:"started_at"`
+	CompletedAt       *time.Time          `json:"completed_at" bson:"completed_at"`
+	UpdatedAt         time.Time           `json:"updated_at" bson:"updated_at"`
+}
+
+// NewExportJob は実行待ちの新しいエクスポートジョブエンティティを作成します
+func NewExportJob(userID, format, tripID string, csv ExportJobCSVOptions, fileName, contentType string) *ExportJob {
+	now := time.Now()
+	return &ExportJob{
+		UUID:          uuid.New().String(),
+		UserID:        userID,
+		Format:        format,
+		TripID:        tripID,
+		CSV:           csv,
+		FileName:      fileName,
+		ContentType:   contentType,
+		Status:        ExportJobStatusPending,
+		MaxAttempts:   DefaultExportJobMaxAttempts,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// IsActive はエクスポートジョブが未完了（実行待ちまたは実行中）かどうかを返します
+func (j *ExportJob) IsActive() bool {
+	return j.Status == ExportJobStatusPending || j.Status == ExportJobStatusRunning
+}
+
+// IsDownloadable はエクスポートしたファイルをダウンロードできるかどうかを返します
+func (j *ExportJob) IsDownloadable(now time.Time) bool {
+	return j.Status == ExportJobStatusCompleted && j.DownloadExpiresAt != nil && now.Before(*j.DownloadExpiresAt)
+}
+
+// Complete はエクスポートジョブを完了にし、ダウンロードトークンと有効期限を設定します
+// 処理済みと全体の件数は実際にエクスポートした件数にします
+func (j *ExportJob) Complete(fileURL string, size int64, count int, retention time.Duration) error {
+	token, err := generateDownloadToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(retention)
+	j.Status = ExportJobStatusCompleted
+	j.FileURL = fileURL
+	j.Size = size
+	j.Error = ""
+	j.DownloadToken = token
+	j.DownloadExpiresAt = &expiresAt
+	j.CompletedAt = &now
+	j.UpdatedAt = now
+	j.Processed = count
+	j.Total = count
+	return nil
+}
+
+// Fail はエクスポートジョブの失敗を記録します
+// 実行回数が上限に達していなければ、試行回数に比例した待ち時間の後に再試行する実行待ちに戻します
+func (j *ExportJob) Fail(err error) {
+	now := time.Now()
+	j.Error = err.Error()
+	j.Processed = 0
+	j.UpdatedAt = now
+	if j.Attempts < j.MaxAttempts {
+		j.Status = ExportJobStatusPending
+		j.NextAttemptAt = now.Add(time.Duration(j.Attempts) * ExportRetryDelay)
+		return
+	}
+	j.Status = ExportJobStatusFailed
+	j.CompletedAt = &now
+}
+
+// Expire はダウンロードの有効期限が切れたエクスポートジョブを期限切れにします
+// ファイルは呼び出し元で削除します
+func (j *ExportJob) Expire() {
+	j.Status = ExportJobStatusExpired
+	j.FileURL = ""
+	j.DownloadToken = ""
+	j.UpdatedAt = time.Now()
+}
+
+// generateDownloadToken はURLに含められる推測困難なダウンロードトークンを生成します
+func generateDownloadToken() (string, error) {
+	b := make([]byte, ExportDownloadTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
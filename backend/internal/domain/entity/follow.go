@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// フィードの取得件数
+const (
+	DefaultFeedLimit = 20
+	MaxFeedLimit     = 50
+)
+
+// FollowStatus はフォロー関係の状態を表す型です
+type FollowStatus string
+
+// フォロー関係の状態の定数
+const (
+	// FollowStatusPending はフォローされる側の承認を待っているフォローリクエストです
+	FollowStatusPending FollowStatus = "pending"
+	// FollowStatusApproved は承認されたフォロー関係です
+	FollowStatusApproved FollowStatus = "approved"
+)
+
+// Follow はユーザー間のフォロー関係を表すエンティティです
+// FollowerIDのユーザーがFolloweeIDのユーザーをフォローしています
+// フォローはフォローリクエストとして作成され、フォローされる側が承認するまでフォロワー向けの温泉メモは閲覧できません
+type Follow struct {
+	ID         primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID       string             `json:"uuid" bson:"uuid"`
+	FollowerID string             `json:"follower_id" bson:"follower_id"`
+	FolloweeID string             `json:"followee_id" bson:"followee_id"`
+	Status     FollowStatus       `json:"status" bson:"status,omitempty"`
+	CreatedAt  time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// NewFollow は承認待ちのフォロー関係エンティティを作成します
+func NewFollow(followerID, followeeID string) *Follow {
+	return &Follow{
+		UUID:       uuid.New().String(),
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		Status:     FollowStatusPending,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// StatusOrDefault はフォロー関係の状態を返します
+// 状態のないフォロー関係（フォローリクエストの導入前のもの）は承認済みとして扱います
+func (f *Follow) StatusOrDefault() FollowStatus {
+	if f.Status == "" {
+		return FollowStatusApproved
+	}
+	return f.Status
+}
+
+// IsApproved はフォロー関係が承認済みかどうかを返します
+func (f *Follow) IsApproved() bool {
+	return f.StatusOrDefault() == FollowStatusApproved
+}
+
+// FollowProfile はフォロー中・フォロワー・フォローリクエストのユーザーの公開プロフィールです
+// メールアドレスなどの個人情報は含めません
+type FollowProfile struct {
+	UserID     string       `json:"user_id"`
+	Name       string       `json:"name"`
+	Status     FollowStatus `json:"status"`
+	FollowedAt time.Time    `json:"followed_at"`
+}
+
+// FeedCursor はフィードのカーソルページネーションの位置です
+// 作成日時の新しい順に並べた温泉メモのうち、この位置より後のものを取得します
+type FeedCursor struct {
+	CreatedAt time.Time
+	ID        primitive.ObjectID
+}
+
+// NewFeedCursor は温泉メモの位置を表すカーソルを作成します
+func NewFeedCursor(onsenLog *OnsenLog) FeedCursor {
+	return FeedCursor{
+		CreatedAt: onsenLog.CreatedAt,
+		ID:        onsenLog.ID,
+	}
+}
+
+// Encode はカーソルをURLに含められる文字列に変換します
+func (c FeedCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixMilli(), 10) + "_" + c.ID.Hex()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseFeedCursor は文字列からカーソルを復元します
+func ParseFeedCursor(s string) (FeedCursor, error) {
+	invalid := errors.New("カーソルが無効です")
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return FeedCursor{}, invalid
+	}
+	millis, hex, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return FeedCursor{}, invalid
+	}
+	createdAt, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return FeedCursor{}, invalid
+	}
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return FeedCursor{}, invalid
+	}
+
+	return FeedCursor{
+		CreatedAt: time.UnixMilli(createdAt),
+		ID:        id,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/yuroku/internal/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Onsen は温泉施設を表すエンティティです
+type Onsen struct {
+	ID            primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID          string             `json:"uuid" bson:"uuid"`
+	UserID        string             `json:"user_id" bson:"user_id"`
+	Name          string             `json:"name" bson:"name"`
+	Location      string             `json:"location" bson:"location"`
+	NormalizedKey string             `json:"-" bson:"normalized_key"`
+	CreatedAt     time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// OnsenVisitSummary は温泉施設ごとの訪問集計を表します
+type OnsenVisitSummary struct {
+	OnsenID       string    `json:"onsen_id" bson:"_id"`
+	VisitCount    int       `json:"visit_count" bson:"visit_count"`
+	FirstVisit    time.Time `json:"first_visit" bson:"first_visit"`
+	LastVisit     time.Time `json:"last_visit" bson:"last_visit"`
+	AverageRating float64   `json:"average_rating" bson:"average_rating"`
+}
+
+// NewOnsen は新しい温泉施設エンティティを作成します
+func NewOnsen(userID, name, location string) *Onsen {
+	now := time.Now()
+	return &Onsen{
+		UUID:          uuid.New().String(),
+		UserID:        userID,
+		Name:          name,
+		Location:      location,
+		NormalizedKey: NormalizeOnsenKey(name, location),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// Update は温泉施設の情報を更新します
+func (o *Onsen) Update(name, location string) {
+	o.Name = name
+	o.Location = location
+	o.NormalizedKey = NormalizeOnsenKey(name, location)
+	o.UpdatedAt = time.Now()
+}
+
+// NormalizeOnsenKey は温泉名と所在地から施設を照合するためのキーを生成します
+func NormalizeOnsenKey(name, location string) string {
+	return common.NormalizeText(name) + "|" + common.NormalizeText(location)
+}
@@ -17,6 +17,7 @@ type OnsenImage struct {
 	Description string             `json:"description" bson:"description"`
 	CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
 	UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
+	DeletedAt   *time.Time         `json:"deleted_at,omitempty" bson:"deleted_at"`
 }
 
 // NewOnsenImage は新しい温泉画像エンティティを作成します
@@ -32,3 +33,8 @@ func NewOnsenImage(onsenID, userID, imageURL, description string) *OnsenImage {
 		UpdatedAt:   now,
 	}
 }
+
+// IsDeleted は温泉画像がゴミ箱にあるかどうかを返します
+func (i *OnsenImage) IsDeleted() bool {
+	return i.DeletedAt != nil
+}
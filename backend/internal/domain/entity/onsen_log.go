@@ -1,29 +1,13 @@
 package entity
 
 import (
+	"math"
 	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-// SpringType は温泉の泉質を表す型です
-type SpringType string
-
-// 泉質の定数
-const (
-	SpringTypeSulfur   SpringType = "硫黄泉"
-	SpringTypeCarbonic SpringType = "炭酸泉"
-	SpringTypeAlkaline SpringType = "アルカリ泉"
-	SpringTypeAcidic   SpringType = "酸性泉"
-	SpringTypeSalt     SpringType = "塩化物泉"
-	SpringTypeIron     SpringType = "鉄泉"
-	SpringTypeRadium   SpringType = "ラジウム泉"
-	SpringTypeSimple   SpringType = "単純温泉"
-	SpringTypeOther    SpringType = "その他"
-	SpringTypeUnknown  SpringType = "不明"
-)
-
 // Feature は温泉の特徴を表す型です
 type Feature string
 
@@ -40,52 +24,187 @@ const (
 )
 
 // OnsenLog は温泉メモを表すエンティティです
+// LegacySpringType は泉質の移行で残した移行前の単一の泉質で、温泉メモを更新しても変わりません
 type OnsenLog struct {
-	ID         primitive.ObjectID `json:"id" bson:"_id,omitempty"`
-	UUID       string             `json:"uuid" bson:"uuid"`
-	UserID     string             `json:"user_id" bson:"user_id"`
-	Name       string             `json:"name" bson:"name"`
-	Location   string             `json:"location" bson:"location"`
-	SpringType SpringType         `json:"spring_type" bson:"spring_type"`
-	Features   []Feature          `json:"features" bson:"features"`
-	VisitDate  time.Time          `json:"visit_date" bson:"visit_date"`
-	Rating     int                `json:"rating" bson:"rating"`
-	Comment    string             `json:"comment" bson:"comment"`
-	CreatedAt  time.Time          `json:"created_at" bson:"created_at"`
-	UpdatedAt  time.Time          `json:"updated_at" bson:"updated_at"`
+	ID               primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID             string             `json:"uuid" bson:"uuid"`
+	UserID           string             `json:"user_id" bson:"user_id"`
+	OnsenID          string             `json:"onsen_id" bson:"onsen_id"`
+	Name             string             `json:"name" bson:"name"`
+	Location         string             `json:"location" bson:"location"`
+	Area             LocationArea       `json:"area" bson:"area"`
+	Coordinates      *GeoPoint          `json:"coordinates,omitempty" bson:"coordinates"`
+	SpringTypes      []SpringType       `json:"spring_types" bson:"spring_types"`
+	LegacySpringType string             `json:"legacy_spring_type,omitempty" bson:"legacy_spring_type,omitempty"`
+	WaterAnalysis    WaterAnalysis      `json:"water_analysis" bson:"water_analysis"`
+	Features         []Feature          `json:"features" bson:"features"`
+	Tags             []string           `json:"tags" bson:"tags"`
+	VisitDate        time.Time          `json:"visit_date" bson:"visit_date"`
+	Visit            VisitDetails       `json:"visit" bson:"visit"`
+	Rating           float64            `json:"rating" bson:"rating"`
+	RatingScores     RatingScores       `json:"rating_scores" bson:"rating_scores"`
+	RatingOverridden bool               `json:"rating_overridden" bson:"rating_overridden"`
+	Comment          string             `json:"comment" bson:"comment"`
+	WishlistItemID   string             `json:"wishlist_item_id" bson:"wishlist_item_id"`
+	Visibility       Visibility         `json:"visibility" bson:"visibility"`
+	DeletedAt        *time.Time         `json:"deleted_at,omitempty" bson:"deleted_at"`
+	SearchTerms      []string           `json:"-" bson:"search_terms"`
+	NameTerms        []string           `json:"-" bson:"name_terms"`
+	CreatedAt        time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// GeoPoint はGeoJSON形式の地点を表します（座標は経度・緯度の順）
+type GeoPoint struct {
+	Type        string    `json:"type" bson:"type"`
+	Coordinates []float64 `json:"coordinates" bson:"coordinates"`
+}
+
+// NewGeoPoint は緯度と経度から地点を作成します
+func NewGeoPoint(latitude, longitude float64) *GeoPoint {
+	return &GeoPoint{
+		Type:        "Point",
+		Coordinates: []float64{longitude, latitude},
+	}
+}
+
+// Latitude は緯度を返します
+func (p *GeoPoint) Latitude() float64 {
+	return p.Coordinates[1]
+}
+
+// Longitude は経度を返します
+func (p *GeoPoint) Longitude() float64 {
+	return p.Coordinates[0]
+}
+
+// NearbyOnsenLog は検索地点からの距離付きの温泉メモです
+type NearbyOnsenLog struct {
+	OnsenLog   *OnsenLog
+	DistanceKm float64
+}
+
+// OnsenLogParams は温泉メモの作成・更新に使用する入力値です
+// Ratingは手動で指定する総合評価で、nilの場合は項目別評価の平均を総合評価にします
+// WishlistItemIDは行きたい温泉から変換した場合の変換元のIDで、作成時のみ反映します
+// Visibilityは公開範囲で、空の場合は非公開にします
+type OnsenLogParams struct {
+	OnsenID        string
+	Name           string
+	Location       string
+	Latitude       *float64
+	Longitude      *float64
+	SpringTypes    []SpringType
+	WaterAnalysis  WaterAnalysis
+	Features       []Feature
+	Tags           []string
+	VisitDate      time.Time
+	Visit          VisitDetails
+	Rating         *float64
+	RatingScores   RatingScores
+	Comment        string
+	WishlistItemID string
+	Visibility     Visibility
 }
 
 // NewOnsenLog は新しい温泉メモエンティティを作成します
-func NewOnsenLog(userID, name, location string, springType SpringType, features []Feature, visitDate time.Time, rating int, comment string) *OnsenLog {
+func NewOnsenLog(userID string, params OnsenLogParams) *OnsenLog {
 	now := time.Now()
-	return &OnsenLog{
-		UUID:       uuid.New().String(),
-		UserID:     userID,
-		Name:       name,
-		Location:   location,
-		SpringType: springType,
-		Features:   features,
-		VisitDate:  visitDate,
-		Rating:     rating,
-		Comment:    comment,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+	onsenLog := &OnsenLog{
+		UUID:           uuid.New().String(),
+		UserID:         userID,
+		WishlistItemID: params.WishlistItemID,
+		CreatedAt:      now,
 	}
+	onsenLog.apply(params)
+	onsenLog.UpdatedAt = now
+	return onsenLog
 }
 
 // Update は温泉メモの情報を更新します
-func (o *OnsenLog) Update(name, location string, springType SpringType, features []Feature, visitDate time.Time, rating int, comment string) {
-	o.Name = name
-	o.Location = location
-	o.SpringType = springType
-	o.Features = features
-	o.VisitDate = visitDate
-	o.Rating = rating
-	o.Comment = comment
+func (o *OnsenLog) Update(params OnsenLogParams) {
+	o.apply(params)
 	o.UpdatedAt = time.Now()
 }
 
+// Params は温泉メモの現在の状態を更新用の入力値として返します
+// 総合評価は手動で指定されている場合か、項目別評価がない場合のみ指定します
+func (o *OnsenLog) Params() OnsenLogParams {
+	params := OnsenLogParams{
+		OnsenID:       o.OnsenID,
+		Name:          o.Name,
+		Location:      o.Location,
+		SpringTypes:   o.SpringTypes,
+		WaterAnalysis: o.WaterAnalysis,
+		Features:      o.Features,
+		Tags:          o.Tags,
+		VisitDate:     o.VisitDate,
+		Visit:         o.Visit,
+		RatingScores:  o.RatingScores,
+		Comment:       o.Comment,
+		Visibility:    o.Visibility,
+	}
+	if o.Coordinates != nil {
+		latitude, longitude := o.Coordinates.Latitude(), o.Coordinates.Longitude()
+		params.Latitude, params.Longitude = &latitude, &longitude
+	}
+	if o.RatingOverridden || o.RatingScores.IsEmpty() {
+		rating := o.Rating
+		params.Rating = &rating
+	}
+	return params
+}
+
+// apply は入力値を温泉メモに反映します
+func (o *OnsenLog) apply(params OnsenLogParams) {
+	o.OnsenID = params.OnsenID
+	o.Name = params.Name
+	o.Location = params.Location
+	o.Area = ResolveLocationArea(params.Location)
+	o.Coordinates = nil
+	if params.Latitude != nil && params.Longitude != nil {
+		o.Coordinates = NewGeoPoint(*params.Latitude, *params.Longitude)
+	}
+	o.SpringTypes = NormalizeSpringTypes(params.SpringTypes)
+	o.WaterAnalysis = params.WaterAnalysis
+	o.WaterAnalysis.Tonicity = params.WaterAnalysis.ResolvedTonicity()
+	o.Features = params.Features
+	o.Tags = NormalizeTags(params.Tags)
+	o.VisitDate = params.VisitDate
+	o.Visit = params.Visit.Normalize()
+	o.RatingScores = params.RatingScores
+	o.Rating, o.RatingOverridden = resolveOverallRating(params.Rating, params.RatingScores)
+	o.Comment = params.Comment
+	o.Visibility = params.Visibility.OrDefault()
+	o.RefreshSearchTerms()
+}
+
+// IsDeleted は温泉メモがゴミ箱にあるかどうかを返します
+func (o *OnsenLog) IsDeleted() bool {
+	return o.DeletedAt != nil
+}
+
 // ValidateRating は評価値が有効かどうかを検証します
-func ValidateRating(rating int) bool {
+func ValidateRating(rating float64) bool {
 	return rating >= 0 && rating <= 5
 }
+
+// ValidateCoordinates は緯度・経度の組が有効かどうかを検証します
+// 緯度と経度はどちらも省略するか、両方を範囲内で指定する必要があります（NaNと無限大は無効です）
+func ValidateCoordinates(latitude, longitude *float64) bool {
+	if latitude == nil && longitude == nil {
+		return true
+	}
+	if latitude == nil || longitude == nil {
+		return false
+	}
+	if !isFinite(*latitude) || !isFinite(*longitude) {
+		return false
+	}
+	return *latitude >= -90 && *latitude <= 90 && *longitude >= -180 && *longitude <= 180
+}
+
+// isFinite は値がNaNでも無限大でもないかどうかを返します
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
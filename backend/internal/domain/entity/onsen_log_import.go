@@ -0,0 +1,183 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/yourusername/yuroku/internal/common"
+)
+
+// MaxImportRows は1回のインポートで取り込める温泉メモの上限です
+const MaxImportRows = 1000
+
+// ImportPolicy は既存の温泉メモと重複した行の扱いを表す型です
+type ImportPolicy string
+
+// 重複時の扱いの定数
+const (
+	// ImportPolicySkip は重複した行を取り込みません
+	ImportPolicySkip ImportPolicy = "skip"
+	// ImportPolicyOverwrite は既存の温泉メモを取り込んだ内容で上書きします
+	ImportPolicyOverwrite ImportPolicy = "overwrite"
+	// ImportPolicyMerge は既存の温泉メモの未入力の項目だけを取り込んだ内容で補います
+	ImportPolicyMerge ImportPolicy = "merge"
+)
+
+// IsValid は重複時の扱いが有効かどうかを返します（空の場合は取り込まないものとして扱うため有効です）
+func (p ImportPolicy) IsValid() bool {
+	switch p {
+	case "", ImportPolicySkip, ImportPolicyOverwrite, ImportPolicyMerge:
+		return true
+	}
+	return false
+}
+
+// OrDefault は空の重複時の扱いを取り込まないものとして返します
+func (p ImportPolicy) OrDefault() ImportPolicy {
+	if p == "" {
+		return ImportPolicySkip
+	}
+	return p
+}
+
+// ImportAction はインポートした行の処理結果を表す型です
+type ImportAction string
+
+// 処理結果の定数
+const (
+	ImportActionCreated ImportAction = "created"
+	ImportActionUpdated ImportAction = "updated"
+	ImportActionSkipped ImportAction = "skipped"
+	ImportActionFailed  ImportAction = "failed"
+)
+
+// ImportRow はインポートするファイルから読み取った1行分の温泉メモです
+// Rowはファイル内の行番号（ヘッダーを除く1始まり）で、Errorsは読み取り時の形式エラーです
+type ImportRow struct {
+	Row    int
+	Params OnsenLogParams
+	Errors []string
+}
+
+// ImportRowResult はインポートした行ごとの処理結果です
+// ドライランの場合は実際には保存せず、保存した場合の処理結果を返します
+type ImportRowResult struct {
+	Row        int          `json:"row"`
+	Name       string       `json:"name"`
+	VisitDate  string       `json:"visit_date,omitempty"`
+	Action     ImportAction `json:"action"`
+	OnsenLogID string       `json:"onsen_log_id,omitempty"`
+	Errors     []string     `json:"errors,omitempty"`
+}
+
+// ImportSummary はインポートの処理結果の集計です
+type ImportSummary struct {
+	DryRun  bool              `json:"dry_run"`
+	Policy  ImportPolicy      `json:"policy"`
+	Total   int               `json:"total"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Skipped int               `json:"skipped"`
+	Failed  int               `json:"failed"`
+	Rows    []ImportRowResult `json:"rows"`
+}
+
+// Add は行の処理結果を集計に加えます
+func (s *ImportSummary) Add(result ImportRowResult) {
+	s.Total++
+	switch result.Action {
+	case ImportActionCreated:
+		s.Created++
+	case ImportActionUpdated:
+		s.Updated++
+	case ImportActionSkipped:
+		s.Skipped++
+	case ImportActionFailed:
+		s.Failed++
+	}
+	s.Rows = append(s.Rows, result)
+}
+
+// ImportDuplicateKey は温泉名と訪問日から重複判定に使用するキーを作成します
+// 温泉名は表記揺れ（全角・半角、空白、記号）を無視して比較します
+func ImportDuplicateKey(name string, visitDate time.Time) string {
+	return common.NormalizeText(name) + "|" + visitDate.Format("2006-01-02")
+}
+
+// MergeOnsenLogParams は既存の温泉メモの未入力の項目を取り込んだ内容で補った入力値を返します
+// 温泉名・訪問日・温泉施設・公開範囲は既存の値を維持し、泉質・特徴・タグ・同行者は両方を合わせます
+func MergeOnsenLogParams(existing *OnsenLog, imported OnsenLogParams) OnsenLogParams {
+	params := existing.Params()
+
+	if params.Location == "" {
+		params.Location = imported.Location
+	}
+	if params.Latitude == nil || params.Longitude == nil {
+		params.Latitude, params.Longitude = imported.Latitude, imported.Longitude
+	}
+	params.SpringTypes = NormalizeSpringTypes(append(params.SpringTypes, imported.SpringTypes...))
+	params.Features = mergeFeatures(params.Features, imported.Features)
+	params.Tags = NormalizeTags(append(params.Tags, imported.Tags...))
+
+	// 温泉分析書は未測定の項目だけを補う
+	if params.WaterAnalysis.SourceTemperature == nil {
+		params.WaterAnalysis.SourceTemperature = imported.WaterAnalysis.SourceTemperature
+	}
+	if params.WaterAnalysis.PH == nil {
+		params.WaterAnalysis.PH = imported.WaterAnalysis.PH
+	}
+	if params.WaterAnalysis.TotalDissolvedSolids == nil {
+		params.WaterAnalysis.TotalDissolvedSolids = imported.WaterAnalysis.TotalDissolvedSolids
+	}
+	if params.WaterAnalysis.Tonicity == "" {
+		params.WaterAnalysis.Tonicity = imported.WaterAnalysis.Tonicity
+	}
+
+	// 訪問時の状況は未記録の項目だけを補う
+	if params.Visit.ArrivalTime == "" {
+		params.Visit.ArrivalTime = imported.Visit.ArrivalTime
+	}
+	if params.Visit.DurationMinutes == 0 {
+		params.Visit.DurationMinutes = imported.Visit.DurationMinutes
+	}
+	if params.Visit.StayType == "" {
+		params.Visit.StayType = imported.Visit.StayType
+	}
+	params.Visit.Companions = NormalizeCompanions(append(params.Visit.Companions, imported.Visit.Companions...))
+	if !params.Visit.HasCosts() {
+		params.Visit.Currency = imported.Visit.Currency
+		params.Visit.EntranceFee = imported.Visit.EntranceFee
+		params.Visit.OtherCosts = imported.Visit.OtherCosts
+	}
+
+	// 項目別評価は未評価の項目だけを補い、手動指定の総合評価がなければ取り込んだ総合評価を使う
+	for _, criterion := range RatingCriteria {
+		if params.RatingScores.Score(criterion) == 0 {
+			params.RatingScores.SetScore(criterion, imported.RatingScores.Score(criterion))
+		}
+	}
+	if !existing.RatingOverridden && imported.Rating != nil {
+		params.Rating = imported.Rating
+	}
+
+	if params.Comment == "" {
+		params.Comment = imported.Comment
+	}
+
+	return params
+}
+
+// mergeFeatures は既存の特徴の後ろに、含まれていない取り込んだ特徴を追加します
+func mergeFeatures(existing, imported []Feature) []Feature {
+	merged := append([]Feature{}, existing...)
+	seen := make(map[Feature]bool, len(existing))
+	for _, feature := range existing {
+		seen[feature] = true
+	}
+	for _, feature := range imported {
+		if !seen[feature] {
+			seen[feature] = true
+			merged = append(merged, feature)
+		}
+	}
+	return merged
+}
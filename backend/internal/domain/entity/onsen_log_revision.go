@@ -0,0 +1,222 @@
+package entity
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RevisionAction は温泉メモの版を記録した操作を表す型です
+type RevisionAction string
+
+// 版の操作の定数
+const (
+	// RevisionActionCreate は初回の更新時に記録する更新前の状態です
+	RevisionActionCreate RevisionAction = "create"
+	// RevisionActionUpdate は温泉メモの更新です
+	RevisionActionUpdate RevisionAction = "update"
+	// RevisionActionRevert は以前の版への復元です
+	RevisionActionRevert RevisionAction = "revert"
+)
+
+// OnsenLogRevision は温泉メモの変更履歴の1つの版を表すエンティティです
+// Snapshotは変更後の状態、Previousは変更前の状態で、初回の版ではPreviousはnilです
+type OnsenLogRevision struct {
+	ID                primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID              string             `json:"uuid" bson:"uuid"`
+	OnsenLogID        string             `json:"onsen_log_id" bson:"onsen_log_id"`
+	UserID            string             `json:"user_id" bson:"user_id"`
+	ActorID           string             `json:"actor_id" bson:"actor_id"`
+	Version           int                `json:"version" bson:"version"`
+	Action            RevisionAction     `json:"action" bson:"action"`
+	RevertedToVersion int                `json:"reverted_to_version,omitempty" bson:"reverted_to_version,omitempty"`
+	ChangedFields     []string           `json:"changed_fields" bson:"changed_fields"`
+	Previous          *OnsenLogSnapshot  `json:"previous,omitempty" bson:"previous"`
+	Snapshot          OnsenLogSnapshot   `json:"snapshot" bson:"snapshot"`
+	CreatedAt         time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// OnsenLogSnapshot は温泉メモの編集できる項目のある時点の状態です
+// 所在地から判定する地域や検索語は復元時に再計算するため含めません
+type OnsenLogSnapshot struct {
+	OnsenID          string        `json:"onsen_id" bson:"onsen_id"`
+	Name             string        `json:"name" bson:"name"`
+	Location         string        `json:"location" bson:"location"`
+	Coordinates      *GeoPoint     `json:"coordinates" bson:"coordinates"`
+	SpringTypes      []SpringType  `json:"spring_types" bson:"spring_types"`
+	WaterAnalysis    WaterAnalysis `json:"water_analysis" bson:"water_analysis"`
+	Features         []Feature     `json:"features" bson:"features"`
+	Tags             []string      `json:"tags" bson:"tags"`
+	VisitDate        time.Time     `json:"visit_date" bson:"visit_date"`
+	Visit            VisitDetails  `json:"visit" bson:"visit"`
+	Rating           float64       `json:"rating" bson:"rating"`
+	RatingScores     RatingScores  `json:"rating_scores" bson:"rating_scores"`
+	RatingOverridden bool          `json:"rating_overridden" bson:"rating_overridden"`
+	Comment          string        `json:"comment" bson:"comment"`
+	Visibility       Visibility    `json:"visibility" bson:"visibility"`
+}
+
+// FieldChange は項目ごとの変更前と変更後の値です
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+// NewOnsenLogRevision は温泉メモの現在の状態を新しい版として作成します
+// previousがnilの場合は変更前の状態がない最初の版になります
+func NewOnsenLogRevision(onsenLog *OnsenLog, version int, actorID string, action RevisionAction, previous *OnsenLogSnapshot) *OnsenLogRevision {
+	revision := &OnsenLogRevision{
+		UUID:          uuid.New().String(),
+		OnsenLogID:    onsenLog.UUID,
+		UserID:        onsenLog.UserID,
+		ActorID:       actorID,
+		Version:       version,
+		Action:        action,
+		ChangedFields: []string{},
+		Previous:      previous,
+		Snapshot:      onsenLog.Snapshot(),
+		CreatedAt:     time.Now(),
+	}
+	if previous != nil {
+		for _, change := range previous.Diff(revision.Snapshot) {
+			revision.ChangedFields = append(revision.ChangedFields, change.Field)
+		}
+	}
+	return revision
+}
+
+// NewInitialOnsenLogRevision は変更履歴のない温泉メモの初回の更新前の状態を最初の版として作成します
+// 作成者による作成として扱い、記録日時には更新前の最終更新日時を使用します
+func NewInitialOnsenLogRevision(onsenLog *OnsenLog, snapshot OnsenLogSnapshot, updatedAt time.Time) *OnsenLogRevision {
+	return &OnsenLogRevision{
+		UUID:          uuid.New().String(),
+		OnsenLogID:    onsenLog.UUID,
+		UserID:        onsenLog.UserID,
+		ActorID:       onsenLog.UserID,
+		Version:       1,
+		Action:        RevisionActionCreate,
+		ChangedFields: []string{},
+		Snapshot:      snapshot,
+		CreatedAt:     updatedAt,
+	}
+}
+
+// Changes は版で変更された項目の変更前と変更後の値を返します
+func (r *OnsenLogRevision) Changes() []FieldChange {
+	if r.Previous == nil {
+		return []FieldChange{}
+	}
+	return r.Previous.Diff(r.Snapshot)
+}
+
+// Snapshot は温泉メモの編集できる項目の現在の状態を返します
+func (o *OnsenLog) Snapshot() OnsenLogSnapshot {
+	return OnsenLogSnapshot{
+		OnsenID:          o.OnsenID,
+		Name:             o.Name,
+		Location:         o.Location,
+		Coordinates:      o.Coordinates,
+		SpringTypes:      o.SpringTypes,
+		WaterAnalysis:    o.WaterAnalysis,
+		Features:         o.Features,
+		Tags:             o.Tags,
+		VisitDate:        o.VisitDate,
+		Visit:            o.Visit,
+		Rating:           o.Rating,
+		RatingScores:     o.RatingScores,
+		RatingOverridden: o.RatingOverridden,
+		Comment:          o.Comment,
+		Visibility:       o.Visibility.OrDefault(),
+	}.normalize()
+}
+
+// Revert は温泉メモを以前の版の状態に戻します
+func (o *OnsenLog) Revert(snapshot OnsenLogSnapshot) {
+	o.OnsenID = snapshot.OnsenID
+	o.Name = snapshot.Name
+	o.Location = snapshot.Location
+	o.Area = ResolveLocationArea(snapshot.Location)
+	o.Coordinates = snapshot.Coordinates
+	o.SpringTypes = snapshot.SpringTypes
+	o.WaterAnalysis = snapshot.WaterAnalysis
+	o.Features = snapshot.Features
+	o.Tags = snapshot.Tags
+	o.VisitDate = snapshot.VisitDate
+	o.Visit = snapshot.Visit
+	o.Rating = snapshot.Rating
+	o.RatingScores = snapshot.RatingScores
+	o.RatingOverridden = snapshot.RatingOverridden
+	o.Comment = snapshot.Comment
+	o.Visibility = snapshot.Visibility.OrDefault()
+	o.RefreshSearchTerms()
+	o.UpdatedAt = time.Now()
+}
+
+// Diff は版の状態と別の版の状態を項目ごとに比較し、値が異なる項目を返します
+func (s OnsenLogSnapshot) Diff(other OnsenLogSnapshot) []FieldChange {
+	from, to := s.normalize().fields(), other.normalize().fields()
+
+	changes := []FieldChange{}
+	for i, field := range from {
+		if !reflect.DeepEqual(field.value, to[i].value) {
+			changes = append(changes, FieldChange{
+				Field: field.name,
+				From:  field.value,
+				To:    to[i].value,
+			})
+		}
+	}
+	return changes
+}
+
+// snapshotField は比較に使用する項目名と値の組です
+type snapshotField struct {
+	name  string
+	value interface{}
+}
+
+// fields は版の状態を比較する項目の順に並べて返します（項目名はレスポンスのキーと同じです）
+func (s OnsenLogSnapshot) fields() []snapshotField {
+	return []snapshotField{
+		{"onsen_id", s.OnsenID},
+		{"name", s.Name},
+		{"location", s.Location},
+		{"coordinates", s.Coordinates},
+		{"spring_types", s.SpringTypes},
+		{"water_analysis", s.WaterAnalysis},
+		{"features", s.Features},
+		{"tags", s.Tags},
+		{"visit_date", s.VisitDate},
+		{"visit", s.Visit},
+		{"rating", s.Rating},
+		{"rating_scores", s.RatingScores},
+		{"rating_overridden", s.RatingOverridden},
+		{"comment", s.Comment},
+		{"visibility", s.Visibility},
+	}
+}
+
+// normalize は保存前後で比較結果が変わらないように空のリストと日時の表現を揃えます
+// MongoDBの日時はミリ秒精度のため、ミリ秒単位に切り捨てます
+func (s OnsenLogSnapshot) normalize() OnsenLogSnapshot {
+	if s.SpringTypes == nil {
+		s.SpringTypes = []SpringType{}
+	}
+	if s.Features == nil {
+		s.Features = []Feature{}
+	}
+	if s.Tags == nil {
+		s.Tags = []string{}
+	}
+	if s.Visit.Companions == nil {
+		s.Visit.Companions = []Companion{}
+	}
+	if s.Visit.OtherCosts == nil {
+		s.Visit.OtherCosts = []VisitCost{}
+	}
+	s.VisitDate = s.VisitDate.UTC().Truncate(time.Millisecond)
+	return s
+}
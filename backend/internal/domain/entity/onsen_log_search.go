@@ -0,0 +1,181 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/yourusername/yuroku/internal/common"
+)
+
+// searchGramSize は検索に使用するN-gramの文字数です
+const searchGramSize = 2
+
+// onsenReadings は温泉地名などの読み仮名の辞書です
+// 漢字表記の温泉メモを「くさつ」「クサツ」のような読みでも検索できるようにします
+var onsenReadings = map[string]string{
+	"温泉":   "おんせん",
+	"露天風呂": "ろてんぶろ",
+	"源泉":   "げんせん",
+	"草津":   "くさつ",
+	"箱根":   "はこね",
+	"強羅":   "ごうら",
+	"別府":   "べっぷ",
+	"由布院":  "ゆふいん",
+	"湯布院":  "ゆふいん",
+	"登別":   "のぼりべつ",
+	"定山渓":  "じょうざんけい",
+	"洞爺湖":  "とうやこ",
+	"層雲峡":  "そううんきょう",
+	"十勝川":  "とかちがわ",
+	"川湯":   "かわゆ",
+	"酸ヶ湯":  "すかゆ",
+	"浅虫":   "あさむし",
+	"乳頭":   "にゅうとう",
+	"銀山":   "ぎんざん",
+	"蔵王":   "ざおう",
+	"秋保":   "あきう",
+	"作並":   "さくなみ",
+	"鳴子":   "なるこ",
+	"飯坂":   "いいざか",
+	"東山":   "ひがしやま",
+	"鬼怒川":  "きぬがわ",
+	"日光":   "にっこう",
+	"那須":   "なす",
+	"塩原":   "しおばら",
+	"伊香保":  "いかほ",
+	"四万":   "しま",
+	"万座":   "まんざ",
+	"法師":   "ほうし",
+	"宝川":   "たからがわ",
+	"熱海":   "あたみ",
+	"伊東":   "いとう",
+	"修善寺":  "しゅぜんじ",
+	"湯河原":  "ゆがわら",
+	"石和":   "いさわ",
+	"下部":   "しもべ",
+	"野沢":   "のざわ",
+	"湯田中":  "ゆだなか",
+	"白骨":   "しらほね",
+	"奥飛騨":  "おくひだ",
+	"平湯":   "ひらゆ",
+	"下呂":   "げろ",
+	"宇奈月":  "うなづき",
+	"和倉":   "わくら",
+	"山中":   "やまなか",
+	"山代":   "やましろ",
+	"芦原":   "あわら",
+	"有馬":   "ありま",
+	"城崎":   "きのさき",
+	"湯村":   "ゆむら",
+	"白浜":   "しらはま",
+	"皆生":   "かいけ",
+	"三朝":   "みささ",
+	"玉造":   "たまつくり",
+	"温泉津":  "ゆのつ",
+	"湯原":   "ゆばら",
+	"奥津":   "おくつ",
+	"道後":   "どうご",
+	"武雄":   "たけお",
+	"嬉野":   "うれしの",
+	"雲仙":   "うんぜん",
+	"黒川":   "くろかわ",
+	"指宿":   "いぶすき",
+	"霧島":   "きりしま",
+}
+
+// searchStopSuffixes は検索語の末尾から取り除く語です
+// 「草津温泉」のように温泉名の末尾に付くことが多く、関連度の計算に使用すると精度が下がるためです
+var searchStopSuffixes = []string{"温泉", "おんせん"}
+
+// readingReplacer は辞書の表記を読み仮名に置き換えます（長い表記を優先）
+var readingReplacer = newReadingReplacer()
+
+// newReadingReplacer は読み仮名辞書から置換器を作成します
+func newReadingReplacer() *strings.Replacer {
+	words := make([]string, 0, len(onsenReadings))
+	for word := range onsenReadings {
+		words = append(words, word)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		if len([]rune(words[i])) != len([]rune(words[j])) {
+			return len([]rune(words[i])) > len([]rune(words[j]))
+		}
+		return words[i] < words[j]
+	})
+
+	pairs := make([]string, 0, len(words)*2)
+	for _, word := range words {
+		pairs = append(pairs, word, onsenReadings[word])
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// RefreshSearchTerms は温泉名・所在地・コメントから検索用のN-gramを再計算します
+func (o *OnsenLog) RefreshSearchTerms() {
+	o.NameTerms = buildSearchTerms(o.Name)
+	o.SearchTerms = buildSearchTerms(o.Name, o.Location, o.Comment)
+}
+
+// buildSearchTerms は文字列から検索用の語句（1文字と2文字のN-gram）を作成します
+// 読み仮名辞書に含まれる表記は、読み仮名に置き換えたN-gramも追加します
+func buildSearchTerms(texts ...string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	add := func(grams []string) {
+		for _, gram := range grams {
+			if !seen[gram] {
+				seen[gram] = true
+				terms = append(terms, gram)
+			}
+		}
+	}
+
+	for _, text := range texts {
+		normalized := common.NormalizeSearchText(text)
+		if normalized == "" {
+			continue
+		}
+
+		// 1文字の検索語にも一致するように1-gramも含める
+		add(common.NGrams(normalized, 1))
+		add(common.NGrams(normalized, searchGramSize))
+
+		if reading := readingReplacer.Replace(normalized); reading != normalized {
+			add(common.NGrams(reading, 1))
+			add(common.NGrams(reading, searchGramSize))
+		}
+	}
+
+	return terms
+}
+
+// SearchQueryVariants は検索キーワードを検索用のN-gramの組に変換します
+// 元の表記と読み仮名に置き換えた表記のそれぞれについてN-gramの組を返します
+func SearchQueryVariants(query string) [][]string {
+	normalized := common.NormalizeSearchText(query)
+	if normalized == "" {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var variants [][]string
+	for _, text := range []string{normalized, readingReplacer.Replace(normalized)} {
+		text = trimSearchStopSuffix(text)
+		if !seen[text] {
+			seen[text] = true
+			variants = append(variants, common.NGrams(text, searchGramSize))
+		}
+	}
+
+	return variants
+}
+
+// trimSearchStopSuffix は検索語の末尾の「温泉」などを取り除きます（検索語が空になる場合はそのまま）
+func trimSearchStopSuffix(text string) string {
+	for _, suffix := range searchStopSuffixes {
+		if trimmed := strings.TrimSuffix(text, suffix); trimmed != "" {
+			text = trimmed
+		}
+	}
+	return text
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+// MaxTopFacilities は統計に含める訪問回数の多い温泉施設の件数です
+const MaxTopFacilities = 10
+
+// UnknownPrefecture は所在地から都道府県を判定できない温泉メモの集計キーです
+const UnknownPrefecture = "不明"
+
+// OnsenLogStats はユーザーの入浴履歴の統計です
+type OnsenLogStats struct {
+	TotalCount         int                  `json:"total_count" bson:"total_count"`
+	AverageRating      float64              `json:"average_rating" bson:"average_rating"`
+	FirstVisit         *time.Time           `json:"first_visit" bson:"first_visit"`
+	LastVisit          *time.Time           `json:"last_visit" bson:"last_visit"`
+	BySpringType       []StatsCount         `json:"by_spring_type" bson:"by_spring_type"`
+	ByPrefecture       []StatsCount         `json:"by_prefecture" bson:"by_prefecture"`
+	ByMonth            []StatsCount         `json:"by_month" bson:"by_month"`
+	ByYear             []StatsCount         `json:"by_year" bson:"by_year"`
+	RatingDistribution []RatingBucket       `json:"rating_distribution" bson:"rating_distribution"`
+	RatingByFeature    []FeatureRatingStats `json:"rating_by_feature" bson:"rating_by_feature"`
+	Spending           []CurrencySpending   `json:"spending" bson:"spending"`
+	TopFacilities      []FacilityVisitStats `json:"top_facilities" bson:"top_facilities"`
+}
+
+// StatsCount は集計キーごとの件数です
+type StatsCount struct {
+	Key   string `json:"key" bson:"_id"`
+	Count int    `json:"count" bson:"count"`
+}
+
+// RatingBucket は総合評価（四捨五入した星の数）ごとの件数です
+type RatingBucket struct {
+	Rating int `json:"rating" bson:"_id"`
+	Count  int `json:"count" bson:"count"`
+}
+
+// FeatureRatingStats は温泉の特徴ごとの平均評価です
+type FeatureRatingStats struct {
+	Feature       Feature `json:"feature" bson:"_id"`
+	Count         int     `json:"count" bson:"count"`
+	AverageRating float64 `json:"average_rating" bson:"average_rating"`
+}
+
+// CurrencySpending は通貨ごとの費用の合計です（通貨の異なる金額は合算しません）
+type CurrencySpending struct {
+	Currency   string  `json:"currency" bson:"_id"`
+	Total      float64 `json:"total" bson:"total"`
+	VisitCount int     `json:"visit_count" bson:"visit_count"`
+}
+
+// FacilityVisitStats は温泉施設ごとの訪問回数です（温泉名は最後に訪問したときのもの）
+type FacilityVisitStats struct {
+	OnsenID    string    `json:"onsen_id" bson:"_id"`
+	Name       string    `json:"name" bson:"name"`
+	VisitCount int       `json:"visit_count" bson:"visit_count"`
+	LastVisit  time.Time `json:"last_visit" bson:"last_visit"`
+}
+
+// RoundAverages は平均評価を小数第1位で丸めます
+func (s *OnsenLogStats) RoundAverages() {
+	s.AverageRating = roundRating(s.AverageRating)
+	for i := range s.RatingByFeature {
+		s.RatingByFeature[i].AverageRating = roundRating(s.RatingByFeature[i].AverageRating)
+	}
+	for i := range s.Spending {
+		s.Spending[i].Total = roundAmount(s.Spending[i].Total)
+	}
+}
+
+// roundRating は評価を小数第1位で丸めます
+func roundRating(rating float64) float64 {
+	return math.Round(rating*10) / 10
+}
@@ -0,0 +1,263 @@
+package entity
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/common"
+)
+
+// PrefectureCount は都道府県の数です
+const PrefectureCount = 47
+
+// prefectureDataset は都道府県と主な温泉地の市町村のデータです
+//
+//go:embed data/prefectures.json
+var prefectureDataset []byte
+
+// Prefecture は都道府県を表します（コードはJIS X 0401の2桁の都道府県コード）
+// OnsenMunicipalitiesは都道府県名のない所在地から都道府県を推定するための主な温泉地の市町村です
+type Prefecture struct {
+	Code                string   `json:"code"`
+	Name                string   `json:"name"`
+	ShortName           string   `json:"short_name"`
+	Kana                string   `json:"kana"`
+	Romaji              string   `json:"romaji"`
+	Region              string   `json:"region"`
+	OnsenMunicipalities []string `json:"onsen_municipalities"`
+}
+
+// prefectureData は埋め込みデータの形式です
+// IrregularMunicipalitiesは名前の途中に「市」「町」「村」「郡」などを含む市区町村・郡です
+type prefectureData struct {
+	Prefectures             []Prefecture `json:"prefectures"`
+	IrregularMunicipalities []string     `json:"irregular_municipalities"`
+}
+
+// 埋め込みデータから作成する都道府県の一覧と検索用のデータ
+var (
+	prefectures             []Prefecture
+	irregularMunicipalities []string
+	onsenMunicipalities     []municipalityRef
+)
+
+// municipalityRef は市町村と所属する都道府県の組です
+type municipalityRef struct {
+	name       string
+	prefecture *Prefecture
+}
+
+// municipalityPattern は郡を除いて所在地の先頭から市区町村名を取り出す正規表現です
+var municipalityPattern = regexp.MustCompile(`^(?:.+?郡)?(.+?[市区町村])`)
+
+func init() {
+	var data prefectureData
+	if err := json.Unmarshal(prefectureDataset, &data); err != nil {
+		panic("都道府県データの読み込みに失敗しました: " + err.Error())
+	}
+	if len(data.Prefectures) != PrefectureCount {
+		panic("都道府県データの件数が不正です")
+	}
+
+	prefectures = data.Prefectures
+	irregularMunicipalities = sortByLengthDesc(data.IrregularMunicipalities)
+	for i := range prefectures {
+		for _, name := range prefectures[i].OnsenMunicipalities {
+			onsenMunicipalities = append(onsenMunicipalities, municipalityRef{name: name, prefecture: &prefectures[i]})
+		}
+	}
+	sort.SliceStable(onsenMunicipalities, func(i, j int) bool {
+		return len(onsenMunicipalities[i].name) > len(onsenMunicipalities[j].name)
+	})
+}
+
+// Prefectures は47都道府県をコード順に返します
+func Prefectures() []Prefecture {
+	result := make([]Prefecture, len(prefectures))
+	copy(result, prefectures)
+	return result
+}
+
+// FindPrefecture はコード・都道府県名・「県」などを除いた名前・読み仮名・ローマ字から都道府県を検索します
+func FindPrefecture(value string) (Prefecture, bool) {
+	normalized := common.NormalizeSearchText(value)
+	if normalized == "" {
+		return Prefecture{}, false
+	}
+	if len(normalized) == 1 {
+		// "1" のような1桁のコードも受け付ける
+		normalized = "0" + normalized
+	}
+	for _, prefecture := range prefectures {
+		if normalized == prefecture.Code ||
+			normalized == prefecture.Name ||
+			normalized == prefecture.ShortName ||
+			normalized == prefecture.Kana ||
+			normalized == prefecture.Romaji {
+			return prefecture, true
+		}
+	}
+	return Prefecture{}, false
+}
+
+// LocationArea は所在地から判定した都道府県と市区町村です
+// Unresolvedは所在地から都道府県を判定できなかったことを表します
+type LocationArea struct {
+	PrefectureCode string `json:"prefecture_code" bson:"prefecture_code"`
+	Prefecture     string `json:"prefecture" bson:"prefecture"`
+	Municipality   string `json:"municipality" bson:"municipality"`
+	Unresolved     bool   `json:"unresolved" bson:"unresolved"`
+}
+
+// ResolveLocationArea は所在地から都道府県と市区町村を判定します
+// 都道府県名（「県」などの省略・読み仮名も可）を優先し、都道府県名がない場合は主な温泉地の市町村名から推定します
+func ResolveLocationArea(location string) LocationArea {
+	normalized := common.NormalizeText(location)
+	if normalized == "" {
+		return LocationArea{Unresolved: true}
+	}
+
+	if prefecture, rest, ok := matchPrefecture(normalized); ok {
+		return LocationArea{
+			PrefectureCode: prefecture.Code,
+			Prefecture:     prefecture.Name,
+			Municipality:   parseMunicipality(rest),
+		}
+	}
+
+	for _, ref := range onsenMunicipalities {
+		if strings.Contains(normalized, ref.name) {
+			return LocationArea{
+				PrefectureCode: ref.prefecture.Code,
+				Prefecture:     ref.prefecture.Name,
+				Municipality:   ref.name,
+			}
+		}
+	}
+
+	return LocationArea{Unresolved: true}
+}
+
+// matchPrefecture は所在地に含まれる都道府県と、都道府県名より後ろの部分を返します
+// 正式名称は所在地のどこにあっても一致させ、省略した名前・読み仮名・ローマ字は先頭のみ一致させます
+func matchPrefecture(location string) (*Prefecture, string, bool) {
+	var found *Prefecture
+	foundIndex := -1
+	for i := range prefectures {
+		index := strings.Index(location, prefectures[i].Name)
+		if index >= 0 && (foundIndex < 0 || index < foundIndex) {
+			found = &prefectures[i]
+			foundIndex = index
+		}
+	}
+	if found != nil {
+		return found, location[foundIndex+len(found.Name):], true
+	}
+
+	for i := range prefectures {
+		for _, name := range []string{prefectures[i].ShortName, prefectures[i].Kana, prefectures[i].Romaji} {
+			if strings.HasPrefix(location, name) {
+				return &prefectures[i], strings.TrimPrefix(location, name), true
+			}
+		}
+	}
+
+	return nil, "", false
+}
+
+// parseMunicipality は都道府県名より後ろの部分から市区町村名を取り出します（郡は除きます）
+// 政令指定都市の区は市までを返します（判定できない場合は空文字）
+func parseMunicipality(rest string) string {
+	for rest != "" {
+		irregular := ""
+		for _, name := range irregularMunicipalities {
+			if strings.HasPrefix(rest, name) {
+				irregular = name
+				break
+			}
+		}
+		if irregular == "" {
+			break
+		}
+		if !strings.HasSuffix(irregular, "郡") {
+			return irregular
+		}
+		rest = strings.TrimPrefix(rest, irregular)
+	}
+
+	if match := municipalityPattern.FindStringSubmatch(rest); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// sortByLengthDesc は前方一致で長い名前を優先するため、文字列を長い順に並べ替えます
+func sortByLengthDesc(values []string) []string {
+	sorted := make([]string, len(values))
+	copy(sorted, values)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+	return sorted
+}
+
+// PrefectureVisitSummary は都道府県ごとの訪問集計です（都道府県コードが空の場合は判定できなかった温泉メモ）
+type PrefectureVisitSummary struct {
+	PrefectureCode string    `json:"prefecture_code" bson:"_id"`
+	VisitCount     int       `json:"visit_count" bson:"visit_count"`
+	FirstVisit     time.Time `json:"first_visit" bson:"first_visit"`
+	LastVisit      time.Time `json:"last_visit" bson:"last_visit"`
+}
+
+// PrefectureCompletion は都道府県ごとの訪問状況です
+type PrefectureCompletion struct {
+	Prefecture Prefecture
+	Visited    bool
+	VisitCount int
+	FirstVisit *time.Time
+	LastVisit  *time.Time
+}
+
+// PrefectureCompletionMap は47都道府県の制覇状況です
+type PrefectureCompletionMap struct {
+	Prefectures     []PrefectureCompletion
+	VisitedCount    int
+	UnresolvedCount int
+}
+
+// NewPrefectureCompletionMap は都道府県ごとの訪問集計から47都道府県の制覇状況を作成します
+func NewPrefectureCompletionMap(summaries []*PrefectureVisitSummary) *PrefectureCompletionMap {
+	byCode := make(map[string]*PrefectureVisitSummary, len(summaries))
+	completionMap := &PrefectureCompletionMap{}
+	for _, summary := range summaries {
+		if summary.PrefectureCode == "" {
+			completionMap.UnresolvedCount += summary.VisitCount
+			continue
+		}
+		byCode[summary.PrefectureCode] = summary
+	}
+
+	for _, prefecture := range prefectures {
+		completion := PrefectureCompletion{Prefecture: prefecture}
+		if summary, ok := byCode[prefecture.Code]; ok && summary.VisitCount > 0 {
+			firstVisit, lastVisit := summary.FirstVisit, summary.LastVisit
+			completion.Visited = true
+			completion.VisitCount = summary.VisitCount
+			completion.FirstVisit = &firstVisit
+			completion.LastVisit = &lastVisit
+			completionMap.VisitedCount++
+		}
+		completionMap.Prefectures = append(completionMap.Prefectures, completion)
+	}
+
+	return completionMap
+}
+
+// CompletionRate は制覇率（%、小数第1位まで）を返します
+func (m *PrefectureCompletionMap) CompletionRate() float64 {
+	return roundRating(float64(m.VisitedCount) / PrefectureCount * 100)
+}
@@ -0,0 +1,137 @@
+package entity
+
+import (
+	"math"
+)
+
+// RatingCriterion は項目別評価の項目を表す型です（値はMongoDBのフィールド名と同じ）
+type RatingCriterion string
+
+// 評価項目の定数
+const (
+	RatingCriterionWaterQuality  RatingCriterion = "water_quality"
+	RatingCriterionCleanliness   RatingCriterion = "cleanliness"
+	RatingCriterionScenery       RatingCriterion = "scenery"
+	RatingCriterionCrowding      RatingCriterion = "crowding"
+	RatingCriterionValueForMoney RatingCriterion = "value_for_money"
+)
+
+// RatingCriteria は評価項目の一覧です（CSVの列などはこの順に並びます）
+var RatingCriteria = []RatingCriterion{
+	RatingCriterionWaterQuality,
+	RatingCriterionCleanliness,
+	RatingCriterionScenery,
+	RatingCriterionCrowding,
+	RatingCriterionValueForMoney,
+}
+
+// Label は評価項目の日本語名を返します
+func (c RatingCriterion) Label() string {
+	switch c {
+	case RatingCriterionWaterQuality:
+		return "湯質"
+	case RatingCriterionCleanliness:
+		return "清潔さ"
+	case RatingCriterionScenery:
+		return "景観"
+	case RatingCriterionCrowding:
+		return "空き具合"
+	case RatingCriterionValueForMoney:
+		return "コストパフォーマンス"
+	default:
+		return string(c)
+	}
+}
+
+// IsValid は評価項目が定義済みかどうかを返します
+func (c RatingCriterion) IsValid() bool {
+	for _, criterion := range RatingCriteria {
+		if c == criterion {
+			return true
+		}
+	}
+	return false
+}
+
+// RatingScores は項目別の評価です（各項目は1〜5、0は未評価）
+// Crowdingは空いているほど高い評価になります
+type RatingScores struct {
+	WaterQuality  int `json:"water_quality" bson:"water_quality"`
+	Cleanliness   int `json:"cleanliness" bson:"cleanliness"`
+	Scenery       int `json:"scenery" bson:"scenery"`
+	Crowding      int `json:"crowding" bson:"crowding"`
+	ValueForMoney int `json:"value_for_money" bson:"value_for_money"`
+}
+
+// Score は評価項目の点数を返します
+func (s RatingScores) Score(criterion RatingCriterion) int {
+	switch criterion {
+	case RatingCriterionWaterQuality:
+		return s.WaterQuality
+	case RatingCriterionCleanliness:
+		return s.Cleanliness
+	case RatingCriterionScenery:
+		return s.Scenery
+	case RatingCriterionCrowding:
+		return s.Crowding
+	case RatingCriterionValueForMoney:
+		return s.ValueForMoney
+	default:
+		return 0
+	}
+}
+
+// SetScore は評価項目の点数を設定します
+func (s *RatingScores) SetScore(criterion RatingCriterion, score int) {
+	switch criterion {
+	case RatingCriterionWaterQuality:
+		s.WaterQuality = score
+	case RatingCriterionCleanliness:
+		s.Cleanliness = score
+	case RatingCriterionScenery:
+		s.Scenery = score
+	case RatingCriterionCrowding:
+		s.Crowding = score
+	case RatingCriterionValueForMoney:
+		s.ValueForMoney = score
+	}
+}
+
+// IsEmpty はすべての項目が未評価かどうかを返します
+func (s RatingScores) IsEmpty() bool {
+	return s.Overall() == 0
+}
+
+// Overall は評価済みの項目の平均を小数第1位で丸めて返します（すべて未評価の場合は0）
+func (s RatingScores) Overall() float64 {
+	total, count := 0, 0
+	for _, criterion := range RatingCriteria {
+		if score := s.Score(criterion); score > 0 {
+			total += score
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return math.Round(float64(total)/float64(count)*10) / 10
+}
+
+// Validate は各項目が0〜5の範囲内かどうかを検証します
+func (s RatingScores) Validate() bool {
+	for _, criterion := range RatingCriteria {
+		if score := s.Score(criterion); score < 0 || score > 5 {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveOverallRating は総合評価を決定します
+// 総合評価が指定されていればそれを優先し（手動指定）、なければ項目別評価の平均を使用します
+func resolveOverallRating(rating *float64, scores RatingScores) (float64, bool) {
+	if rating != nil {
+		return *rating, true
+	}
+	return scores.Overall(), false
+}
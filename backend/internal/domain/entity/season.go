@@ -0,0 +1,51 @@
+package entity
+
+import "time"
+
+// Season は季節を表す型です
+type Season string
+
+// 季節の定数
+const (
+	SeasonSpring Season = "春"
+	SeasonSummer Season = "夏"
+	SeasonAutumn Season = "秋"
+	SeasonWinter Season = "冬"
+)
+
+// Seasons は季節の一覧です
+var Seasons = []Season{
+	SeasonSpring,
+	SeasonSummer,
+	SeasonAutumn,
+	SeasonWinter,
+}
+
+// IsValid は季節が定義済みかどうかを返します
+func (s Season) IsValid() bool {
+	for _, season := range Seasons {
+		if s == season {
+			return true
+		}
+	}
+	return false
+}
+
+// SeasonOf は日付の季節を返します（3〜5月は春、6〜8月は夏、9〜11月は秋、12〜2月は冬）
+func SeasonOf(date time.Time) Season {
+	return SeasonOfMonth(date.Month())
+}
+
+// SeasonOfMonth は月の季節を返します
+func SeasonOfMonth(month time.Month) Season {
+	switch month {
+	case time.March, time.April, time.May:
+		return SeasonSpring
+	case time.June, time.July, time.August:
+		return SeasonSummer
+	case time.September, time.October, time.November:
+		return SeasonAutumn
+	default:
+		return SeasonWinter
+	}
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 共有リンクの設定値
+const (
+	// ShareTokenBytes は共有トークンの乱数のバイト数です
+	ShareTokenBytes = 32
+	// MaxShareLinkDuration は共有リンクの有効期間の上限です
+	MaxShareLinkDuration = 365 * 24 * time.Hour
+)
+
+// ShareLink は温泉メモを読み取り専用で公開する共有リンクを表すエンティティです
+// ExpiresAtがnilの場合は無期限で、取り消されるまで有効です
+type ShareLink struct {
+	ID         primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID       string             `json:"uuid" bson:"uuid"`
+	UserID     string             `json:"user_id" bson:"user_id"`
+	OnsenLogID string             `json:"onsen_log_id" bson:"onsen_log_id"`
+	Token      string             `json:"token" bson:"token"`
+	ExpiresAt  *time.Time         `json:"expires_at" bson:"expires_at"`
+	RevokedAt  *time.Time         `json:"revoked_at" bson:"revoked_at"`
+	CreatedAt  time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// NewShareLink は新しい共有リンクエンティティを作成します
+// expiresInが0の場合は無期限の共有リンクになります
+func NewShareLink(userID, onsenLogID string, expiresIn time.Duration) (*ShareLink, error) {
+	token, err := generateShareToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	shareLink := &ShareLink{
+		UUID:       uuid.New().String(),
+		UserID:     userID,
+		OnsenLogID: onsenLogID,
+		Token:      token,
+		CreatedAt:  now,
+	}
+	if expiresIn > 0 {
+		expiresAt := now.Add(expiresIn)
+		shareLink.ExpiresAt = &expiresAt
+	}
+
+	return shareLink, nil
+}
+
+// IsExpired は共有リンクの有効期限が切れているかどうかを返します
+func (l *ShareLink) IsExpired(now time.Time) bool {
+	return l.ExpiresAt != nil && !now.Before(*l.ExpiresAt)
+}
+
+// IsRevoked は共有リンクが取り消されているかどうかを返します
+func (l *ShareLink) IsRevoked() bool {
+	return l.RevokedAt != nil
+}
+
+// IsActive は共有リンクが有効かどうかを返します
+func (l *ShareLink) IsActive(now time.Time) bool {
+	return !l.IsRevoked() && !l.IsExpired(now)
+}
+
+// Revoke は共有リンクを取り消します
+func (l *ShareLink) Revoke() {
+	now := time.Now()
+	l.RevokedAt = &now
+}
+
+// generateShareToken はURLに含められる推測困難な共有トークンを生成します
+func generateShareToken() (string, error) {
+	b := make([]byte, ShareTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
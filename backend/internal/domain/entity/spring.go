@@ -0,0 +1,222 @@
+package entity
+
+import (
+	"strings"
+)
+
+// SpringType は温泉の泉質を表す型です（温泉法に基づく療養泉の泉質分類）
+type SpringType string
+
+// 泉質の定数
+const (
+	SpringTypeSimple        SpringType = "単純温泉"
+	SpringTypeChloride      SpringType = "塩化物泉"
+	SpringTypeBicarbonate   SpringType = "炭酸水素塩泉"
+	SpringTypeSulfate       SpringType = "硫酸塩泉"
+	SpringTypeCarbonDioxide SpringType = "二酸化炭素泉"
+	SpringTypeIron          SpringType = "含鉄泉"
+	SpringTypeAcidic        SpringType = "酸性泉"
+	SpringTypeIodine        SpringType = "含よう素泉"
+	SpringTypeSulfur        SpringType = "硫黄泉"
+	SpringTypeRadioactive   SpringType = "放射能泉"
+)
+
+// SpringTypes は療養泉の泉質の一覧です
+var SpringTypes = []SpringType{
+	SpringTypeSimple,
+	SpringTypeChloride,
+	SpringTypeBicarbonate,
+	SpringTypeSulfate,
+	SpringTypeCarbonDioxide,
+	SpringTypeIron,
+	SpringTypeAcidic,
+	SpringTypeIodine,
+	SpringTypeSulfur,
+	SpringTypeRadioactive,
+}
+
+// IsValid は泉質が療養泉の分類に含まれるかどうかを返します
+func (t SpringType) IsValid() bool {
+	for _, springType := range SpringTypes {
+		if t == springType {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeSpringTypes は泉質の重複と空の値を取り除きます
+func NormalizeSpringTypes(springTypes []SpringType) []SpringType {
+	seen := make(map[SpringType]bool)
+	normalized := make([]SpringType, 0, len(springTypes))
+	for _, springType := range springTypes {
+		if springType == "" || seen[springType] {
+			continue
+		}
+		seen[springType] = true
+		normalized = append(normalized, springType)
+	}
+	return normalized
+}
+
+// ValidateSpringTypes はすべての泉質が療養泉の分類に含まれるかどうかを検証します
+func ValidateSpringTypes(springTypes []SpringType) bool {
+	for _, springType := range springTypes {
+		if !springType.IsValid() {
+			return false
+		}
+	}
+	return true
+}
+
+// Tonicity は浸透圧による分類を表す型です
+type Tonicity string
+
+// 浸透圧の分類の定数
+const (
+	TonicityHypotonic  Tonicity = "低張性"
+	TonicityIsotonic   Tonicity = "等張性"
+	TonicityHypertonic Tonicity = "高張性"
+)
+
+// IsValid は浸透圧の分類が定義済みかどうかを返します（未設定も有効）
+func (t Tonicity) IsValid() bool {
+	switch t {
+	case "", TonicityHypotonic, TonicityIsotonic, TonicityHypertonic:
+		return true
+	default:
+		return false
+	}
+}
+
+// LiquidClass はpH値による液性の分類を表す型です
+type LiquidClass string
+
+// 液性の分類の定数
+const (
+	LiquidClassAcidic       LiquidClass = "酸性"
+	LiquidClassWeakAcidic   LiquidClass = "弱酸性"
+	LiquidClassNeutral      LiquidClass = "中性"
+	LiquidClassWeakAlkaline LiquidClass = "弱アルカリ性"
+	LiquidClassAlkaline     LiquidClass = "アルカリ性"
+)
+
+// TemperatureClass は源泉温度による分類を表す型です
+type TemperatureClass string
+
+// 源泉温度の分類の定数
+const (
+	TemperatureClassCold TemperatureClass = "冷鉱泉"
+	TemperatureClassLow  TemperatureClass = "低温泉"
+	TemperatureClassWarm TemperatureClass = "温泉"
+	TemperatureClassHigh TemperatureClass = "高温泉"
+)
+
+// WaterAnalysis は温泉分析書の測定値です（未測定の値はnil）
+type WaterAnalysis struct {
+	// SourceTemperature は源泉温度（℃）です
+	SourceTemperature *float64 `json:"source_temperature" bson:"source_temperature"`
+	// PH はpH値です
+	PH *float64 `json:"ph" bson:"ph"`
+	// TotalDissolvedSolids は溶存物質の総量（mg/kg）です
+	TotalDissolvedSolids *float64 `json:"total_dissolved_solids" bson:"total_dissolved_solids"`
+	// Tonicity は浸透圧の分類です（未指定の場合は溶存物質の総量から判定）
+	Tonicity Tonicity `json:"tonicity" bson:"tonicity"`
+}
+
+// Validate は測定値が有効な範囲内かどうかを検証します
+func (a WaterAnalysis) Validate() bool {
+	if a.PH != nil && (*a.PH < 0 || *a.PH > 14) {
+		return false
+	}
+	if a.SourceTemperature != nil && (*a.SourceTemperature < -10 || *a.SourceTemperature > 150) {
+		return false
+	}
+	if a.TotalDissolvedSolids != nil && *a.TotalDissolvedSolids < 0 {
+		return false
+	}
+	return a.Tonicity.IsValid()
+}
+
+// ResolvedTonicity は浸透圧の分類を返します
+// 指定がなければ溶存物質の総量（8g/kg未満は低張性、10g/kg未満は等張性、それ以上は高張性）から判定します
+func (a WaterAnalysis) ResolvedTonicity() Tonicity {
+	if a.Tonicity != "" || a.TotalDissolvedSolids == nil {
+		return a.Tonicity
+	}
+	switch tds := *a.TotalDissolvedSolids; {
+	case tds < 8000:
+		return TonicityHypotonic
+	case tds < 10000:
+		return TonicityIsotonic
+	default:
+		return TonicityHypertonic
+	}
+}
+
+// LiquidClass はpH値から液性の分類を返します（pH値が未測定の場合は空文字）
+func (a WaterAnalysis) LiquidClass() LiquidClass {
+	if a.PH == nil {
+		return ""
+	}
+	switch ph := *a.PH; {
+	case ph < 3:
+		return LiquidClassAcidic
+	case ph < 6:
+		return LiquidClassWeakAcidic
+	case ph < 7.5:
+		return LiquidClassNeutral
+	case ph < 8.5:
+		return LiquidClassWeakAlkaline
+	default:
+		return LiquidClassAlkaline
+	}
+}
+
+// TemperatureClass は源泉温度から分類を返します（源泉温度が未測定の場合は空文字）
+func (a WaterAnalysis) TemperatureClass() TemperatureClass {
+	if a.SourceTemperature == nil {
+		return ""
+	}
+	switch temperature := *a.SourceTemperature; {
+	case temperature < 25:
+		return TemperatureClassCold
+	case temperature < 34:
+		return TemperatureClassLow
+	case temperature < 42:
+		return TemperatureClassWarm
+	default:
+		return TemperatureClassHigh
+	}
+}
+
+// legacySpringTypes は以前の泉質の値と療養泉の泉質の対応です
+// 「アルカリ泉」「その他」「不明」は泉質を特定できないため対応させません
+var legacySpringTypes = map[string]SpringType{
+	"単純温泉":  SpringTypeSimple,
+	"塩化物泉":  SpringTypeChloride,
+	"炭酸泉":   SpringTypeCarbonDioxide,
+	"酸性泉":   SpringTypeAcidic,
+	"鉄泉":    SpringTypeIron,
+	"硫黄泉":   SpringTypeSulfur,
+	"ラジウム泉": SpringTypeRadioactive,
+}
+
+// SpringTypeFromLegacy は以前の泉質の値を療養泉の泉質に変換します
+// 対応する泉質がない場合はfalseを返します
+func SpringTypeFromLegacy(legacy string) (SpringType, bool) {
+	if springType := SpringType(legacy); springType.IsValid() {
+		return springType, true
+	}
+	springType, ok := legacySpringTypes[legacy]
+	return springType, ok
+}
+
+// JoinSpringTypes は泉質を区切り文字でつないだ文字列にします
+func JoinSpringTypes(springTypes []SpringType, sep string) string {
+	names := make([]string, len(springTypes))
+	for i, springType := range springTypes {
+		names[i] = string(springType)
+	}
+	return strings.Join(names, sep)
+}
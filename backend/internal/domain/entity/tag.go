@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// タグの制限値
+const (
+	MaxTagsPerOnsenLog = 20
+	MaxTagLength       = 30
+)
+
+// TagMatchMode は複数タグで絞り込むときの一致条件です
+type TagMatchMode string
+
+// タグの一致条件の定数
+const (
+	// TagMatchAny はいずれかのタグを含む温泉メモに一致します
+	TagMatchAny TagMatchMode = "any"
+	// TagMatchAll はすべてのタグを含む温泉メモに一致します
+	TagMatchAll TagMatchMode = "all"
+)
+
+// TagUsage はタグとその使用回数を表します
+type TagUsage struct {
+	Name       string    `json:"name" bson:"_id"`
+	Count      int       `json:"count" bson:"count"`
+	LastUsedAt time.Time `json:"last_used_at" bson:"last_used_at"`
+}
+
+// NormalizeTags はタグの前後の空白を取り除き、空のタグと重複を除きます
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool)
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// NormalizeTag はタグの前後の空白（全角を含む）を取り除きます
+func NormalizeTag(tag string) string {
+	return strings.TrimSpace(tag)
+}
+
+// RenameTag は温泉メモのタグを置き換えます
+// 置き換え先のタグが既に付いている場合は、置き換え元のタグを取り除きます
+func (o *OnsenLog) RenameTag(from, to string) {
+	tags := make([]string, len(o.Tags))
+	for i, tag := range o.Tags {
+		if tag == from {
+			tag = to
+		}
+		tags[i] = tag
+	}
+	o.Tags = NormalizeTags(tags)
+}
+
+// RemoveTag は温泉メモからタグを取り除きます
+func (o *OnsenLog) RemoveTag(name string) {
+	tags := make([]string, 0, len(o.Tags))
+	for _, tag := range o.Tags {
+		if tag != name {
+			tags = append(tags, tag)
+		}
+	}
+	o.Tags = tags
+}
+
+// ValidateTags はタグの数と長さが制限内かどうかを検証します
+func ValidateTags(tags []string) bool {
+	if len(tags) > MaxTagsPerOnsenLog {
+		return false
+	}
+	for _, tag := range tags {
+		if !ValidateTag(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateTag はタグが空でなく長さの制限内かどうかを検証します
+func ValidateTag(tag string) bool {
+	length := len([]rune(tag))
+	return length > 0 && length <= MaxTagLength
+}
+
+// ParseTagMatchMode は文字列をタグの一致条件に変換します（不明な値はanyとして扱います）
+func ParseTagMatchMode(s string) TagMatchMode {
+	if TagMatchMode(strings.ToLower(s)) == TagMatchAll {
+		return TagMatchAll
+	}
+	return TagMatchAny
+}
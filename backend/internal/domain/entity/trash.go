@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// DefaultTrashRetention はゴミ箱の温泉メモと画像を完全に削除するまでのデフォルトの保持期間です
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// TrashPurgeAt はゴミ箱の温泉メモが完全に削除される予定日時を返します
+// ゴミ箱にない温泉メモの場合はnilを返します
+func TrashPurgeAt(onsenLog *OnsenLog, retention time.Duration) *time.Time {
+	if !onsenLog.IsDeleted() {
+		return nil
+	}
+	purgeAt := onsenLog.DeletedAt.Add(retention)
+	return &purgeAt
+}
+
+// TrashImagePurgeAt はゴミ箱の温泉画像が完全に削除される予定日時を返します
+// ゴミ箱にない温泉画像の場合はnilを返します
+func TrashImagePurgeAt(image *OnsenImage, retention time.Duration) *time.Time {
+	if !image.IsDeleted() {
+		return nil
+	}
+	purgeAt := image.DeletedAt.Add(retention)
+	return &purgeAt
+}
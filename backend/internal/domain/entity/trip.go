@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 旅行の入力値の上限
+const (
+	MaxTripTitleLength  = 100
+	MaxTripNotesLength  = 2000
+	MaxOnsenLogsPerTrip = 100
+)
+
+// Trip は複数の温泉メモをまとめた旅行を表すエンティティです
+// OnsenLogIDsは旅程の順に並べた温泉メモのIDで、削除された温泉メモのIDは取得時に除かれます
+type Trip struct {
+	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID        string             `json:"uuid" bson:"uuid"`
+	UserID      string             `json:"user_id" bson:"user_id"`
+	Title       string             `json:"title" bson:"title"`
+	StartDate   time.Time          `json:"start_date" bson:"start_date"`
+	EndDate     time.Time          `json:"end_date" bson:"end_date"`
+	Notes       string             `json:"notes" bson:"notes"`
+	OnsenLogIDs []string           `json:"onsen_log_ids" bson:"onsen_log_ids"`
+	CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// TripParams は旅行の作成・更新に使用する入力値です
+type TripParams struct {
+	Title       string
+	StartDate   time.Time
+	EndDate     time.Time
+	Notes       string
+	OnsenLogIDs []string
+}
+
+// Normalize は入力値の前後の空白を取り除き、温泉メモのIDの空の値と重複を除きます
+func (p TripParams) Normalize() TripParams {
+	p.Title = strings.TrimSpace(p.Title)
+	p.Notes = strings.TrimSpace(p.Notes)
+
+	seen := make(map[string]bool)
+	onsenLogIDs := make([]string, 0, len(p.OnsenLogIDs))
+	for _, id := range p.OnsenLogIDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		onsenLogIDs = append(onsenLogIDs, id)
+	}
+	p.OnsenLogIDs = onsenLogIDs
+	return p
+}
+
+// NewTrip は新しい旅行エンティティを作成します
+func NewTrip(userID string, params TripParams) *Trip {
+	now := time.Now()
+	trip := &Trip{
+		UUID:      uuid.New().String(),
+		UserID:    userID,
+		CreatedAt: now,
+	}
+	trip.apply(params)
+	trip.UpdatedAt = now
+	return trip
+}
+
+// Update は旅行の情報を更新します
+func (t *Trip) Update(params TripParams) {
+	t.apply(params)
+	t.UpdatedAt = time.Now()
+}
+
+// apply は入力値を旅行に反映します
+func (t *Trip) apply(params TripParams) {
+	params = params.Normalize()
+	t.Title = params.Title
+	t.StartDate = params.StartDate
+	t.EndDate = params.EndDate
+	t.Notes = params.Notes
+	t.OnsenLogIDs = params.OnsenLogIDs
+}
+
+// Days は旅行の日数を返します（開始日と終了日を含みます）
+func (t *Trip) Days() int {
+	return int(t.EndDate.Sub(t.StartDate).Hours()/24) + 1
+}
+
+// IsUpcoming は旅行が今後の予定（終了日がtoday以降）かどうかを返します
+func (t *Trip) IsUpcoming(today time.Time) bool {
+	return !t.EndDate.Before(today)
+}
+
+// TripStats は旅行に含まれる温泉メモの統計です
+// AverageRatingは総合評価のある温泉メモの平均で、費用は通貨ごとに合計します
+type TripStats struct {
+	BathCount     int                `json:"bath_count"`
+	Days          int                `json:"days"`
+	AverageRating float64            `json:"average_rating"`
+	Spending      []CurrencySpending `json:"spending"`
+}
+
+// NewTripStats は旅行と旅程順の温泉メモから統計を作成します
+func NewTripStats(trip *Trip, onsenLogs []*OnsenLog) TripStats {
+	stats := TripStats{
+		BathCount: len(onsenLogs),
+		Days:      trip.Days(),
+		Spending:  []CurrencySpending{},
+	}
+
+	ratingTotal, ratedCount := 0.0, 0
+	spendingIndex := make(map[string]int)
+	for _, onsenLog := range onsenLogs {
+		if onsenLog.Rating > 0 {
+			ratingTotal += onsenLog.Rating
+			ratedCount++
+		}
+
+		currency := onsenLog.Visit.Currency
+		if currency == "" {
+			continue
+		}
+		index, ok := spendingIndex[currency]
+		if !ok {
+			index = len(stats.Spending)
+			spendingIndex[currency] = index
+			stats.Spending = append(stats.Spending, CurrencySpending{Currency: currency})
+		}
+		stats.Spending[index].Total = roundAmount(stats.Spending[index].Total + onsenLog.Visit.TotalCost)
+		stats.Spending[index].VisitCount++
+	}
+
+	if ratedCount > 0 {
+		stats.AverageRating = roundRating(ratingTotal / float64(ratedCount))
+	}
+
+	return stats
+}
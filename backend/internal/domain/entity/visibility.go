@@ -0,0 +1,37 @@
+package entity
+
+// Visibility は温泉メモの公開範囲を表す型です
+type Visibility string
+
+// 公開範囲の定数
+const (
+	// VisibilityPrivate は作成者のみが閲覧できます
+	VisibilityPrivate Visibility = "private"
+	// VisibilityFollowers は作成者をフォローしているユーザーも閲覧できます
+	VisibilityFollowers Visibility = "followers"
+	// VisibilityPublic はすべてのユーザーが閲覧できます
+	VisibilityPublic Visibility = "public"
+)
+
+// IsValid は公開範囲が有効かどうかを返します（空の場合は非公開として扱うため有効です）
+func (v Visibility) IsValid() bool {
+	switch v {
+	case "", VisibilityPrivate, VisibilityFollowers, VisibilityPublic:
+		return true
+	}
+	return false
+}
+
+// OrDefault は空の公開範囲を非公開として返します
+// 公開範囲の導入前に作成された温泉メモも非公開として扱います
+func (v Visibility) OrDefault() Visibility {
+	if v == "" {
+		return VisibilityPrivate
+	}
+	return v
+}
+
+// FollowerVisibilities はフォロワーが閲覧できる公開範囲のリストを返します
+func FollowerVisibilities() []Visibility {
+	return []Visibility{VisibilityFollowers, VisibilityPublic}
+}
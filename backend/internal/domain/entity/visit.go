@@ -0,0 +1,241 @@
+package entity
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// 訪問情報の入力値の上限
+const (
+	MaxVisitDurationMinutes = 7 * 24 * 60
+	MaxOtherCostsPerVisit   = 10
+	MaxCostLabelLength      = 30
+	DefaultCurrency         = "JPY"
+	arrivalTimeLayout       = "15:04"
+)
+
+// currencyCodePattern はISO 4217の通貨コード（英大文字3文字）の形式です
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// StayType は日帰り・宿泊の区分を表す型です
+type StayType string
+
+// 日帰り・宿泊の区分の定数
+const (
+	StayTypeDayTrip   StayType = "日帰り"
+	StayTypeOvernight StayType = "宿泊"
+)
+
+// IsValid は日帰り・宿泊の区分が有効かどうかを返します（未指定も有効）
+func (s StayType) IsValid() bool {
+	return s == "" || s == StayTypeDayTrip || s == StayTypeOvernight
+}
+
+// Companion は同行者の区分を表す型です
+type Companion string
+
+// 同行者の区分の定数
+const (
+	CompanionSolo      Companion = "ひとり"
+	CompanionFamily    Companion = "家族"
+	CompanionPartner   Companion = "パートナー"
+	CompanionFriends   Companion = "友人"
+	CompanionColleague Companion = "同僚"
+	CompanionOther     Companion = "その他"
+)
+
+// Companions は同行者の区分の一覧です
+var Companions = []Companion{
+	CompanionSolo,
+	CompanionFamily,
+	CompanionPartner,
+	CompanionFriends,
+	CompanionColleague,
+	CompanionOther,
+}
+
+// IsValid は同行者の区分が定義済みかどうかを返します
+func (c Companion) IsValid() bool {
+	for _, companion := range Companions {
+		if c == companion {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeCompanions は同行者の区分の前後の空白を取り除き、空の値と重複を除きます
+func NormalizeCompanions(companions []Companion) []Companion {
+	normalized := make([]Companion, 0, len(companions))
+	seen := make(map[Companion]bool)
+	for _, companion := range companions {
+		companion = Companion(strings.TrimSpace(string(companion)))
+		if companion == "" || seen[companion] {
+			continue
+		}
+		seen[companion] = true
+		normalized = append(normalized, companion)
+	}
+	return normalized
+}
+
+// TimeOfDay は到着時刻の時間帯を表す型です
+type TimeOfDay string
+
+// 時間帯の定数
+const (
+	TimeOfDayMorning   TimeOfDay = "朝"
+	TimeOfDayDaytime   TimeOfDay = "昼"
+	TimeOfDayEvening   TimeOfDay = "夕方"
+	TimeOfDayNight     TimeOfDay = "夜"
+	TimeOfDayLateNight TimeOfDay = "深夜"
+)
+
+// VisitCost は入浴料以外の費用（食事・休憩所・貸しタオルなど）です
+type VisitCost struct {
+	Label  string  `json:"label" bson:"label"`
+	Amount float64 `json:"amount" bson:"amount"`
+}
+
+// VisitDetails は訪問時の状況（到着時刻・滞在時間・同行者・費用）です
+// 到着時刻は "HH:MM" 形式、滞在時間は分単位で、0や空文字は未記録を表します
+// 費用はCurrencyの通貨で記録し、TotalCostは入浴料とその他の費用の合計です
+type VisitDetails struct {
+	ArrivalTime     string      `json:"arrival_time" bson:"arrival_time"`
+	DurationMinutes int         `json:"duration_minutes" bson:"duration_minutes"`
+	StayType        StayType    `json:"stay_type" bson:"stay_type"`
+	Companions      []Companion `json:"companions" bson:"companions"`
+	Currency        string      `json:"currency" bson:"currency"`
+	EntranceFee     *float64    `json:"entrance_fee" bson:"entrance_fee"`
+	OtherCosts      []VisitCost `json:"other_costs" bson:"other_costs"`
+	TotalCost       float64     `json:"total_cost" bson:"total_cost"`
+}
+
+// Normalize は訪問時の状況を保存用に整えます
+// 到着時刻の形式を揃え、費用が記録されていて通貨が未指定の場合は日本円とし、合計費用を再計算します
+func (v VisitDetails) Normalize() VisitDetails {
+	v.ArrivalTime = strings.TrimSpace(v.ArrivalTime)
+	if arrivalTime, ok := NormalizeArrivalTime(v.ArrivalTime); ok {
+		v.ArrivalTime = arrivalTime
+	}
+	v.Companions = NormalizeCompanions(v.Companions)
+	v.Currency = strings.ToUpper(strings.TrimSpace(v.Currency))
+
+	otherCosts := make([]VisitCost, 0, len(v.OtherCosts))
+	for _, cost := range v.OtherCosts {
+		cost.Label = strings.TrimSpace(cost.Label)
+		otherCosts = append(otherCosts, cost)
+	}
+	v.OtherCosts = otherCosts
+
+	if v.Currency == "" && v.HasCosts() {
+		v.Currency = DefaultCurrency
+	}
+	v.TotalCost = v.computeTotalCost()
+	return v
+}
+
+// Validate は訪問時の状況が有効かどうかを検証します
+func (v VisitDetails) Validate() bool {
+	if v.ArrivalTime != "" {
+		if _, err := time.Parse(arrivalTimeLayout, v.ArrivalTime); err != nil {
+			return false
+		}
+	}
+	if v.DurationMinutes < 0 || v.DurationMinutes > MaxVisitDurationMinutes {
+		return false
+	}
+	if !v.StayType.IsValid() {
+		return false
+	}
+	for _, companion := range v.Companions {
+		if !companion.IsValid() {
+			return false
+		}
+	}
+	if v.Currency != "" && !currencyCodePattern.MatchString(v.Currency) {
+		return false
+	}
+	if v.EntranceFee != nil && *v.EntranceFee < 0 {
+		return false
+	}
+	if len(v.OtherCosts) > MaxOtherCostsPerVisit {
+		return false
+	}
+	for _, cost := range v.OtherCosts {
+		if cost.Amount < 0 || len([]rune(cost.Label)) > MaxCostLabelLength {
+			return false
+		}
+	}
+	return true
+}
+
+// HasCosts は費用が記録されているかどうかを返します
+func (v VisitDetails) HasCosts() bool {
+	return v.EntranceFee != nil || len(v.OtherCosts) > 0
+}
+
+// OtherCostTotal は入浴料以外の費用の合計を返します
+func (v VisitDetails) OtherCostTotal() float64 {
+	total := 0.0
+	for _, cost := range v.OtherCosts {
+		total += cost.Amount
+	}
+	return roundAmount(total)
+}
+
+// computeTotalCost は入浴料とその他の費用の合計を計算します
+func (v VisitDetails) computeTotalCost() float64 {
+	total := v.OtherCostTotal()
+	if v.EntranceFee != nil {
+		total += *v.EntranceFee
+	}
+	return roundAmount(total)
+}
+
+// TimeOfDay は到着時刻から時間帯を返します（到着時刻が未記録の場合は空文字）
+// 5時〜10時は朝、10時〜16時は昼、16時〜19時は夕方、19時〜23時は夜、それ以外は深夜です
+func (v VisitDetails) TimeOfDay() TimeOfDay {
+	arrival, err := time.Parse(arrivalTimeLayout, v.ArrivalTime)
+	if err != nil {
+		return ""
+	}
+	switch hour := arrival.Hour(); {
+	case hour >= 5 && hour < 10:
+		return TimeOfDayMorning
+	case hour >= 10 && hour < 16:
+		return TimeOfDayDaytime
+	case hour >= 16 && hour < 19:
+		return TimeOfDayEvening
+	case hour >= 19 && hour < 23:
+		return TimeOfDayNight
+	default:
+		return TimeOfDayLateNight
+	}
+}
+
+// NormalizeArrivalTime は到着時刻を "09:30" の形式に揃えます（時刻として解釈できない場合はfalse）
+// 絞り込みで到着時刻を文字列のまま比較できるように、時を2桁にします
+func NormalizeArrivalTime(arrivalTime string) (string, bool) {
+	arrival, err := time.Parse(arrivalTimeLayout, arrivalTime)
+	if err != nil {
+		return "", false
+	}
+	return arrival.Format(arrivalTimeLayout), true
+}
+
+// JoinCompanions は同行者の区分を区切り文字で連結します
+func JoinCompanions(companions []Companion, sep string) string {
+	values := make([]string, len(companions))
+	for i, companion := range companions {
+		values[i] = string(companion)
+	}
+	return strings.Join(values, sep)
+}
+
+// roundAmount は金額の浮動小数点の誤差を取り除きます（小数第2位まで）
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 行きたい温泉の入力値の上限
+const (
+	MaxWishlistNotesLength = 1000
+)
+
+// WishlistPriority は行きたい温泉の優先度を表す型です（大きいほど優先度が高い）
+type WishlistPriority int
+
+// 優先度の定数
+const (
+	WishlistPriorityLow    WishlistPriority = 1
+	WishlistPriorityMedium WishlistPriority = 2
+	WishlistPriorityHigh   WishlistPriority = 3
+)
+
+// IsValid は優先度が有効かどうかを返します
+func (p WishlistPriority) IsValid() bool {
+	return p >= WishlistPriorityLow && p <= WishlistPriorityHigh
+}
+
+// WishlistStatus は行きたい温泉の訪問状況での絞り込み条件です
+type WishlistStatus string
+
+// 訪問状況の絞り込み条件の定数
+const (
+	// WishlistStatusAll はすべての行きたい温泉に一致します
+	WishlistStatusAll WishlistStatus = "all"
+	// WishlistStatusPending はまだ訪問していない行きたい温泉に一致します
+	WishlistStatusPending WishlistStatus = "pending"
+	// WishlistStatusVisited は温泉メモに変換済みの行きたい温泉に一致します
+	WishlistStatusVisited WishlistStatus = "visited"
+)
+
+// ParseWishlistStatus は文字列を訪問状況の絞り込み条件に変換します（不明な値はallとして扱います）
+func ParseWishlistStatus(s string) WishlistStatus {
+	switch status := WishlistStatus(strings.ToLower(s)); status {
+	case WishlistStatusPending, WishlistStatusVisited:
+		return status
+	default:
+		return WishlistStatusAll
+	}
+}
+
+// WishlistItem は行きたい温泉を表すエンティティです
+// TargetSeasonは行きたい季節で、空文字は季節を問わないことを表します
+// OnsenLogIDは訪問後に変換した温泉メモのIDで、空文字はまだ訪問していないことを表します
+type WishlistItem struct {
+	ID           primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UUID         string             `json:"uuid" bson:"uuid"`
+	UserID       string             `json:"user_id" bson:"user_id"`
+	Name         string             `json:"name" bson:"name"`
+	Location     string             `json:"location" bson:"location"`
+	Notes        string             `json:"notes" bson:"notes"`
+	Priority     WishlistPriority   `json:"priority" bson:"priority"`
+	TargetSeason Season             `json:"target_season" bson:"target_season"`
+	OnsenLogID   string             `json:"onsen_log_id" bson:"onsen_log_id"`
+	VisitedAt    *time.Time         `json:"visited_at" bson:"visited_at"`
+	CreatedAt    time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// WishlistItemParams は行きたい温泉の作成・更新に使用する入力値です
+// Priorityが0の場合は中（2）として扱います
+type WishlistItemParams struct {
+	Name         string
+	Location     string
+	Notes        string
+	Priority     WishlistPriority
+	TargetSeason Season
+}
+
+// Normalize は入力値の前後の空白を取り除き、優先度の省略を補います
+func (p WishlistItemParams) Normalize() WishlistItemParams {
+	p.Name = strings.TrimSpace(p.Name)
+	p.Location = strings.TrimSpace(p.Location)
+	p.Notes = strings.TrimSpace(p.Notes)
+	p.TargetSeason = Season(strings.TrimSpace(string(p.TargetSeason)))
+	if p.Priority == 0 {
+		p.Priority = WishlistPriorityMedium
+	}
+	return p
+}
+
+// NewWishlistItem は新しい行きたい温泉エンティティを作成します
+func NewWishlistItem(userID string, params WishlistItemParams) *WishlistItem {
+	now := time.Now()
+	item := &WishlistItem{
+		UUID:      uuid.New().String(),
+		UserID:    userID,
+		CreatedAt: now,
+	}
+	item.apply(params)
+	item.UpdatedAt = now
+	return item
+}
+
+// Update は行きたい温泉の情報を更新します
+func (w *WishlistItem) Update(params WishlistItemParams) {
+	w.apply(params)
+	w.UpdatedAt = time.Now()
+}
+
+// apply は入力値を行きたい温泉に反映します
+func (w *WishlistItem) apply(params WishlistItemParams) {
+	params = params.Normalize()
+	w.Name = params.Name
+	w.Location = params.Location
+	w.Notes = params.Notes
+	w.Priority = params.Priority
+	w.TargetSeason = params.TargetSeason
+}
+
+// MarkVisited は行きたい温泉を訪問済みにし、変換した温泉メモと紐づけます
+func (w *WishlistItem) MarkVisited(onsenLogID string) {
+	now := time.Now()
+	w.OnsenLogID = onsenLogID
+	w.VisitedAt = &now
+	w.UpdatedAt = now
+}
+
+// IsVisited は行きたい温泉を温泉メモに変換済みかどうかを返します
+func (w *WishlistItem) IsVisited() bool {
+	return w.OnsenLogID != ""
+}
@@ -0,0 +1,181 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// 温泉アルバムの集計の設定値
+const (
+	// MaxYearbookRanking は年ごとの集計に含める泉質・都道府県のランキングの件数です
+	MaxYearbookRanking = 5
+	// MaxYearbookHighlights は年ごとの集計に含める評価の高い温泉メモの件数です
+	MaxYearbookHighlights = 3
+)
+
+// YearbookYearStats は温泉アルバムの年ごとの集計です
+// MonthlyCountsは1月から12月までの訪問回数で、泉質・都道府県は件数の多い順に上位のみを含みます
+type YearbookYearStats struct {
+	Year            int                 `json:"year"`
+	VisitCount      int                 `json:"visit_count"`
+	OnsenCount      int                 `json:"onsen_count"`
+	PrefectureCount int                 `json:"prefecture_count"`
+	AverageRating   float64             `json:"average_rating"`
+	FirstVisit      time.Time           `json:"first_visit"`
+	LastVisit       time.Time           `json:"last_visit"`
+	MonthlyCounts   [12]int             `json:"monthly_counts"`
+	BySpringType    []StatsCount        `json:"by_spring_type"`
+	ByPrefecture    []StatsCount        `json:"by_prefecture"`
+	Highlights      []YearbookHighlight `json:"highlights"`
+	Spending        []CurrencySpending  `json:"spending"`
+}
+
+// YearbookHighlight は年ごとの集計に含める評価の高い温泉メモです
+type YearbookHighlight struct {
+	Name      string    `json:"name"`
+	VisitDate time.Time `json:"visit_date"`
+	Rating    float64   `json:"rating"`
+}
+
+// NewYearbookStats は温泉メモを訪問日の年ごとに集計し、年の古い順に返します
+// 温泉の数は温泉施設ごと（施設に紐づかない温泉メモは温泉名と所在地ごと）に数えます
+func NewYearbookStats(onsenLogs []*OnsenLog) []YearbookYearStats {
+	// 年ごとに温泉メモを分ける
+	byYear := make(map[int][]*OnsenLog)
+	for _, onsenLog := range onsenLogs {
+		year := onsenLog.VisitDate.Year()
+		byYear[year] = append(byYear[year], onsenLog)
+	}
+	years := make([]int, 0, len(byYear))
+	for year := range byYear {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+
+	stats := make([]YearbookYearStats, len(years))
+	for i, year := range years {
+		stats[i] = newYearbookYearStats(year, byYear[year])
+	}
+	return stats
+}
+
+// newYearbookYearStats は1年分の温泉メモを集計します
+func newYearbookYearStats(year int, onsenLogs []*OnsenLog) YearbookYearStats {
+	stats := YearbookYearStats{
+		Year:       year,
+		VisitCount: len(onsenLogs),
+		Spending:   []CurrencySpending{},
+	}
+
+	onsens := make(map[string]bool)
+	springTypeCounts := make(map[string]int)
+	prefectureCounts := make(map[string]int)
+	spendingIndex := make(map[string]int)
+	ratingTotal, ratedCount := 0.0, 0
+	for _, onsenLog := range onsenLogs {
+		// 訪問日の範囲と月ごとの訪問回数
+		if stats.FirstVisit.IsZero() || onsenLog.VisitDate.Before(stats.FirstVisit) {
+			stats.FirstVisit = onsenLog.VisitDate
+		}
+		if onsenLog.VisitDate.After(stats.LastVisit) {
+			stats.LastVisit = onsenLog.VisitDate
+		}
+		stats.MonthlyCounts[onsenLog.VisitDate.Month()-1]++
+
+		// 温泉の数
+		onsenKey := "id:" + onsenLog.OnsenID
+		if onsenLog.OnsenID == "" {
+			onsenKey = "key:" + NormalizeOnsenKey(onsenLog.Name, onsenLog.Location)
+		}
+		onsens[onsenKey] = true
+
+		// 泉質と都道府県
+		for _, springType := range NormalizeSpringTypes(onsenLog.SpringTypes) {
+			springTypeCounts[string(springType)]++
+		}
+		prefecture := onsenLog.Area.Prefecture
+		if prefecture == "" {
+			prefecture = UnknownPrefecture
+		}
+		prefectureCounts[prefecture]++
+
+		// 平均評価
+		if onsenLog.Rating > 0 {
+			ratingTotal += onsenLog.Rating
+			ratedCount++
+		}
+
+		// 通貨ごとの費用
+		if currency := onsenLog.Visit.Currency; currency != "" {
+			index, ok := spendingIndex[currency]
+			if !ok {
+				index = len(stats.Spending)
+				spendingIndex[currency] = index
+				stats.Spending = append(stats.Spending, CurrencySpending{Currency: currency})
+			}
+			stats.Spending[index].Total = roundAmount(stats.Spending[index].Total + onsenLog.Visit.TotalCost)
+			stats.Spending[index].VisitCount++
+		}
+	}
+
+	stats.OnsenCount = len(onsens)
+	stats.PrefectureCount = len(prefectureCounts)
+	if _, ok := prefectureCounts[UnknownPrefecture]; ok {
+		stats.PrefectureCount--
+	}
+	if ratedCount > 0 {
+		stats.AverageRating = roundRating(ratingTotal / float64(ratedCount))
+	}
+	stats.BySpringType = yearbookRanking(springTypeCounts)
+	stats.ByPrefecture = yearbookRanking(prefectureCounts)
+	stats.Highlights = yearbookHighlights(onsenLogs)
+
+	return stats
+}
+
+// yearbookRanking は件数の多い順（同数の場合は名前順）に上位の集計キーを返します
+func yearbookRanking(counts map[string]int) []StatsCount {
+	ranking := make([]StatsCount, 0, len(counts))
+	for key, count := range counts {
+		ranking = append(ranking, StatsCount{Key: key, Count: count})
+	}
+	sort.Slice(ranking, func(a, b int) bool {
+		if ranking[a].Count != ranking[b].Count {
+			return ranking[a].Count > ranking[b].Count
+		}
+		return ranking[a].Key < ranking[b].Key
+	})
+	if len(ranking) > MaxYearbookRanking {
+		ranking = ranking[:MaxYearbookRanking]
+	}
+	return ranking
+}
+
+// yearbookHighlights は評価の高い順（同じ評価の場合は訪問日の古い順）に上位の温泉メモを返します（未評価の温泉メモは含みません）
+func yearbookHighlights(onsenLogs []*OnsenLog) []YearbookHighlight {
+	var rated []*OnsenLog
+	for _, onsenLog := range onsenLogs {
+		if onsenLog.Rating > 0 {
+			rated = append(rated, onsenLog)
+		}
+	}
+	sort.SliceStable(rated, func(a, b int) bool {
+		if rated[a].Rating != rated[b].Rating {
+			return rated[a].Rating > rated[b].Rating
+		}
+		return rated[a].VisitDate.Before(rated[b].VisitDate)
+	})
+	if len(rated) > MaxYearbookHighlights {
+		rated = rated[:MaxYearbookHighlights]
+	}
+
+	highlights := make([]YearbookHighlight, len(rated))
+	for i, onsenLog := range rated {
+		highlights[i] = YearbookHighlight{
+			Name:      onsenLog.Name,
+			VisitDate: onsenLog.VisitDate,
+			Rating:    onsenLog.Rating,
+		}
+	}
+	return highlights
+}
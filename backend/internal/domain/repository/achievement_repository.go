@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// AchievementRepository はユーザーが獲得した実績の永続化を担当するインターフェースです
+type AchievementRepository interface {
+	// Create は獲得した実績を保存します（獲得済みの実績は無視します）
+	Create(ctx context.Context, achievement *entity.UserAchievement) error
+
+	// FindByUserID はユーザーIDに紐づく獲得済みの実績を獲得日時の昇順で検索します
+	FindByUserID(ctx context.Context, userID string) ([]*entity.UserAchievement, error)
+}
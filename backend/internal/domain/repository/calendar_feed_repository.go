@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// CalendarFeedRepository はカレンダーフィードの永続化を担当するインターフェースです
+type CalendarFeedRepository interface {
+	// Create は新しいカレンダーフィードを作成します
+	Create(ctx context.Context, feed *entity.CalendarFeed) error
+
+	// FindByUserID はユーザーIDでカレンダーフィードを検索します
+	FindByUserID(ctx context.Context, userID string) (*entity.CalendarFeed, error)
+
+	// FindByToken はトークンでカレンダーフィードを検索します
+	FindByToken(ctx context.Context, token string) (*entity.CalendarFeed, error)
+
+	// Update はカレンダーフィードを更新します
+	Update(ctx context.Context, feed *entity.CalendarFeed) error
+
+	// DeleteByUserID はユーザーIDに紐づくカレンダーフィードを削除します
+	DeleteByUserID(ctx context.Context, userID string) error
+}
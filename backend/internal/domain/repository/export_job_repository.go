@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// ExportJobRepository はエクスポートジョブの永続化を担当するインターフェースです
+type ExportJobRepository interface {
+	// Create は新しいエクスポートジョブを作成します
+	Create(ctx context.Context, job *entity.ExportJob) error
+
+	// FindByID はIDでエクスポートジョブを検索します
+	FindByID(ctx context.Context, id string) (*entity.ExportJob, error)
+
+	// FindByUserID はユーザーIDに紐づくエクスポートジョブを作成日時の新しい順に検索します
+	FindByUserID(ctx context.Context, userID string) ([]*entity.ExportJob, error)
+
+	// CountActiveByUserID はユーザーIDに紐づく未完了（実行待ちまたは実行中）のエクスポートジョブの件数を返します
+	CountActiveByUserID(ctx context.Context, userID string) (int, error)
+
+	// ClaimNext は実行時刻を過ぎた実行待ちのエクスポートジョブを作成日時の古い順に1件取得し、実行中にします
+	// 取得と同時に試行回数を1増やします。実行待ちのジョブがない場合はnilを返します
+	ClaimNext(ctx context.Context, now time.Time) (*entity.ExportJob, error)
+
+	// UpdateProgress はエクスポートジョブの処理済みの件数と更新日時を更新します
+	UpdateProgress(ctx context.Context, id string, processed int) error
+
+	// Touch は実行中のエクスポートジョブの更新日時を更新します（実行中のジョブの生存確認に使用します）
+	Touch(ctx context.Context, id string, now time.Time) error
+
+	// RequeueStale は更新日時がstaleBeforeより古い実行中のエクスポートジョブを実行待ちに戻し、戻した件数を返します
+	// サーバーの停止で中断されたジョブを再実行するために使用します
+	RequeueStale(ctx context.Context, now, staleBefore time.Time) (int, error)
+
+	// FindExpired はダウンロードの有効期限が切れた完了済みのエクスポートジョブを最大limit件検索します
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]*entity.ExportJob, error)
+
+	// Update はエクスポートジョブを更新します
+	Update(ctx context.Context, job *entity.ExportJob) error
+}
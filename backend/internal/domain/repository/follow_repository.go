@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// FollowRepository はフォロー関係の永続化を担当するインターフェースです
+// FindBy系のメソッドとExistsは承認済みのフォロー関係のみを対象とします
+type FollowRepository interface {
+	// Create は新しいフォロー関係を作成します（既にフォローしている場合は何もしません）
+	Create(ctx context.Context, follow *entity.Follow) error
+
+	// Find はフォロー関係を状態を問わず検索します（存在しない場合はnilを返します）
+	Find(ctx context.Context, followerID, followeeID string) (*entity.Follow, error)
+
+	// Approve は承認待ちのフォロー関係を承認済みにします
+	Approve(ctx context.Context, followerID, followeeID string) error
+
+	// Delete はフォロー関係を削除します（承認待ちのフォローリクエストを含みます）
+	Delete(ctx context.Context, followerID, followeeID string) error
+
+	// FindByFollowerID はユーザーがフォローしているフォロー関係をフォローした日時の新しい順に検索します
+	FindByFollowerID(ctx context.Context, followerID string) ([]*entity.Follow, error)
+
+	// FindByFolloweeID はユーザーをフォローしているフォロー関係をフォローされた日時の新しい順に検索します
+	FindByFolloweeID(ctx context.Context, followeeID string) ([]*entity.Follow, error)
+
+	// FindPendingByFolloweeID はユーザーへの承認待ちのフォローリクエストを新しい順に検索します
+	FindPendingByFolloweeID(ctx context.Context, followeeID string) ([]*entity.Follow, error)
+
+	// Exists は承認済みのフォロー関係が存在するかどうかを返します
+	Exists(ctx context.Context, followerID, followeeID string) (bool, error)
+}
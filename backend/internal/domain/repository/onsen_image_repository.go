@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/yourusername/yuroku/internal/domain/entity"
 )
 
 // OnsenImageRepository は温泉画像の永続化を担当するインターフェースです
+// ゴミ箱の画像（削除日時のあるもの）はFindDeleted系のメソッド以外の検索には含みません
 type OnsenImageRepository interface {
 	// Create は新しい温泉画像を作成します
 	Create(ctx context.Context, onsenImage *entity.OnsenImage) error
@@ -17,9 +19,33 @@ type OnsenImageRepository interface {
 	// FindByOnsenID は温泉IDに紐づく画像を検索します
 	FindByOnsenID(ctx context.Context, onsenID string) ([]*entity.OnsenImage, error)
 
-	// Delete は温泉画像を削除します
+	// Delete は温泉画像を完全に削除します（ゴミ箱の画像を含みます）
 	Delete(ctx context.Context, id string) error
 
-	// DeleteByOnsenID は温泉IDに紐づく画像をすべて削除します
+	// FindDeletedByID はIDでゴミ箱の画像を検索します
+	FindDeletedByID(ctx context.Context, id string) (*entity.OnsenImage, error)
+
+	// FindDeletedByOnsenID は温泉IDに紐づくゴミ箱の画像を検索します
+	FindDeletedByOnsenID(ctx context.Context, onsenID string) ([]*entity.OnsenImage, error)
+
+	// FindDeletedByUserID はユーザーIDに紐づくゴミ箱の画像を削除日時の新しい順に検索します
+	FindDeletedByUserID(ctx context.Context, userID string) ([]*entity.OnsenImage, error)
+
+	// FindDeletedBefore は指定日時より前に削除されたゴミ箱の画像を削除日時の古い順に検索します
+	FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.OnsenImage, error)
+
+	// SoftDelete は温泉画像に削除日時を設定してゴミ箱に移動します
+	SoftDelete(ctx context.Context, id string, deletedAt time.Time) error
+
+	// Restore はゴミ箱の温泉画像の削除日時を取り除いて復元します
+	Restore(ctx context.Context, id string) error
+
+	// SoftDeleteByOnsenID は温泉IDに紐づく画像に削除日時を設定してゴミ箱に移動します
+	SoftDeleteByOnsenID(ctx context.Context, onsenID string, deletedAt time.Time) error
+
+	// RestoreByOnsenID は温泉IDに紐づく画像のうち指定日時に削除されたものを復元します
+	RestoreByOnsenID(ctx context.Context, onsenID string, deletedAt time.Time) error
+
+	// DeleteByOnsenID は温泉IDに紐づく画像をすべて削除します（ゴミ箱の画像を含みます）
 	DeleteByOnsenID(ctx context.Context, onsenID string) error
 }
@@ -7,7 +7,58 @@ import (
 	"github.com/yourusername/yuroku/internal/domain/entity"
 )
 
+// OnsenLogFilter は温泉メモの検索条件です
+type OnsenLogFilter struct {
+	// SpringTypes はいずれかを含む泉質です
+	SpringTypes []entity.SpringType
+	Location    string
+	// PrefectureCodes はいずれかに該当する都道府県コードです
+	PrefectureCodes []string
+	MinRating       float64
+	// MinScores は評価項目ごとの最低評価です
+	MinScores map[entity.RatingCriterion]int
+	// 温泉分析書の測定値での絞り込み条件です（nilの場合は条件なし）
+	MinPH          *float64
+	MaxPH          *float64
+	MinTemperature *float64
+	MaxTemperature *float64
+	Tonicity       entity.Tonicity
+	StartDate      *time.Time
+	EndDate        *time.Time
+	// 訪問時の状況での絞り込み条件です
+	// Companionsはいずれかを含む同行者、到着時刻は "HH:MM" 形式、費用はCurrencyの通貨での合計費用です
+	StayType           entity.StayType
+	Companions         []entity.Companion
+	ArrivalFrom        string
+	ArrivalTo          string
+	MinDurationMinutes *float64
+	MaxDurationMinutes *float64
+	MinTotalCost       *float64
+	MaxTotalCost       *float64
+	Currency           string
+	// Query は温泉名・所在地・コメントを対象としたキーワードです
+	Query string
+	// Tags はタグでの絞り込み条件です（TagMatchでいずれか・すべてを指定）
+	Tags     []string
+	TagMatch entity.TagMatchMode
+	// Sort は並び順です（未指定の場合はキーワード検索時は関連度順、それ以外は訪問日の降順）
+	Sort OnsenLogSort
+}
+
+// 温泉メモの並び替え項目（評価項目名も指定できます）
+const (
+	OnsenLogSortVisitDate = "visit_date"
+	OnsenLogSortRating    = "rating"
+)
+
+// OnsenLogSort は温泉メモの並び順です
+type OnsenLogSort struct {
+	Field     string
+	Ascending bool
+}
+
 // OnsenLogRepository は温泉メモの永続化を担当するインターフェースです
+// ゴミ箱の温泉メモ（削除日時のあるもの）はFindDeleted系のメソッド以外の検索・集計には含みません
 type OnsenLogRepository interface {
 	// Create は新しい温泉メモを作成します
 	Create(ctx context.Context, onsenLog *entity.OnsenLog) error
@@ -18,16 +69,74 @@ type OnsenLogRepository interface {
 	// FindByUserID はユーザーIDに紐づく温泉メモを検索します
 	FindByUserID(ctx context.Context, userID string) ([]*entity.OnsenLog, error)
 
+	// CountByUserID はユーザーIDに紐づく温泉メモの件数を返します
+	CountByUserID(ctx context.Context, userID string) (int, error)
+
+	// EachByUserID はユーザーIDに紐づく温泉メモを訪問日の新しい順に1件ずつ読み取り、fnを呼び出します
+	// すべての温泉メモをメモリに読み込まないため、件数の多いエクスポートに使用します。fnがエラーを返した場合はそこで終了します
+	EachByUserID(ctx context.Context, userID string, fn func(onsenLog *entity.OnsenLog) error) error
+
+	// FindByUserIDAndVisitDateRange はユーザーIDに紐づく温泉メモのうち訪問日がfrom以上to未満のものを訪問日の古い順に検索します
+	// fromまたはtoがnilの場合はその側の条件はありません
+	FindByUserIDAndVisitDateRange(ctx context.Context, userID string, from, to *time.Time) ([]*entity.OnsenLog, error)
+
+	// FindByUserIDAndIDs はユーザーIDに紐づく温泉メモのうちIDに一致するものを指定したIDの順に検索します
+	// 見つからないIDは結果に含めません
+	FindByUserIDAndIDs(ctx context.Context, userID string, ids []string) ([]*entity.OnsenLog, error)
+
+	// FindVisibleByUserIDs は指定したユーザーの温泉メモのうち公開範囲が一致するものを作成日時の新しい順に検索します
+	// cursorを指定した場合はその位置より後の温泉メモを検索します
+	FindVisibleByUserIDs(ctx context.Context, userIDs []string, visibilities []entity.Visibility, cursor *entity.FeedCursor, limit int) ([]*entity.OnsenLog, error)
+
 	// FindByUserIDWithPagination はユーザーIDに紐づく温泉メモをページネーションで検索します
 	FindByUserIDWithPagination(ctx context.Context, userID string, page, limit int) ([]*entity.OnsenLog, int, error)
 
 	// FindByUserIDAndFilter はユーザーIDと条件に紐づく温泉メモを検索します
-	FindByUserIDAndFilter(ctx context.Context, userID string, springType entity.SpringType, location string, minRating int, startDate, endDate *time.Time, page, limit int) ([]*entity.OnsenLog, int, error)
+	FindByUserIDAndFilter(ctx context.Context, userID string, filter OnsenLogFilter, page, limit int) ([]*entity.OnsenLog, int, error)
+
+	// FindNearby はユーザーIDに紐づく温泉メモのうち指定地点から半径内のものを距離の近い順に検索します
+	FindNearby(ctx context.Context, userID string, latitude, longitude, radiusKm float64, limit int) ([]*entity.NearbyOnsenLog, error)
+
+	// FindByOnsenID は温泉施設IDに紐づく温泉メモを検索します
+	FindByOnsenID(ctx context.Context, onsenID string) ([]*entity.OnsenLog, error)
+
+	// AggregateStats は条件に一致する温泉メモの統計を集計します
+	AggregateStats(ctx context.Context, userID string, filter OnsenLogFilter) (*entity.OnsenLogStats, error)
+
+	// AggregateAchievementCounters はユーザーの温泉メモから実績の判定に使う集計値を計算します
+	AggregateAchievementCounters(ctx context.Context, userID string) (*entity.AchievementCounters, error)
+
+	// SummarizeVisitsByPrefecture はユーザーの温泉メモを都道府県ごとに集計します
+	SummarizeVisitsByPrefecture(ctx context.Context, userID string) ([]*entity.PrefectureVisitSummary, error)
+
+	// SummarizeVisitsByOnsen はユーザーの温泉メモを温泉施設ごとに集計します
+	SummarizeVisitsByOnsen(ctx context.Context, userID string) ([]*entity.OnsenVisitSummary, error)
+
+	// FindTagsByUserID はユーザーが使用しているタグを使用回数付きで取得します
+	FindTagsByUserID(ctx context.Context, userID string) ([]*entity.TagUsage, error)
+
+	// FindByUserIDAndTag はユーザーIDに紐づく温泉メモのうちタグが付いているものを訪問日の新しい順に検索します
+	FindByUserIDAndTag(ctx context.Context, userID, tag string) ([]*entity.OnsenLog, error)
+
+	// FindDeletedByID はIDでゴミ箱の温泉メモを検索します
+	FindDeletedByID(ctx context.Context, id string) (*entity.OnsenLog, error)
+
+	// FindDeletedByUserID はユーザーIDに紐づくゴミ箱の温泉メモを削除日時の新しい順に検索します
+	FindDeletedByUserID(ctx context.Context, userID string) ([]*entity.OnsenLog, error)
+
+	// FindDeletedBefore は指定日時より前に削除されたゴミ箱の温泉メモを削除日時の古い順に検索します
+	FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.OnsenLog, error)
+
+	// SoftDelete は温泉メモに削除日時を設定してゴミ箱に移動します
+	SoftDelete(ctx context.Context, id string, deletedAt time.Time) error
+
+	// Restore はゴミ箱の温泉メモの削除日時を取り除いて復元します
+	Restore(ctx context.Context, id string) error
 
 	// Update は温泉メモを更新します
 	Update(ctx context.Context, onsenLog *entity.OnsenLog) error
 
-	// Delete は温泉メモを削除します
+	// Delete は温泉メモを完全に削除します（ゴミ箱の温泉メモを含みます）
 	Delete(ctx context.Context, id string) error
 
 	// DeleteByUserID はユーザーIDに紐づく温泉メモをすべて削除します
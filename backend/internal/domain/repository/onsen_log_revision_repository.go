@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// OnsenLogRevisionRepository は温泉メモの変更履歴の永続化を担当するインターフェースです
+type OnsenLogRevisionRepository interface {
+	// Create は新しい版を作成します
+	Create(ctx context.Context, revision *entity.OnsenLogRevision) error
+
+	// FindByOnsenLogID は温泉メモの版を新しい順に検索します
+	FindByOnsenLogID(ctx context.Context, onsenLogID string) ([]*entity.OnsenLogRevision, error)
+
+	// FindLatestByOnsenLogID は温泉メモの最新の版を検索します（版がない場合はnilを返します）
+	FindLatestByOnsenLogID(ctx context.Context, onsenLogID string) (*entity.OnsenLogRevision, error)
+
+	// DeleteByOnsenLogID は温泉メモの版をすべて削除します
+	DeleteByOnsenLogID(ctx context.Context, onsenLogID string) error
+}
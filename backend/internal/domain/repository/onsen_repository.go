@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// OnsenRepository は温泉施設の永続化を担当するインターフェースです
+type OnsenRepository interface {
+	// Create は新しい温泉施設を作成します
+	Create(ctx context.Context, onsen *entity.Onsen) error
+
+	// FindByID はIDで温泉施設を検索します
+	FindByID(ctx context.Context, id string) (*entity.Onsen, error)
+
+	// FindByUserID はユーザーIDに紐づく温泉施設を検索します
+	FindByUserID(ctx context.Context, userID string) ([]*entity.Onsen, error)
+
+	// FindByUserIDAndKey はユーザーIDと照合キーで温泉施設を検索します（見つからない場合はnilを返します）
+	FindByUserIDAndKey(ctx context.Context, userID, normalizedKey string) (*entity.Onsen, error)
+
+	// Update は温泉施設を更新します
+	Update(ctx context.Context, onsen *entity.Onsen) error
+
+	// Delete は温泉施設を削除します
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// ShareLinkRepository は共有リンクの永続化を担当するインターフェースです
+type ShareLinkRepository interface {
+	// Create は新しい共有リンクを作成します
+	Create(ctx context.Context, shareLink *entity.ShareLink) error
+
+	// FindByID はIDで共有リンクを検索します
+	FindByID(ctx context.Context, id string) (*entity.ShareLink, error)
+
+	// FindByToken は共有トークンで共有リンクを検索します
+	FindByToken(ctx context.Context, token string) (*entity.ShareLink, error)
+
+	// FindActiveByUserID はユーザーIDに紐づく有効な共有リンクを作成日時の新しい順に検索します
+	FindActiveByUserID(ctx context.Context, userID string, now time.Time) ([]*entity.ShareLink, error)
+
+	// Update は共有リンクを更新します
+	Update(ctx context.Context, shareLink *entity.ShareLink) error
+}
@@ -12,4 +12,7 @@ type StorageRepository interface {
 
 	// Delete はファイルを削除します
 	Delete(ctx context.Context, fileURL string) error
+
+	// Open はファイルを読み取り用に開きます（呼び出し元で閉じる必要があります）
+	Open(ctx context.Context, fileURL string) (io.ReadCloser, error)
 }
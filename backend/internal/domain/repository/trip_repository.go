@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// TripRepository は旅行の永続化を担当するインターフェースです
+type TripRepository interface {
+	// Create は新しい旅行を作成します
+	Create(ctx context.Context, trip *entity.Trip) error
+
+	// FindByID はIDで旅行を検索します
+	FindByID(ctx context.Context, id string) (*entity.Trip, error)
+
+	// FindByUserID はユーザーIDに紐づく旅行を開始日の新しい順に検索します
+	FindByUserID(ctx context.Context, userID string) ([]*entity.Trip, error)
+
+	// Update は旅行を更新します
+	Update(ctx context.Context, trip *entity.Trip) error
+
+	// Delete は旅行を削除します
+	Delete(ctx context.Context, id string) error
+}
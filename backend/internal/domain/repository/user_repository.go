@@ -14,6 +14,9 @@ type UserRepository interface {
 	// FindByID はIDでユーザーを検索します
 	FindByID(ctx context.Context, id string) (*entity.User, error)
 
+	// FindByIDs はIDに一致するユーザーを検索します（見つからないIDは結果に含めません）
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error)
+
 	// FindByEmail はメールアドレスでユーザーを検索します
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// WishlistFilter は行きたい温泉の検索条件です
+type WishlistFilter struct {
+	Status entity.WishlistStatus
+	// TargetSeason は行きたい季節です（季節を問わない行きたい温泉も含みます。空文字の場合は条件なし）
+	TargetSeason entity.Season
+}
+
+// WishlistRepository は行きたい温泉の永続化を担当するインターフェースです
+type WishlistRepository interface {
+	// Create は新しい行きたい温泉を作成します
+	Create(ctx context.Context, item *entity.WishlistItem) error
+
+	// FindByID はIDで行きたい温泉を検索します
+	FindByID(ctx context.Context, id string) (*entity.WishlistItem, error)
+
+	// FindByUserID はユーザーIDと条件に紐づく行きたい温泉を優先度の高い順に検索します
+	FindByUserID(ctx context.Context, userID string, filter WishlistFilter) ([]*entity.WishlistItem, error)
+
+	// Update は行きたい温泉を更新します
+	Update(ctx context.Context, item *entity.WishlistItem) error
+
+	// Delete は行きたい温泉を削除します
+	Delete(ctx context.Context, id string) error
+}
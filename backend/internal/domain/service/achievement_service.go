@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// AchievementService は温泉メモの履歴から実績（バッジ）を判定するドメインサービスです
+// 判定ルールはentityの実績データで定義し、このサービスはルールを履歴に適用して獲得状況を保存します
+type AchievementService struct {
+	achievementRepo repository.AchievementRepository
+	onsenLogRepo    repository.OnsenLogRepository
+}
+
+// NewAchievementService は新しい実績サービスを作成します
+func NewAchievementService(achievementRepo repository.AchievementRepository, onsenLogRepo repository.OnsenLogRepository) *AchievementService {
+	return &AchievementService{
+		achievementRepo: achievementRepo,
+		onsenLogRepo:    onsenLogRepo,
+	}
+}
+
+// EvaluateAchievements はユーザーの温泉メモの履歴を判定し、新たに獲得した実績を保存して返します
+func (s *AchievementService) EvaluateAchievements(ctx context.Context, userID string) ([]entity.AchievementProgress, error) {
+	progresses, err := s.evaluate(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.unlock(ctx, userID, progresses)
+}
+
+// GetAchievements はすべての実績の達成状況を定義順に取得します
+// 実績の導入前に記録した温泉メモも反映されるように、取得時にも判定を行います
+func (s *AchievementService) GetAchievements(ctx context.Context, userID string) ([]entity.AchievementProgress, error) {
+	progresses, err := s.evaluate(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.unlock(ctx, userID, progresses); err != nil {
+		return nil, err
+	}
+	return progresses, nil
+}
+
+// unlock は条件を満たした未獲得の実績を保存し、達成状況を獲得済みに更新して新たに獲得した実績を返します
+func (s *AchievementService) unlock(ctx context.Context, userID string, progresses []entity.AchievementProgress) ([]entity.AchievementProgress, error) {
+	var unlocked []entity.AchievementProgress
+	for i := range progresses {
+		progress := &progresses[i]
+		if progress.Unlocked() || progress.Current < progress.Rule.Target {
+			continue
+		}
+		achievement := entity.NewUserAchievement(userID, progress.Rule.ID, time.Now())
+		if err := s.achievementRepo.Create(ctx, achievement); err != nil {
+			return nil, err
+		}
+		progress.UnlockedAt = &achievement.UnlockedAt
+		unlocked = append(unlocked, *progress)
+	}
+
+	return unlocked, nil
+}
+
+// evaluate は獲得済みの実績と温泉メモの集計値からすべての実績の達成状況を計算します
+// 一度獲得した実績は、温泉メモを削除して条件を満たさなくなっても獲得済みのままです
+func (s *AchievementService) evaluate(ctx context.Context, userID string) ([]entity.AchievementProgress, error) {
+	achievements, err := s.achievementRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	unlockedAt := make(map[string]time.Time, len(achievements))
+	for _, achievement := range achievements {
+		unlockedAt[achievement.AchievementID] = achievement.UnlockedAt
+	}
+
+	counters, err := s.onsenLogRepo.AggregateAchievementCounters(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := entity.AchievementRules()
+	progresses := make([]entity.AchievementProgress, 0, len(rules))
+	for _, rule := range rules {
+		progress := entity.AchievementProgress{Rule: rule, Current: rule.Evaluate(counters)}
+		if at, ok := unlockedAt[rule.ID]; ok {
+			progress.UnlockedAt = &at
+		}
+		progresses = append(progresses, progress)
+	}
+
+	return progresses, nil
+}
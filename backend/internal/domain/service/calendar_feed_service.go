@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// CalendarFeedService はカレンダーフィードに関するドメインサービスです
+type CalendarFeedService struct {
+	calendarFeedRepo repository.CalendarFeedRepository
+	onsenLogRepo     repository.OnsenLogRepository
+	tripRepo         repository.TripRepository
+}
+
+// NewCalendarFeedService は新しいカレンダーフィードサービスを作成します
+func NewCalendarFeedService(
+	calendarFeedRepo repository.CalendarFeedRepository,
+	onsenLogRepo repository.OnsenLogRepository,
+	tripRepo repository.TripRepository,
+) *CalendarFeedService {
+	return &CalendarFeedService{
+		calendarFeedRepo: calendarFeedRepo,
+		onsenLogRepo:     onsenLogRepo,
+		tripRepo:         tripRepo,
+	}
+}
+
+// IssueCalendarFeed はユーザーのカレンダーフィードを発行します
+// 発行済みの場合はトークンを再発行し、以前のフィードURLを使用できなくします
+func (s *CalendarFeedService) IssueCalendarFeed(ctx context.Context, userID string) (*entity.CalendarFeed, error) {
+	// 発行済みの場合はトークンを再発行
+	feed, err := s.calendarFeedRepo.FindByUserID(ctx, userID)
+	if err == nil && feed != nil {
+		if err := feed.RotateToken(); err != nil {
+			return nil, err
+		}
+		if err := s.calendarFeedRepo.Update(ctx, feed); err != nil {
+			return nil, err
+		}
+		return feed, nil
+	}
+
+	// 新しいカレンダーフィードを作成
+	feed, err = entity.NewCalendarFeed(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.calendarFeedRepo.Create(ctx, feed); err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+// GetCalendarFeed はユーザーのカレンダーフィードを取得します
+func (s *CalendarFeedService) GetCalendarFeed(ctx context.Context, userID string) (*entity.CalendarFeed, error) {
+	return s.calendarFeedRepo.FindByUserID(ctx, userID)
+}
+
+// DeleteCalendarFeed はユーザーのカレンダーフィードを削除し、フィードURLを使用できなくします
+func (s *CalendarFeedService) DeleteCalendarFeed(ctx context.Context, userID string) error {
+	return s.calendarFeedRepo.DeleteByUserID(ctx, userID)
+}
+
+// GetCalendarFeedByToken はトークンからカレンダーフィードを取得します
+func (s *CalendarFeedService) GetCalendarFeedByToken(ctx context.Context, token string) (*entity.CalendarFeed, error) {
+	if token == "" {
+		return nil, errors.New("カレンダーフィードが見つかりません")
+	}
+	return s.calendarFeedRepo.FindByToken(ctx, token)
+}
+
+// EachCalendarOnsenLog はカレンダーフィードに含める温泉メモ（ユーザーのすべての温泉メモ）を1件ずつ読み取り、fnを呼び出します
+func (s *CalendarFeedService) EachCalendarOnsenLog(ctx context.Context, userID string, fn func(onsenLog *entity.OnsenLog) error) error {
+	return s.onsenLogRepo.EachByUserID(ctx, userID, fn)
+}
+
+// GetUpcomingTrips はカレンダーフィードに含める今後の旅行（終了日がtoday以降の旅行）を取得します
+func (s *CalendarFeedService) GetUpcomingTrips(ctx context.Context, userID string, today time.Time) ([]*entity.Trip, error) {
+	trips, err := s.tripRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming := make([]*entity.Trip, 0, len(trips))
+	for _, trip := range trips {
+		if trip.IsUpcoming(today) {
+			upcoming = append(upcoming, trip)
+		}
+	}
+	return upcoming, nil
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// exportCleanupBatchSize は1回の期限切れファイルの削除で処理するエクスポートジョブの件数です
+const exportCleanupBatchSize = 100
+
+// ExportJobService はエクスポートジョブに関するドメインサービスです
+type ExportJobService struct {
+	exportJobRepo repository.ExportJobRepository
+	onsenLogRepo  repository.OnsenLogRepository
+	tripRepo      repository.TripRepository
+	storageRepo   repository.StorageRepository
+	retention     time.Duration
+}
+
+// NewExportJobService は新しいエクスポートジョブサービスを作成します
+// retentionはエクスポートしたファイルをダウンロードできる期間です
+func NewExportJobService(
+	exportJobRepo repository.ExportJobRepository,
+	onsenLogRepo repository.OnsenLogRepository,
+	tripRepo repository.TripRepository,
+	storageRepo repository.StorageRepository,
+	retention time.Duration,
+) *ExportJobService {
+	if retention <= 0 {
+		retention = entity.DefaultExportRetention
+	}
+	return &ExportJobService{
+		exportJobRepo: exportJobRepo,
+		onsenLogRepo:  onsenLogRepo,
+		tripRepo:      tripRepo,
+		storageRepo:   storageRepo,
+		retention:     retention,
+	}
+}
+
+// CreateExportJob はエクスポートジョブを実行待ちとして登録します
+// 旅行を指定した場合は所有者と温泉メモが含まれていることを検証します
+func (s *ExportJobService) CreateExportJob(ctx context.Context, job *entity.ExportJob) error {
+	// 未完了のジョブの件数を検証
+	active, err := s.exportJobRepo.CountActiveByUserID(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+	if active >= entity.MaxActiveExportJobs {
+		return fmt.Errorf("実行中のエクスポートが多すぎます（同時に%d件まで）。完了してから再度お試しください", entity.MaxActiveExportJobs)
+	}
+
+	// エクスポートする温泉メモの件数を取得
+	total, err := s.countTargets(ctx, job)
+	if err != nil {
+		return err
+	}
+	job.Total = total
+
+	// ジョブを保存
+	return s.exportJobRepo.Create(ctx, job)
+}
+
+// GetExportJob はエクスポートジョブを取得します
+func (s *ExportJobService) GetExportJob(ctx context.Context, id, userID string) (*entity.ExportJob, error) {
+	job, err := s.exportJobRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if job.UserID != userID {
+		return nil, errors.New("このエクスポートジョブを閲覧する権限がありません")
+	}
+
+	return job, nil
+}
+
+// GetExportJobs はユーザーのエクスポートジョブを作成日時の新しい順に取得します
+func (s *ExportJobService) GetExportJobs(ctx context.Context, userID string) ([]*entity.ExportJob, error) {
+	return s.exportJobRepo.FindByUserID(ctx, userID)
+}
+
+// ClaimNextExportJob は実行待ちのエクスポートジョブを1件取得して実行中にします
+// エクスポートする温泉メモの件数は実行時点の件数に更新します。実行待ちのジョブがない場合はnilを返します
+func (s *ExportJobService) ClaimNextExportJob(ctx context.Context, now time.Time) (*entity.ExportJob, error) {
+	job, err := s.exportJobRepo.ClaimNext(ctx, now)
+	if err != nil || job == nil {
+		return nil, err
+	}
+
+	// 件数を取得できない場合（旅行が削除された場合など）はエクスポートの実行時に失敗として扱う
+	if total, err := s.countTargets(ctx, job); err == nil {
+		job.Total = total
+		job.Processed = 0
+		if err := s.exportJobRepo.Update(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+
+	return job, nil
+}
+
+// UpdateProgress はエクスポートジョブの処理済みの件数を更新します
+func (s *ExportJobService) UpdateProgress(ctx context.Context, job *entity.ExportJob, processed int) error {
+	job.Processed = processed
+	return s.exportJobRepo.UpdateProgress(ctx, job.UUID, processed)
+}
+
+// SaveExportFile はエクスポートしたファイルをreaderから読み取りながらストレージに保存し、ファイルのURLとサイズを返します
+func (s *ExportJobService) SaveExportFile(ctx context.Context, job *entity.ExportJob, reader io.Reader) (string, int64, error) {
+	counter := &countingReader{r: reader}
+	fileURL, err := s.storageRepo.Upload(ctx, counter, job.FileName, job.ContentType)
+	if err != nil {
+		return "", 0, err
+	}
+	return fileURL, counter.n, nil
+}
+
+// CompleteExportJob は保存したファイルとエクスポートした件数を記録し、エクスポートジョブを完了にします
+// 完了にできなかった場合は保存したファイルを削除します
+func (s *ExportJobService) CompleteExportJob(ctx context.Context, job *entity.ExportJob, fileURL string, size int64, count int) error {
+	if err := job.Complete(fileURL, size, count, s.retention); err != nil {
+		s.deleteFile(ctx, fileURL)
+		return err
+	}
+	if err := s.exportJobRepo.Update(ctx, job); err != nil {
+		s.deleteFile(ctx, fileURL)
+		return err
+	}
+
+	return nil
+}
+
+// FailExportJob はエクスポートジョブの失敗を記録します
+// 実行回数が上限に達していない場合は再試行する実行待ちに戻ります
+func (s *ExportJobService) FailExportJob(ctx context.Context, job *entity.ExportJob, cause error) error {
+	job.Fail(cause)
+	return s.exportJobRepo.Update(ctx, job)
+}
+
+// TouchExportJob は実行中のエクスポートジョブの更新日時を更新し、実行中であることを記録します
+func (s *ExportJobService) TouchExportJob(ctx context.Context, job *entity.ExportJob, now time.Time) error {
+	return s.exportJobRepo.Touch(ctx, job.UUID, now)
+}
+
+// RequeueInterruptedExportJobs は実行中のまま中断されたエクスポートジョブを実行待ちに戻し、戻した件数を返します
+// 更新日時がExportJobLeaseTimeoutより古いジョブのみを戻すため、他のサーバーで実行中のジョブは戻しません
+func (s *ExportJobService) RequeueInterruptedExportJobs(ctx context.Context, now time.Time) (int, error) {
+	return s.exportJobRepo.RequeueStale(ctx, now, now.Add(-entity.ExportJobLeaseTimeout))
+}
+
+// OpenExportFile はダウンロードトークンを検証し、エクスポートしたファイルを読み取り用に開きます
+// 読み取ったファイルは呼び出し元で閉じる必要があります
+func (s *ExportJobService) OpenExportFile(ctx context.Context, id, token string) (*entity.ExportJob, io.ReadCloser, error) {
+	job, err := s.exportJobRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ダウンロードトークンの検証（トークンの比較にかかる時間で推測されないようにする）
+	if job.DownloadToken == "" || subtle.ConstantTimeCompare([]byte(job.DownloadToken), []byte(token)) != 1 {
+		return nil, nil, errors.New("エクスポートファイルが見つかりません")
+	}
+	if !job.IsDownloadable(time.Now()) {
+		return nil, nil, errors.New("ダウンロードの有効期限が切れています")
+	}
+
+	// ファイルを開く
+	reader, err := s.storageRepo.Open(ctx, job.FileURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return job, reader, nil
+}
+
+// PurgeExpiredExports はダウンロードの有効期限が切れたエクスポートファイルを削除し、ジョブを期限切れにして件数を返します
+// ファイルの削除に失敗した場合もジョブは期限切れにします
+func (s *ExportJobService) PurgeExpiredExports(ctx context.Context, now time.Time) (int, error) {
+	purged := 0
+	for {
+		// 有効期限が切れたジョブを古い順に取得
+		jobs, err := s.exportJobRepo.FindExpired(ctx, now, exportCleanupBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, job := range jobs {
+			s.deleteFile(ctx, job.FileURL)
+			job.Expire()
+			if err := s.exportJobRepo.Update(ctx, job); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(jobs) < exportCleanupBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// countTargets はエクスポートジョブでエクスポートする温泉メモの件数を返します
+func (s *ExportJobService) countTargets(ctx context.Context, job *entity.ExportJob) (int, error) {
+	if job.TripID == "" {
+		return s.onsenLogRepo.CountByUserID(ctx, job.UserID)
+	}
+
+	// 旅行を取得
+	trip, err := s.tripRepo.FindByID(ctx, job.TripID)
+	if err != nil {
+		return 0, err
+	}
+
+	// ユーザーIDの検証
+	if trip.UserID != job.UserID {
+		return 0, errors.New("この旅行をエクスポートする権限がありません")
+	}
+
+	// 温泉メモのない旅行はすべての温泉メモのエクスポートと区別できないためエラーにする
+	if len(trip.OnsenLogIDs) == 0 {
+		return 0, errors.New("旅行に温泉メモが含まれていません")
+	}
+
+	return len(trip.OnsenLogIDs), nil
+}
+
+// deleteFile はストレージのファイルを削除します（失敗した場合はログに記録します）
+func (s *ExportJobService) deleteFile(ctx context.Context, fileURL string) {
+	if fileURL == "" {
+		return
+	}
+	if err := s.storageRepo.Delete(ctx, fileURL); err != nil {
+		log.Printf("Failed to delete export file %s: %v", fileURL, err)
+	}
+}
+
+// countingReader は読み取ったバイト数を数えるリーダーです
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+// Read はデータを読み取り、読み取ったバイト数を加算します
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
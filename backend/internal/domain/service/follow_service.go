@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// FollowService はフォロー関係とフィードに関するドメインサービスです
+type FollowService struct {
+	followRepo   repository.FollowRepository
+	userRepo     repository.UserRepository
+	onsenLogRepo repository.OnsenLogRepository
+}
+
+// NewFollowService は新しいフォローサービスを作成します
+func NewFollowService(
+	followRepo repository.FollowRepository,
+	userRepo repository.UserRepository,
+	onsenLogRepo repository.OnsenLogRepository,
+) *FollowService {
+	return &FollowService{
+		followRepo:   followRepo,
+		userRepo:     userRepo,
+		onsenLogRepo: onsenLogRepo,
+	}
+}
+
+// Follow はユーザーにフォローリクエストを送ります
+// フォローするユーザーはユーザーID（メールアドレスではなくユーザーごとのランダムなID）で指定します
+// フォローされる側が承認するまで、フォロワー向けの温泉メモは閲覧できません
+func (s *FollowService) Follow(ctx context.Context, followerID, followeeID string) (entity.FollowProfile, error) {
+	if strings.TrimSpace(followeeID) == "" {
+		return entity.FollowProfile{}, errors.New("フォローするユーザーのIDを指定してください")
+	}
+
+	// フォローするユーザーを取得
+	followee, err := s.userRepo.FindByID(ctx, followeeID)
+	if err != nil || followee == nil {
+		return entity.FollowProfile{}, errors.New("ユーザーが見つかりません")
+	}
+
+	// 自分自身はフォローできない
+	if followee.UUID == followerID {
+		return entity.FollowProfile{}, errors.New("自分自身をフォローすることはできません")
+	}
+
+	// 既にフォローしている、またはフォローリクエストを送っている場合はその状態を返す
+	follow, err := s.followRepo.Find(ctx, followerID, followee.UUID)
+	if err != nil {
+		return entity.FollowProfile{}, err
+	}
+
+	// フォローリクエストを保存
+	if follow == nil {
+		follow = entity.NewFollow(followerID, followee.UUID)
+		if err := s.followRepo.Create(ctx, follow); err != nil {
+			return entity.FollowProfile{}, err
+		}
+	}
+
+	return toFollowProfile(follow, followee), nil
+}
+
+// Unfollow はユーザーのフォローを解除します（承認待ちのフォローリクエストの取り消しを含みます）
+func (s *FollowService) Unfollow(ctx context.Context, followerID, followeeID string) error {
+	return s.followRepo.Delete(ctx, followerID, followeeID)
+}
+
+// GetFollowRequests はユーザーへの承認待ちのフォローリクエストを新しい順に取得します
+func (s *FollowService) GetFollowRequests(ctx context.Context, userID string) ([]entity.FollowProfile, error) {
+	follows, err := s.followRepo.FindPendingByFolloweeID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toFollowProfiles(ctx, follows, func(follow *entity.Follow) string {
+		return follow.FollowerID
+	})
+}
+
+// ApproveFollowRequest はフォローリクエストを承認し、リクエストしたユーザーをフォロワーにします
+func (s *FollowService) ApproveFollowRequest(ctx context.Context, userID, followerID string) (entity.FollowProfile, error) {
+	// フォローリクエストを承認
+	if err := s.followRepo.Approve(ctx, followerID, userID); err != nil {
+		return entity.FollowProfile{}, err
+	}
+
+	// 承認したフォロー関係を取得
+	follow, err := s.followRepo.Find(ctx, followerID, userID)
+	if err != nil {
+		return entity.FollowProfile{}, err
+	}
+	if follow == nil {
+		return entity.FollowProfile{}, errors.New("フォローリクエストが見つかりません")
+	}
+	follower, err := s.userRepo.FindByID(ctx, followerID)
+	if err != nil {
+		return entity.FollowProfile{}, err
+	}
+
+	return toFollowProfile(follow, follower), nil
+}
+
+// RejectFollowRequest はフォローリクエストを拒否します
+func (s *FollowService) RejectFollowRequest(ctx context.Context, userID, followerID string) error {
+	follow, err := s.followRepo.Find(ctx, followerID, userID)
+	if err != nil {
+		return err
+	}
+	if follow == nil || follow.IsApproved() {
+		return errors.New("フォローリクエストが見つかりません")
+	}
+
+	return s.followRepo.Delete(ctx, followerID, userID)
+}
+
+// RemoveFollower は承認済みのフォロワーを解除します
+// 解除したユーザーはフォロワー向けの温泉メモを閲覧できなくなります
+func (s *FollowService) RemoveFollower(ctx context.Context, userID, followerID string) error {
+	follow, err := s.followRepo.Find(ctx, followerID, userID)
+	if err != nil {
+		return err
+	}
+	if follow == nil || !follow.IsApproved() {
+		return errors.New("このユーザーはフォロワーではありません")
+	}
+
+	return s.followRepo.Delete(ctx, followerID, userID)
+}
+
+// GetFollowing はユーザーがフォローしているユーザーをフォローした日時の新しい順に取得します
+func (s *FollowService) GetFollowing(ctx context.Context, userID string) ([]entity.FollowProfile, error) {
+	follows, err := s.followRepo.FindByFollowerID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toFollowProfiles(ctx, follows, func(follow *entity.Follow) string {
+		return follow.FolloweeID
+	})
+}
+
+// GetFollowers はユーザーをフォローしているユーザーをフォローされた日時の新しい順に取得します
+func (s *FollowService) GetFollowers(ctx context.Context, userID string) ([]entity.FollowProfile, error) {
+	follows, err := s.followRepo.FindByFolloweeID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toFollowProfiles(ctx, follows, func(follow *entity.Follow) string {
+		return follow.FollowerID
+	})
+}
+
+// GetFeed はフォローしているユーザーの閲覧できる温泉メモを作成日時の新しい順に取得します
+// 次のページがある場合は次のページのカーソルを返します
+func (s *FollowService) GetFeed(ctx context.Context, userID, cursor string, limit int) ([]*entity.OnsenLog, map[string]*entity.User, string, error) {
+	// フォローしているユーザーを取得
+	follows, err := s.followRepo.FindByFollowerID(ctx, userID)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	followeeIDs := make([]string, len(follows))
+	for i, follow := range follows {
+		followeeIDs[i] = follow.FolloweeID
+	}
+
+	return s.findVisibleOnsenLogs(ctx, followeeIDs, entity.FollowerVisibilities(), cursor, limit)
+}
+
+// GetUserOnsenLogs はユーザーの温泉メモのうち閲覧者が閲覧できるものを作成日時の新しい順に取得します
+// 閲覧者が未ログインの場合は公開の温泉メモのみ、フォローが承認されている場合はフォロワー向けの温泉メモも取得します
+func (s *FollowService) GetUserOnsenLogs(ctx context.Context, userID, viewerID, cursor string, limit int) ([]*entity.OnsenLog, map[string]*entity.User, string, error) {
+	// ユーザーの存在を確認
+	if _, err := s.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, nil, "", err
+	}
+
+	// 閲覧者に応じた公開範囲を決める
+	visibilities := []entity.Visibility{entity.VisibilityPublic}
+	if viewerID != "" && viewerID != userID {
+		following, err := s.followRepo.Exists(ctx, viewerID, userID)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		if following {
+			visibilities = entity.FollowerVisibilities()
+		}
+	}
+
+	return s.findVisibleOnsenLogs(ctx, []string{userID}, visibilities, cursor, limit)
+}
+
+// findVisibleOnsenLogs は公開範囲が一致する温泉メモを作成者と次のページのカーソル付きで取得します
+func (s *FollowService) findVisibleOnsenLogs(ctx context.Context, userIDs []string, visibilities []entity.Visibility, cursor string, limit int) ([]*entity.OnsenLog, map[string]*entity.User, string, error) {
+	// 取得件数を補正
+	if limit <= 0 {
+		limit = entity.DefaultFeedLimit
+	}
+	if limit > entity.MaxFeedLimit {
+		limit = entity.MaxFeedLimit
+	}
+
+	// カーソルを復元
+	var after *entity.FeedCursor
+	if cursor != "" {
+		parsed, err := entity.ParseFeedCursor(cursor)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		after = &parsed
+	}
+
+	// 次のページの有無を判定するため1件多く取得
+	onsenLogs, err := s.onsenLogRepo.FindVisibleByUserIDs(ctx, userIDs, visibilities, after, limit+1)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	nextCursor := ""
+	if len(onsenLogs) > limit {
+		onsenLogs = onsenLogs[:limit]
+		nextCursor = entity.NewFeedCursor(onsenLogs[limit-1]).Encode()
+	}
+
+	// 作成者を取得
+	authorIDs := make([]string, len(onsenLogs))
+	for i, onsenLog := range onsenLogs {
+		authorIDs[i] = onsenLog.UserID
+	}
+	authors, err := s.findUsers(ctx, authorIDs)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return onsenLogs, authors, nextCursor, nil
+}
+
+// toFollowProfiles はフォロー関係を相手のユーザーの公開プロフィールに変換します
+// 退会したユーザーは結果に含めません
+func (s *FollowService) toFollowProfiles(ctx context.Context, follows []*entity.Follow, otherID func(*entity.Follow) string) ([]entity.FollowProfile, error) {
+	otherIDs := make([]string, len(follows))
+	for i, follow := range follows {
+		otherIDs[i] = otherID(follow)
+	}
+	users, err := s.findUsers(ctx, otherIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]entity.FollowProfile, 0, len(follows))
+	for _, follow := range follows {
+		user, ok := users[otherID(follow)]
+		if !ok {
+			continue
+		}
+		profiles = append(profiles, toFollowProfile(follow, user))
+	}
+
+	return profiles, nil
+}
+
+// toFollowProfile はフォロー関係と相手のユーザーを公開プロフィールに変換します
+func toFollowProfile(follow *entity.Follow, user *entity.User) entity.FollowProfile {
+	return entity.FollowProfile{
+		UserID:     user.UUID,
+		Name:       user.Name,
+		Status:     follow.StatusOrDefault(),
+		FollowedAt: follow.CreatedAt,
+	}
+}
+
+// findUsers はIDに一致するユーザーをIDをキーにしたマップで取得します
+func (s *FollowService) findUsers(ctx context.Context, ids []string) (map[string]*entity.User, error) {
+	// 重複したIDを除く
+	seen := make(map[string]bool)
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, uniqueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*entity.User, len(users))
+	for _, user := range users {
+		byID[user.UUID] = user
+	}
+	return byID, nil
+}
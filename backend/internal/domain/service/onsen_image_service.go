@@ -6,6 +6,7 @@ import (
 	"io"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/yourusername/yuroku/internal/domain/entity"
 	"github.com/yourusername/yuroku/internal/domain/repository"
@@ -92,7 +93,18 @@ func (s *OnsenImageService) GetImagesByOnsenID(ctx context.Context, onsenID, use
 	return s.imageRepo.FindByOnsenID(ctx, onsenID)
 }
 
-// DeleteImage は温泉画像を削除します
+// GetImagesByOnsenLog は取得済みの温泉メモに紐づく画像を取得します（所有者の検証は呼び出し元で行います）
+func (s *OnsenImageService) GetImagesByOnsenLog(ctx context.Context, onsenLog *entity.OnsenLog) ([]*entity.OnsenImage, error) {
+	return s.imageRepo.FindByOnsenID(ctx, onsenLog.UUID)
+}
+
+// OpenImageFile は温泉画像のファイルをストレージから読み取り用に開きます（呼び出し元で閉じる必要があります）
+func (s *OnsenImageService) OpenImageFile(ctx context.Context, image *entity.OnsenImage) (io.ReadCloser, error) {
+	return s.storageRepo.Open(ctx, image.ImageURL)
+}
+
+// DeleteImage は温泉画像をゴミ箱に移動します
+// ゴミ箱の画像は保持期間が過ぎるまで復元でき、その後ファイルとともに完全に削除されます
 func (s *OnsenImageService) DeleteImage(ctx context.Context, imageID, userID string) error {
 	// 画像を取得
 	image, err := s.imageRepo.FindByID(ctx, imageID)
@@ -111,13 +123,8 @@ func (s *OnsenImageService) DeleteImage(ctx context.Context, imageID, userID str
 		return errors.New("この画像を削除する権限がありません")
 	}
 
-	// ストレージから画像を削除
-	if err := s.storageRepo.Delete(ctx, image.ImageURL); err != nil {
-		return err
-	}
-
-	// データベースから画像情報を削除
-	return s.imageRepo.Delete(ctx, imageID)
+	// 画像をゴミ箱に移動（ファイルは完全に削除するときに削除する）
+	return s.imageRepo.SoftDelete(ctx, image.UUID, time.Now())
 }
 
 // getExtensionFromContentType はContent-Typeからファイル拡張子を推測します
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// OnsenLogRevisionService は温泉メモの変更履歴に関するドメインサービスです
+type OnsenLogRevisionService struct {
+	revisionRepo repository.OnsenLogRevisionRepository
+	onsenLogRepo repository.OnsenLogRepository
+	onsenRepo    repository.OnsenRepository
+}
+
+// NewOnsenLogRevisionService は新しい温泉メモの変更履歴サービスを作成します
+func NewOnsenLogRevisionService(
+	revisionRepo repository.OnsenLogRevisionRepository,
+	onsenLogRepo repository.OnsenLogRepository,
+	onsenRepo repository.OnsenRepository,
+) *OnsenLogRevisionService {
+	return &OnsenLogRevisionService{
+		revisionRepo: revisionRepo,
+		onsenLogRepo: onsenLogRepo,
+		onsenRepo:    onsenRepo,
+	}
+}
+
+// GetRevisions は温泉メモの版を新しい順に取得します
+func (s *OnsenLogRevisionService) GetRevisions(ctx context.Context, onsenLogID, userID string) ([]*entity.OnsenLogRevision, error) {
+	// 温泉メモを取得
+	onsenLog, err := s.findOnsenLog(ctx, onsenLogID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.revisionRepo.FindByOnsenLogID(ctx, onsenLog.UUID)
+}
+
+// DiffRevisions は温泉メモの2つの版の状態を項目ごとに比較します
+// 版はIDまたは版番号で指定します
+func (s *OnsenLogRevisionService) DiffRevisions(ctx context.Context, onsenLogID, userID, fromID, toID string) (*entity.OnsenLogRevision, *entity.OnsenLogRevision, []entity.FieldChange, error) {
+	// 温泉メモを取得
+	onsenLog, err := s.findOnsenLog(ctx, onsenLogID, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 比較する版を取得
+	revisions, err := s.revisionRepo.FindByOnsenLogID(ctx, onsenLog.UUID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	from, err := findRevision(revisions, fromID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	to, err := findRevision(revisions, toID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return from, to, from.Snapshot.Diff(to.Snapshot), nil
+}
+
+// RevertOnsenLog は温泉メモを以前の版の状態に戻し、復元を新しい版として記録します
+// 版はIDまたは版番号で指定します。現在の状態と同じ版に戻す場合は版を記録しません
+func (s *OnsenLogRevisionService) RevertOnsenLog(ctx context.Context, onsenLogID, revisionID, userID string) (*entity.OnsenLog, error) {
+	// 温泉メモを取得
+	onsenLog, err := s.findOnsenLog(ctx, onsenLogID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 復元する版を取得
+	revisions, err := s.revisionRepo.FindByOnsenLogID(ctx, onsenLog.UUID)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := findRevision(revisions, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 版の温泉施設が削除されている場合は温泉名と所在地で照合し直す
+	snapshot := revision.Snapshot
+	if snapshot.OnsenID != "" {
+		onsen, err := s.onsenRepo.FindByID(ctx, snapshot.OnsenID)
+		if err != nil || onsen.UserID != userID {
+			onsen, err = findOrCreateOnsen(ctx, s.onsenRepo, userID, snapshot.Name, snapshot.Location)
+			if err != nil {
+				return nil, err
+			}
+		}
+		snapshot.OnsenID = onsen.UUID
+	}
+
+	// 変更がなければ何もしない
+	before, updatedAt := onsenLog.Snapshot(), onsenLog.UpdatedAt
+	if len(before.Diff(snapshot)) == 0 {
+		return onsenLog, nil
+	}
+
+	// 温泉メモを以前の版の状態に戻す
+	onsenLog.Revert(snapshot)
+	if err := s.onsenLogRepo.Update(ctx, onsenLog); err != nil {
+		return nil, err
+	}
+
+	// 復元を版として記録
+	if err := recordOnsenLogRevision(ctx, s.revisionRepo, onsenLog, before, updatedAt, userID, entity.RevisionActionRevert, revision.Version); err != nil {
+		return nil, err
+	}
+
+	return onsenLog, nil
+}
+
+// findOnsenLog は温泉メモを取得し、所有者を検証します
+func (s *OnsenLogRevisionService) findOnsenLog(ctx context.Context, onsenLogID, userID string) (*entity.OnsenLog, error) {
+	onsenLog, err := s.onsenLogRepo.FindByID(ctx, onsenLogID)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if onsenLog.UserID != userID {
+		return nil, errors.New("この温泉メモの変更履歴を参照する権限がありません")
+	}
+
+	return onsenLog, nil
+}
+
+// findRevision は版のリストからIDまたは版番号に一致する版を探します
+func findRevision(revisions []*entity.OnsenLogRevision, id string) (*entity.OnsenLogRevision, error) {
+	for _, revision := range revisions {
+		if revision.UUID == id || strconv.Itoa(revision.Version) == id {
+			return revision, nil
+		}
+	}
+	return nil, errors.New("版が見つかりません")
+}
+
+// recordOnsenLogRevision は温泉メモの変更を新しい版として記録します
+// 変更履歴のない温泉メモは、変更前の状態を最初の版として先に記録します
+// 変更された項目がない場合は版を記録しません
+func recordOnsenLogRevision(
+	ctx context.Context,
+	revisionRepo repository.OnsenLogRevisionRepository,
+	onsenLog *entity.OnsenLog,
+	before entity.OnsenLogSnapshot,
+	updatedAt time.Time,
+	actorID string,
+	action entity.RevisionAction,
+	revertedToVersion int,
+) error {
+	if len(before.Diff(onsenLog.Snapshot())) == 0 {
+		return nil
+	}
+
+	// 最新の版を取得
+	latest, err := revisionRepo.FindLatestByOnsenLogID(ctx, onsenLog.UUID)
+	if err != nil {
+		return err
+	}
+
+	// 変更履歴がなければ変更前の状態を最初の版として記録
+	if latest == nil {
+		latest = entity.NewInitialOnsenLogRevision(onsenLog, before, updatedAt)
+		if err := revisionRepo.Create(ctx, latest); err != nil {
+			return err
+		}
+	}
+
+	// 変更を新しい版として記録
+	revision := entity.NewOnsenLogRevision(onsenLog, latest.Version+1, actorID, action, &before)
+	revision.RevertedToVersion = revertedToVersion
+	return revisionRepo.Create(ctx, revision)
+}
@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/yourusername/yuroku/internal/domain/entity"
@@ -13,30 +16,36 @@ import (
 type OnsenLogService struct {
 	onsenLogRepo repository.OnsenLogRepository
 	imageRepo    repository.OnsenImageRepository
+	onsenRepo    repository.OnsenRepository
+	revisionRepo repository.OnsenLogRevisionRepository
 }
 
 // NewOnsenLogService は新しい温泉メモサービスを作成します
-func NewOnsenLogService(onsenLogRepo repository.OnsenLogRepository, imageRepo repository.OnsenImageRepository) *OnsenLogService {
+func NewOnsenLogService(onsenLogRepo repository.OnsenLogRepository, imageRepo repository.OnsenImageRepository, onsenRepo repository.OnsenRepository, revisionRepo repository.OnsenLogRevisionRepository) *OnsenLogService {
 	return &OnsenLogService{
 		onsenLogRepo: onsenLogRepo,
 		imageRepo:    imageRepo,
+		onsenRepo:    onsenRepo,
+		revisionRepo: revisionRepo,
 	}
 }
 
 // CreateOnsenLog は新しい温泉メモを作成します
-func (s *OnsenLogService) CreateOnsenLog(ctx context.Context, userID, name, location string, springType entity.SpringType, features []entity.Feature, visitDate time.Time, rating int, comment string) (*entity.OnsenLog, error) {
-	// 評価値のバリデーション
-	if !entity.ValidateRating(rating) {
-		return nil, errors.New("評価は0から5の間で指定してください")
+func (s *OnsenLogService) CreateOnsenLog(ctx context.Context, userID string, params entity.OnsenLogParams) (*entity.OnsenLog, error) {
+	// 入力値のバリデーション
+	if err := validateOnsenLogParams(params); err != nil {
+		return nil, err
 	}
 
-	// 温泉名のバリデーション
-	if name == "" {
-		return nil, errors.New("温泉名は必須です")
+	// 温泉施設を紐づけ
+	onsenID, err := s.resolveOnsenID(ctx, userID, params)
+	if err != nil {
+		return nil, err
 	}
+	params.OnsenID = onsenID
 
 	// 新しい温泉メモを作成
-	onsenLog := entity.NewOnsenLog(userID, name, location, springType, features, visitDate, rating, comment)
+	onsenLog := entity.NewOnsenLog(userID, params)
 
 	// 温泉メモを保存
 	if err := s.onsenLogRepo.Create(ctx, onsenLog); err != nil {
@@ -56,6 +65,38 @@ func (s *OnsenLogService) GetOnsenLogsByUserID(ctx context.Context, userID strin
 	return s.onsenLogRepo.FindByUserID(ctx, userID)
 }
 
+// EachOnsenLogByUserID はユーザーIDに紐づく温泉メモを訪問日の新しい順に1件ずつ読み取り、fnを呼び出します
+func (s *OnsenLogService) EachOnsenLogByUserID(ctx context.Context, userID string, fn func(onsenLog *entity.OnsenLog) error) error {
+	return s.onsenLogRepo.EachByUserID(ctx, userID, fn)
+}
+
+// GetYearbookOnsenLogs は温泉アルバムに含める温泉メモを訪問日の古い順に取得します
+// yearが0の場合はすべての年の温泉メモを、それ以外の場合は訪問日がその年の温泉メモのみを取得します
+func (s *OnsenLogService) GetYearbookOnsenLogs(ctx context.Context, userID string, year int) ([]*entity.OnsenLog, error) {
+	// 年を指定した場合は訪問日がその年の1月1日以上、翌年の1月1日未満の温泉メモに絞り込む
+	var from, to *time.Time
+	if year != 0 {
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(1, 0, 0)
+		from, to = &start, &end
+	}
+
+	onsenLogs, err := s.onsenLogRepo.FindByUserIDAndVisitDateRange(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(onsenLogs) == 0 {
+		return nil, errors.New("温泉アルバムに含める温泉メモがありません")
+	}
+
+	return onsenLogs, nil
+}
+
+// GetOnsenLogsByIDs はユーザーIDに紐づく温泉メモのうちIDに一致するものを指定したIDの順に取得します
+func (s *OnsenLogService) GetOnsenLogsByIDs(ctx context.Context, userID string, ids []string) ([]*entity.OnsenLog, error) {
+	return s.onsenLogRepo.FindByUserIDAndIDs(ctx, userID, ids)
+}
+
 // GetOnsenLogsByUserIDWithPagination はユーザーIDに紐づく温泉メモをページネーションで取得します
 func (s *OnsenLogService) GetOnsenLogsByUserIDWithPagination(ctx context.Context, userID string, page, limit int) ([]*entity.OnsenLog, int, error) {
 	if page < 1 {
@@ -68,26 +109,49 @@ func (s *OnsenLogService) GetOnsenLogsByUserIDWithPagination(ctx context.Context
 }
 
 // GetOnsenLogsByUserIDAndFilter はユーザーIDと条件に紐づく温泉メモを取得します
-func (s *OnsenLogService) GetOnsenLogsByUserIDAndFilter(ctx context.Context, userID string, springType entity.SpringType, location string, minRating int, startDate, endDate *time.Time, page, limit int) ([]*entity.OnsenLog, int, error) {
+func (s *OnsenLogService) GetOnsenLogsByUserIDAndFilter(ctx context.Context, userID string, filter repository.OnsenLogFilter, page, limit int) ([]*entity.OnsenLog, int, error) {
 	if page < 1 {
 		page = 1
 	}
 	if limit < 1 || limit > 100 {
 		limit = 10
 	}
-	return s.onsenLogRepo.FindByUserIDAndFilter(ctx, userID, springType, location, minRating, startDate, endDate, page, limit)
+	filter, err := normalizeOnsenLogFilter(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.onsenLogRepo.FindByUserIDAndFilter(ctx, userID, filter, page, limit)
 }
 
-// UpdateOnsenLog は温泉メモを更新します
-func (s *OnsenLogService) UpdateOnsenLog(ctx context.Context, id, userID, name, location string, springType entity.SpringType, features []entity.Feature, visitDate time.Time, rating int, comment string) (*entity.OnsenLog, error) {
-	// 評価値のバリデーション
-	if !entity.ValidateRating(rating) {
-		return nil, errors.New("評価は0から5の間で指定してください")
+// GetOnsenLogStats はユーザーIDと条件に紐づく温泉メモの統計を取得します
+func (s *OnsenLogService) GetOnsenLogStats(ctx context.Context, userID string, filter repository.OnsenLogFilter) (*entity.OnsenLogStats, error) {
+	filter, err := normalizeOnsenLogFilter(filter)
+	if err != nil {
+		return nil, err
 	}
+	return s.onsenLogRepo.AggregateStats(ctx, userID, filter)
+}
 
-	// 温泉名のバリデーション
-	if name == "" {
-		return nil, errors.New("温泉名は必須です")
+// GetNearbyOnsenLogs は指定地点の周辺にある温泉メモを距離の近い順に取得します
+func (s *OnsenLogService) GetNearbyOnsenLogs(ctx context.Context, userID string, latitude, longitude, radiusKm float64, limit int) ([]*entity.NearbyOnsenLog, error) {
+	// 位置情報のバリデーション
+	if !entity.ValidateCoordinates(&latitude, &longitude) {
+		return nil, errors.New("緯度と経度は有効な範囲で指定してください")
+	}
+	if math.IsNaN(radiusKm) || math.IsInf(radiusKm, 0) || radiusKm <= 0 || radiusKm > 1000 {
+		return nil, errors.New("検索半径は0より大きく1000km以下で指定してください")
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return s.onsenLogRepo.FindNearby(ctx, userID, latitude, longitude, radiusKm, limit)
+}
+
+// UpdateOnsenLog は温泉メモを更新し、変更を変更履歴の版として記録します
+func (s *OnsenLogService) UpdateOnsenLog(ctx context.Context, id, userID string, params entity.OnsenLogParams) (*entity.OnsenLog, error) {
+	// 入力値のバリデーション
+	if err := validateOnsenLogParams(params); err != nil {
+		return nil, err
 	}
 
 	// 温泉メモを取得
@@ -101,18 +165,37 @@ func (s *OnsenLogService) UpdateOnsenLog(ctx context.Context, id, userID, name,
 		return nil, errors.New("この温泉メモを編集する権限がありません")
 	}
 
+	// 温泉名と所在地が変わらなければ現在の温泉施設を維持
+	if params.OnsenID == "" && onsenLog.OnsenID != "" &&
+		params.Name == onsenLog.Name && params.Location == onsenLog.Location {
+		params.OnsenID = onsenLog.OnsenID
+	} else {
+		onsenID, err := s.resolveOnsenID(ctx, userID, params)
+		if err != nil {
+			return nil, err
+		}
+		params.OnsenID = onsenID
+	}
+
 	// 温泉メモを更新
-	onsenLog.Update(name, location, springType, features, visitDate, rating, comment)
+	before, updatedAt := onsenLog.Snapshot(), onsenLog.UpdatedAt
+	onsenLog.Update(params)
 
 	// 更新を保存
 	if err := s.onsenLogRepo.Update(ctx, onsenLog); err != nil {
 		return nil, err
 	}
 
+	// 変更を版として記録
+	if err := recordOnsenLogRevision(ctx, s.revisionRepo, onsenLog, before, updatedAt, userID, entity.RevisionActionUpdate, 0); err != nil {
+		return nil, err
+	}
+
 	return onsenLog, nil
 }
 
-// DeleteOnsenLog は温泉メモを削除します
+// DeleteOnsenLog は温泉メモを画像とともにゴミ箱に移動します
+// ゴミ箱の温泉メモは保持期間が過ぎるまで復元でき、その後完全に削除されます
 func (s *OnsenLogService) DeleteOnsenLog(ctx context.Context, id, userID string) error {
 	// 温泉メモを取得
 	onsenLog, err := s.onsenLogRepo.FindByID(ctx, id)
@@ -125,11 +208,256 @@ func (s *OnsenLogService) DeleteOnsenLog(ctx context.Context, id, userID string)
 		return errors.New("この温泉メモを削除する権限がありません")
 	}
 
-	// 関連する画像を削除
-	if err := s.imageRepo.DeleteByOnsenID(ctx, id); err != nil {
+	// 関連する画像をゴミ箱に移動（温泉メモと同じ削除日時で復元できるようにする）
+	deletedAt := time.Now()
+	if err := s.imageRepo.SoftDeleteByOnsenID(ctx, onsenLog.UUID, deletedAt); err != nil {
 		return err
 	}
 
-	// 温泉メモを削除
-	return s.onsenLogRepo.Delete(ctx, id)
+	// 温泉メモをゴミ箱に移動
+	return s.onsenLogRepo.SoftDelete(ctx, onsenLog.UUID, deletedAt)
+}
+
+// ImportOnsenLogs はファイルから読み取った温泉メモを取り込みます
+// 温泉名と訪問日が同じ既存の温泉メモは重複として扱い、policyに従って取り込まない・上書き・補完のいずれかを行います
+// ドライランの場合は保存せず、行ごとの検証結果と保存した場合の処理結果を返します
+func (s *OnsenLogService) ImportOnsenLogs(ctx context.Context, userID string, rows []entity.ImportRow, policy entity.ImportPolicy, dryRun bool) (*entity.ImportSummary, error) {
+	// 入力値のバリデーション
+	if !policy.IsValid() {
+		return nil, errors.New("重複時の扱いは skip・overwrite・merge のいずれかで指定してください")
+	}
+	if len(rows) > entity.MaxImportRows {
+		return nil, errors.New("一度にインポートできる温泉メモは1000件までです")
+	}
+	policy = policy.OrDefault()
+
+	// 重複判定のため既存の温泉メモを取得
+	onsenLogs, err := s.onsenLogRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*entity.OnsenLog, len(onsenLogs))
+	for _, onsenLog := range onsenLogs {
+		existing[entity.ImportDuplicateKey(onsenLog.Name, onsenLog.VisitDate)] = onsenLog
+	}
+
+	summary := &entity.ImportSummary{
+		DryRun: dryRun,
+		Policy: policy,
+		Rows:   []entity.ImportRowResult{},
+	}
+	imported := make(map[string]int)
+	for _, row := range rows {
+		summary.Add(s.importRow(ctx, userID, row, policy, dryRun, existing, imported))
+	}
+
+	return summary, nil
+}
+
+// importRow はインポートする1行を検証し、重複時の扱いに従って作成または更新します
+// importedはファイル内の重複を検出するため、取り込んだ行のキーと行番号を記録します
+func (s *OnsenLogService) importRow(
+	ctx context.Context,
+	userID string,
+	row entity.ImportRow,
+	policy entity.ImportPolicy,
+	dryRun bool,
+	existing map[string]*entity.OnsenLog,
+	imported map[string]int,
+) entity.ImportRowResult {
+	params := row.Params
+	result := entity.ImportRowResult{
+		Row:  row.Row,
+		Name: params.Name,
+	}
+	if !params.VisitDate.IsZero() {
+		result.VisitDate = params.VisitDate.Format("2006-01-02")
+	}
+	fail := func(messages ...string) entity.ImportRowResult {
+		result.Action = entity.ImportActionFailed
+		result.Errors = messages
+		return result
+	}
+
+	// 形式エラーと入力値のバリデーション
+	if len(row.Errors) > 0 {
+		return fail(row.Errors...)
+	}
+	if params.VisitDate.IsZero() {
+		return fail("訪問日は必須です")
+	}
+	if err := validateOnsenLogParams(params); err != nil {
+		return fail(err.Error())
+	}
+
+	// ファイル内の重複を検出
+	key := entity.ImportDuplicateKey(params.Name, params.VisitDate)
+	if previous, ok := imported[key]; ok {
+		return fail(fmt.Sprintf("%d行目と温泉名・訪問日が重複しています", previous))
+	}
+	imported[key] = row.Row
+
+	// 既存の温泉メモと重複しない場合は作成
+	onsenLog, ok := existing[key]
+	if !ok {
+		result.Action = entity.ImportActionCreated
+		if dryRun {
+			return result
+		}
+		created, err := s.CreateOnsenLog(ctx, userID, params)
+		if err != nil {
+			return fail(err.Error())
+		}
+		result.OnsenLogID = created.UUID
+		return result
+	}
+
+	// 重複した場合は重複時の扱いに従う
+	result.OnsenLogID = onsenLog.UUID
+	switch policy {
+	case entity.ImportPolicyOverwrite:
+		// 取り込んだ内容に公開範囲がなければ既存の公開範囲を維持
+		if params.Visibility == "" {
+			params.Visibility = onsenLog.Visibility
+		}
+	case entity.ImportPolicyMerge:
+		params = entity.MergeOnsenLogParams(onsenLog, params)
+		if err := validateOnsenLogParams(params); err != nil {
+			return fail(err.Error())
+		}
+	default:
+		result.Action = entity.ImportActionSkipped
+		return result
+	}
+
+	result.Action = entity.ImportActionUpdated
+	if dryRun {
+		return result
+	}
+	if _, err := s.UpdateOnsenLog(ctx, onsenLog.UUID, userID, params); err != nil {
+		return fail(err.Error())
+	}
+	return result
+}
+
+// isValidOnsenLogSortField は温泉メモの並び替え項目が有効かどうかを返します
+func isValidOnsenLogSortField(field string) bool {
+	switch field {
+	case "", repository.OnsenLogSortVisitDate, repository.OnsenLogSortRating:
+		return true
+	default:
+		return entity.RatingCriterion(field).IsValid()
+	}
+}
+
+// normalizeOnsenLogFilter は温泉メモの検索条件を検証し、比較できる形式に揃えます
+func normalizeOnsenLogFilter(filter repository.OnsenLogFilter) (repository.OnsenLogFilter, error) {
+	filter.Tags = entity.NormalizeTags(filter.Tags)
+	for i, value := range filter.PrefectureCodes {
+		prefecture, ok := entity.FindPrefecture(value)
+		if !ok {
+			return filter, errors.New("都道府県は都道府県コードまたは都道府県名で指定してください")
+		}
+		filter.PrefectureCodes[i] = prefecture.Code
+	}
+	if !filter.Tonicity.IsValid() {
+		return filter, errors.New("浸透圧の分類は低張性・等張性・高張性のいずれかで指定してください")
+	}
+	if !filter.StayType.IsValid() {
+		return filter, errors.New("日帰り・宿泊の区分が無効です")
+	}
+	for _, companion := range filter.Companions {
+		if !companion.IsValid() {
+			return filter, errors.New("同行者の区分が無効です")
+		}
+	}
+	for _, arrivalTime := range []*string{&filter.ArrivalFrom, &filter.ArrivalTo} {
+		if *arrivalTime == "" {
+			continue
+		}
+		normalized, ok := entity.NormalizeArrivalTime(*arrivalTime)
+		if !ok {
+			return filter, errors.New("到着時刻はHH:MM形式で指定してください")
+		}
+		*arrivalTime = normalized
+	}
+	filter.Currency = strings.ToUpper(filter.Currency)
+	if !isValidOnsenLogSortField(filter.Sort.Field) {
+		return filter, errors.New("並び替え項目が無効です")
+	}
+	if len([]rune(filter.Query)) > 100 {
+		return filter, errors.New("検索キーワードは100文字以内で指定してください")
+	}
+	return filter, nil
+}
+
+// validateOnsenLogParams は温泉メモの作成・更新時の入力値を検証します
+func validateOnsenLogParams(params entity.OnsenLogParams) error {
+	// 評価値のバリデーション
+	if params.Rating != nil && !entity.ValidateRating(*params.Rating) {
+		return errors.New("評価は0から5の間で指定してください")
+	}
+	if !params.RatingScores.Validate() {
+		return errors.New("項目別の評価は0から5の間で指定してください")
+	}
+	if params.Rating == nil && params.RatingScores.IsEmpty() {
+		return errors.New("総合評価または項目別の評価を指定してください")
+	}
+
+	// 温泉名のバリデーション
+	if params.Name == "" {
+		return errors.New("温泉名は必須です")
+	}
+
+	// 位置情報のバリデーション
+	if !entity.ValidateCoordinates(params.Latitude, params.Longitude) {
+		return errors.New("緯度と経度は両方を有効な範囲で指定してください")
+	}
+
+	// タグのバリデーション
+	if !entity.ValidateTags(entity.NormalizeTags(params.Tags)) {
+		return errors.New("タグは20個以内、それぞれ30文字以内で指定してください")
+	}
+
+	// 泉質と温泉分析書の測定値のバリデーション
+	if !entity.ValidateSpringTypes(params.SpringTypes) {
+		return errors.New("泉質は療養泉の泉質分類から指定してください")
+	}
+	if !params.WaterAnalysis.Validate() {
+		return errors.New("温泉分析書の測定値が無効です（pHは0〜14、浸透圧は低張性・等張性・高張性のいずれか）")
+	}
+
+	// 訪問時の状況のバリデーション
+	if !params.Visit.Normalize().Validate() {
+		return errors.New("訪問時の状況が無効です（到着時刻はHH:MM形式、滞在時間は7日以内、費用は0以上、通貨はISO 4217の通貨コード）")
+	}
+
+	// 公開範囲のバリデーション
+	if !params.Visibility.IsValid() {
+		return errors.New("公開範囲は private・followers・public のいずれかで指定してください")
+	}
+
+	return nil
+}
+
+// resolveOnsenID は温泉メモに紐づける温泉施設のIDを決定します
+// 施設IDが指定されていれば所有者を検証し、なければ温泉名と所在地で照合します
+func (s *OnsenLogService) resolveOnsenID(ctx context.Context, userID string, params entity.OnsenLogParams) (string, error) {
+	if params.OnsenID != "" {
+		onsen, err := s.onsenRepo.FindByID(ctx, params.OnsenID)
+		if err != nil {
+			return "", err
+		}
+		if onsen.UserID != userID {
+			return "", errors.New("この温泉施設を利用する権限がありません")
+		}
+		return onsen.UUID, nil
+	}
+
+	onsen, err := findOrCreateOnsen(ctx, s.onsenRepo, userID, params.Name, params.Location)
+	if err != nil {
+		return "", err
+	}
+
+	return onsen.UUID, nil
 }
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// OnsenService は温泉施設に関するドメインサービスです
+type OnsenService struct {
+	onsenRepo    repository.OnsenRepository
+	onsenLogRepo repository.OnsenLogRepository
+}
+
+// NewOnsenService は新しい温泉施設サービスを作成します
+func NewOnsenService(onsenRepo repository.OnsenRepository, onsenLogRepo repository.OnsenLogRepository) *OnsenService {
+	return &OnsenService{
+		onsenRepo:    onsenRepo,
+		onsenLogRepo: onsenLogRepo,
+	}
+}
+
+// CreateOnsen は新しい温泉施設を作成します
+func (s *OnsenService) CreateOnsen(ctx context.Context, userID, name, location string) (*entity.Onsen, error) {
+	// 温泉名のバリデーション
+	if name == "" {
+		return nil, errors.New("温泉名は必須です")
+	}
+
+	// 新しい温泉施設を作成
+	onsen := entity.NewOnsen(userID, name, location)
+
+	// 温泉施設を保存
+	if err := s.onsenRepo.Create(ctx, onsen); err != nil {
+		return nil, err
+	}
+
+	return onsen, nil
+}
+
+// GetOnsen は温泉施設を取得します
+func (s *OnsenService) GetOnsen(ctx context.Context, id, userID string) (*entity.Onsen, error) {
+	onsen, err := s.onsenRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if onsen.UserID != userID {
+		return nil, errors.New("この温泉施設を閲覧する権限がありません")
+	}
+
+	return onsen, nil
+}
+
+// GetOnsensByUserID はユーザーIDに紐づく温泉施設を取得します
+func (s *OnsenService) GetOnsensByUserID(ctx context.Context, userID string) ([]*entity.Onsen, error) {
+	return s.onsenRepo.FindByUserID(ctx, userID)
+}
+
+// GetVisitSummaries はユーザーの温泉施設ごとの訪問集計を取得します
+func (s *OnsenService) GetVisitSummaries(ctx context.Context, userID string) ([]*entity.OnsenVisitSummary, error) {
+	return s.onsenLogRepo.SummarizeVisitsByOnsen(ctx, userID)
+}
+
+// GetOnsenLogs は温泉施設に紐づく温泉メモを取得します
+func (s *OnsenService) GetOnsenLogs(ctx context.Context, id, userID string) ([]*entity.OnsenLog, error) {
+	onsen, err := s.GetOnsen(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.onsenLogRepo.FindByOnsenID(ctx, onsen.UUID)
+}
+
+// UpdateOnsen は温泉施設を更新します
+func (s *OnsenService) UpdateOnsen(ctx context.Context, id, userID, name, location string) (*entity.Onsen, error) {
+	// 温泉名のバリデーション
+	if name == "" {
+		return nil, errors.New("温泉名は必須です")
+	}
+
+	// 温泉施設を取得
+	onsen, err := s.onsenRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if onsen.UserID != userID {
+		return nil, errors.New("この温泉施設を編集する権限がありません")
+	}
+
+	// 温泉施設を更新
+	onsen.Update(name, location)
+
+	// 更新を保存
+	if err := s.onsenRepo.Update(ctx, onsen); err != nil {
+		return nil, err
+	}
+
+	return onsen, nil
+}
+
+// DeleteOnsen は温泉施設を削除します
+func (s *OnsenService) DeleteOnsen(ctx context.Context, id, userID string) error {
+	// 温泉施設を取得
+	onsen, err := s.onsenRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// ユーザーIDの検証
+	if onsen.UserID != userID {
+		return errors.New("この温泉施設を削除する権限がありません")
+	}
+
+	// 温泉メモが紐づいている施設は削除できない
+	onsenLogs, err := s.onsenLogRepo.FindByOnsenID(ctx, onsen.UUID)
+	if err != nil {
+		return err
+	}
+	if len(onsenLogs) > 0 {
+		return errors.New("温泉メモが紐づいている温泉施設は削除できません")
+	}
+
+	return s.onsenRepo.Delete(ctx, onsen.UUID)
+}
+
+// FindOrCreateOnsen は温泉名と所在地が一致する温泉施設を取得し、なければ作成します
+func (s *OnsenService) FindOrCreateOnsen(ctx context.Context, userID, name, location string) (*entity.Onsen, error) {
+	return findOrCreateOnsen(ctx, s.onsenRepo, userID, name, location)
+}
+
+// findOrCreateOnsen は正規化した温泉名と所在地で温泉施設を照合し、なければ作成します
+func findOrCreateOnsen(ctx context.Context, onsenRepo repository.OnsenRepository, userID, name, location string) (*entity.Onsen, error) {
+	// 照合キーで既存の施設を検索
+	onsen, err := onsenRepo.FindByUserIDAndKey(ctx, userID, entity.NormalizeOnsenKey(name, location))
+	if err != nil {
+		return nil, err
+	}
+	if onsen != nil {
+		return onsen, nil
+	}
+
+	// 見つからなければ新しい施設を作成
+	onsen = entity.NewOnsen(userID, name, location)
+	if err := onsenRepo.Create(ctx, onsen); err != nil {
+		return nil, err
+	}
+
+	return onsen, nil
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// PrefectureService は都道府県ごとの訪問状況に関するドメインサービスです
+type PrefectureService struct {
+	onsenLogRepo repository.OnsenLogRepository
+}
+
+// NewPrefectureService は新しい都道府県サービスを作成します
+func NewPrefectureService(onsenLogRepo repository.OnsenLogRepository) *PrefectureService {
+	return &PrefectureService{
+		onsenLogRepo: onsenLogRepo,
+	}
+}
+
+// GetPrefectures は47都道府県を都道府県コード順に取得します
+func (s *PrefectureService) GetPrefectures() []entity.Prefecture {
+	return entity.Prefectures()
+}
+
+// GetCompletionMap はユーザーの47都道府県の制覇状況を取得します
+func (s *PrefectureService) GetCompletionMap(ctx context.Context, userID string) (*entity.PrefectureCompletionMap, error) {
+	summaries, err := s.onsenLogRepo.SummarizeVisitsByPrefecture(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewPrefectureCompletionMap(summaries), nil
+}
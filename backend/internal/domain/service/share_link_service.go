@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// ShareLinkService は温泉メモの共有リンクに関するドメインサービスです
+type ShareLinkService struct {
+	shareLinkRepo repository.ShareLinkRepository
+	onsenLogRepo  repository.OnsenLogRepository
+	imageRepo     repository.OnsenImageRepository
+}
+
+// NewShareLinkService は新しい共有リンクサービスを作成します
+func NewShareLinkService(
+	shareLinkRepo repository.ShareLinkRepository,
+	onsenLogRepo repository.OnsenLogRepository,
+	imageRepo repository.OnsenImageRepository,
+) *ShareLinkService {
+	return &ShareLinkService{
+		shareLinkRepo: shareLinkRepo,
+		onsenLogRepo:  onsenLogRepo,
+		imageRepo:     imageRepo,
+	}
+}
+
+// CreateShareLink は温泉メモの共有リンクを作成します
+// expiresInが0の場合は無期限の共有リンクになります
+func (s *ShareLinkService) CreateShareLink(ctx context.Context, onsenLogID, userID string, expiresIn time.Duration) (*entity.ShareLink, error) {
+	// 有効期間のバリデーション
+	if expiresIn < 0 {
+		return nil, errors.New("有効期間は0以上で指定してください")
+	}
+	if expiresIn > entity.MaxShareLinkDuration {
+		return nil, errors.New("有効期間は365日以内で指定してください")
+	}
+
+	// 温泉メモを取得
+	onsenLog, err := s.onsenLogRepo.FindByID(ctx, onsenLogID)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if onsenLog.UserID != userID {
+		return nil, errors.New("この温泉メモを共有する権限がありません")
+	}
+
+	// 新しい共有リンクを作成
+	shareLink, err := entity.NewShareLink(userID, onsenLog.UUID, expiresIn)
+	if err != nil {
+		return nil, err
+	}
+
+	// 共有リンクを保存
+	if err := s.shareLinkRepo.Create(ctx, shareLink); err != nil {
+		return nil, err
+	}
+
+	return shareLink, nil
+}
+
+// GetActiveShareLinks はユーザーの有効な共有リンクを作成日時の新しい順に取得します
+func (s *ShareLinkService) GetActiveShareLinks(ctx context.Context, userID string) ([]*entity.ShareLink, error) {
+	return s.shareLinkRepo.FindActiveByUserID(ctx, userID, time.Now())
+}
+
+// RevokeShareLink は共有リンクを取り消します
+func (s *ShareLinkService) RevokeShareLink(ctx context.Context, id, userID string) error {
+	// 共有リンクを取得
+	shareLink, err := s.shareLinkRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// ユーザーIDの検証
+	if shareLink.UserID != userID {
+		return errors.New("この共有リンクを取り消す権限がありません")
+	}
+
+	// 取り消し済みの場合は何もしない
+	if shareLink.IsRevoked() {
+		return nil
+	}
+
+	// 共有リンクを取り消す
+	shareLink.Revoke()
+	return s.shareLinkRepo.Update(ctx, shareLink)
+}
+
+// GetSharedOnsenLog は共有トークンから公開されている温泉メモとその画像を取得します
+// 取り消された共有リンクや共有元の温泉メモが削除された共有リンクは見つからないものとして扱います
+func (s *ShareLinkService) GetSharedOnsenLog(ctx context.Context, token string) (*entity.ShareLink, *entity.OnsenLog, []*entity.OnsenImage, error) {
+	// 共有リンクを取得
+	shareLink, err := s.shareLinkRepo.FindByToken(ctx, token)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if shareLink.IsRevoked() {
+		return nil, nil, nil, errors.New("共有リンクが見つかりません")
+	}
+	if shareLink.IsExpired(time.Now()) {
+		return nil, nil, nil, errors.New("共有リンクの有効期限が切れています")
+	}
+
+	// 温泉メモを取得
+	onsenLog, err := s.onsenLogRepo.FindByID(ctx, shareLink.OnsenLogID)
+	if err != nil || onsenLog.UserID != shareLink.UserID {
+		return nil, nil, nil, errors.New("共有リンクが見つかりません")
+	}
+
+	// 画像を取得
+	images, err := s.imageRepo.FindByOnsenID(ctx, onsenLog.UUID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return shareLink, onsenLog, images, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// TagService はユーザー定義タグに関するドメインサービスです
+type TagService struct {
+	onsenLogRepo repository.OnsenLogRepository
+	revisionRepo repository.OnsenLogRevisionRepository
+}
+
+// NewTagService は新しいタグサービスを作成します
+func NewTagService(onsenLogRepo repository.OnsenLogRepository, revisionRepo repository.OnsenLogRevisionRepository) *TagService {
+	return &TagService{
+		onsenLogRepo: onsenLogRepo,
+		revisionRepo: revisionRepo,
+	}
+}
+
+// GetTags はユーザーが使用しているタグを使用回数の多い順に取得します
+func (s *TagService) GetTags(ctx context.Context, userID string) ([]*entity.TagUsage, error) {
+	return s.onsenLogRepo.FindTagsByUserID(ctx, userID)
+}
+
+// RenameTag はユーザーのすべての温泉メモでタグの名前を変更します
+// 変更後の名前が既に使用されている場合はエラーを返します（統合を使用してください）
+func (s *TagService) RenameTag(ctx context.Context, userID, name, newName string) (int, error) {
+	name = entity.NormalizeTag(name)
+	newName = entity.NormalizeTag(newName)
+
+	// タグ名のバリデーション
+	if !entity.ValidateTag(newName) {
+		return 0, errors.New("タグは30文字以内で指定してください")
+	}
+	if name == newName {
+		return 0, errors.New("変更後のタグ名が変更前と同じです")
+	}
+
+	// 変更前のタグが存在し、変更後のタグが未使用であることを確認
+	tags, err := s.onsenLogRepo.FindTagsByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if findTagUsage(tags, name) == nil {
+		return 0, errors.New("タグが見つかりません")
+	}
+	if findTagUsage(tags, newName) != nil {
+		return 0, errors.New("変更後のタグは既に使用されています。タグの統合を使用してください")
+	}
+
+	return s.updateTaggedOnsenLogs(ctx, userID, name, func(onsenLog *entity.OnsenLog) {
+		onsenLog.RenameTag(name, newName)
+	})
+}
+
+// MergeTags は統合元のタグを統合先のタグに置き換えます
+// 両方のタグが付いている温泉メモでは、統合先のタグだけが残ります
+func (s *TagService) MergeTags(ctx context.Context, userID, source, target string) (int, error) {
+	source = entity.NormalizeTag(source)
+	target = entity.NormalizeTag(target)
+
+	// タグ名のバリデーション
+	if source == "" || !entity.ValidateTag(target) {
+		return 0, errors.New("統合元と統合先のタグを30文字以内で指定してください")
+	}
+	if source == target {
+		return 0, errors.New("統合元と統合先に同じタグは指定できません")
+	}
+
+	// 統合元のタグが存在することを確認
+	tags, err := s.onsenLogRepo.FindTagsByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if findTagUsage(tags, source) == nil {
+		return 0, errors.New("タグが見つかりません")
+	}
+
+	return s.updateTaggedOnsenLogs(ctx, userID, source, func(onsenLog *entity.OnsenLog) {
+		onsenLog.RenameTag(source, target)
+	})
+}
+
+// DeleteTag はユーザーのすべての温泉メモからタグを削除します
+func (s *TagService) DeleteTag(ctx context.Context, userID, name string) (int, error) {
+	name = entity.NormalizeTag(name)
+
+	updated, err := s.updateTaggedOnsenLogs(ctx, userID, name, func(onsenLog *entity.OnsenLog) {
+		onsenLog.RemoveTag(name)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if updated == 0 {
+		return 0, errors.New("タグが見つかりません")
+	}
+
+	return updated, nil
+}
+
+// updateTaggedOnsenLogs はタグが付いている温泉メモを1件ずつ更新し、変更を変更履歴の版として記録します
+// ゴミ箱の温泉メモは更新しません。更新した件数を返します
+func (s *TagService) updateTaggedOnsenLogs(ctx context.Context, userID, tag string, update func(onsenLog *entity.OnsenLog)) (int, error) {
+	// タグが付いている温泉メモを取得
+	onsenLogs, err := s.onsenLogRepo.FindByUserIDAndTag(ctx, userID, tag)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, onsenLog := range onsenLogs {
+		// タグを更新
+		before, updatedAt := onsenLog.Snapshot(), onsenLog.UpdatedAt
+		update(onsenLog)
+
+		// 更新を保存
+		if err := s.onsenLogRepo.Update(ctx, onsenLog); err != nil {
+			return 0, err
+		}
+
+		// 変更を版として記録
+		if err := recordOnsenLogRevision(ctx, s.revisionRepo, onsenLog, before, updatedAt, userID, entity.RevisionActionUpdate, 0); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(onsenLogs), nil
+}
+
+// findTagUsage はタグ名に一致する使用状況を探します
+func findTagUsage(tags []*entity.TagUsage, name string) *entity.TagUsage {
+	for _, tag := range tags {
+		if tag.Name == name {
+			return tag
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// trashPurgeBatchSize は1回の完全削除で処理する温泉メモ・画像の件数です
+const trashPurgeBatchSize = 100
+
+// TrashService はゴミ箱の温泉メモと画像に関するドメインサービスです
+type TrashService struct {
+	onsenLogRepo repository.OnsenLogRepository
+	imageRepo    repository.OnsenImageRepository
+	storageRepo  repository.StorageRepository
+	revisionRepo repository.OnsenLogRevisionRepository
+	retention    time.Duration
+}
+
+// NewTrashService は新しいゴミ箱サービスを作成します
+// retentionはゴミ箱の温泉メモと画像を完全に削除するまでの保持期間です
+func NewTrashService(
+	onsenLogRepo repository.OnsenLogRepository,
+	imageRepo repository.OnsenImageRepository,
+	storageRepo repository.StorageRepository,
+	revisionRepo repository.OnsenLogRevisionRepository,
+	retention time.Duration,
+) *TrashService {
+	if retention <= 0 {
+		retention = entity.DefaultTrashRetention
+	}
+	return &TrashService{
+		onsenLogRepo: onsenLogRepo,
+		imageRepo:    imageRepo,
+		storageRepo:  storageRepo,
+		revisionRepo: revisionRepo,
+		retention:    retention,
+	}
+}
+
+// Retention はゴミ箱の保持期間を返します
+func (s *TrashService) Retention() time.Duration {
+	return s.retention
+}
+
+// GetTrash はユーザーのゴミ箱の温泉メモと画像を削除日時の新しい順に取得します
+// 温泉メモと一緒に削除された画像は温泉メモとともに復元するため、画像には含めません
+// 温泉メモより先に画像だけを削除していた場合は、温泉メモがゴミ箱にあっても画像に含めます（復元は温泉メモの復元後に行います）
+func (s *TrashService) GetTrash(ctx context.Context, userID string) ([]*entity.OnsenLog, []*entity.OnsenImage, error) {
+	onsenLogs, err := s.onsenLogRepo.FindDeletedByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deletedImages, err := s.imageRepo.FindDeletedByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ゴミ箱の温泉メモと一緒に削除された画像（削除日時が温泉メモと同じもの）を除く
+	onsenLogDeletedAt := make(map[string]time.Time, len(onsenLogs))
+	for _, onsenLog := range onsenLogs {
+		onsenLogDeletedAt[onsenLog.UUID] = *onsenLog.DeletedAt
+	}
+	images := make([]*entity.OnsenImage, 0, len(deletedImages))
+	for _, image := range deletedImages {
+		if deletedAt, ok := onsenLogDeletedAt[image.OnsenID]; ok && image.DeletedAt.Equal(deletedAt) {
+			continue
+		}
+		images = append(images, image)
+	}
+
+	return onsenLogs, images, nil
+}
+
+// RestoreOnsenLog はゴミ箱の温泉メモを一緒に削除された画像とともに復元します
+func (s *TrashService) RestoreOnsenLog(ctx context.Context, id, userID string) (*entity.OnsenLog, error) {
+	// ゴミ箱の温泉メモを取得
+	onsenLog, err := s.findDeletedOnsenLog(ctx, id, userID, "この温泉メモを復元する権限がありません")
+	if err != nil {
+		return nil, err
+	}
+
+	// 温泉メモと同時に削除された画像を復元
+	if err := s.imageRepo.RestoreByOnsenID(ctx, onsenLog.UUID, *onsenLog.DeletedAt); err != nil {
+		return nil, err
+	}
+
+	// 温泉メモを復元
+	if err := s.onsenLogRepo.Restore(ctx, onsenLog.UUID); err != nil {
+		return nil, err
+	}
+
+	onsenLog.DeletedAt = nil
+	return onsenLog, nil
+}
+
+// PurgeOnsenLog はゴミ箱の温泉メモを保持期間を待たずに完全に削除します
+func (s *TrashService) PurgeOnsenLog(ctx context.Context, id, userID string) error {
+	// ゴミ箱の温泉メモを取得
+	onsenLog, err := s.findDeletedOnsenLog(ctx, id, userID, "この温泉メモを削除する権限がありません")
+	if err != nil {
+		return err
+	}
+
+	return s.purge(ctx, onsenLog)
+}
+
+// RestoreImage はゴミ箱の温泉画像を復元します
+// 温泉メモがゴミ箱にある場合は、先に温泉メモを復元する必要があります
+func (s *TrashService) RestoreImage(ctx context.Context, id, userID string) (*entity.OnsenImage, error) {
+	// ゴミ箱の画像を取得
+	image, err := s.findDeletedImage(ctx, id, userID, "この画像を復元する権限がありません")
+	if err != nil {
+		return nil, err
+	}
+
+	// 温泉メモがゴミ箱にないことを確認
+	if _, err := s.onsenLogRepo.FindByID(ctx, image.OnsenID); err != nil {
+		return nil, errors.New("画像の温泉メモが見つかりません。温泉メモがゴミ箱にある場合は先に温泉メモを復元してください")
+	}
+
+	// 最大3枚までの制限
+	images, err := s.imageRepo.FindByOnsenID(ctx, image.OnsenID)
+	if err != nil {
+		return nil, err
+	}
+	if len(images) >= 3 {
+		return nil, errors.New("画像は最大3枚までのため復元できません")
+	}
+
+	// 画像を復元
+	if err := s.imageRepo.Restore(ctx, image.UUID); err != nil {
+		return nil, err
+	}
+
+	image.DeletedAt = nil
+	return image, nil
+}
+
+// PurgeImage はゴミ箱の温泉画像を保持期間を待たずにファイルとともに完全に削除します
+func (s *TrashService) PurgeImage(ctx context.Context, id, userID string) error {
+	// ゴミ箱の画像を取得
+	image, err := s.findDeletedImage(ctx, id, userID, "この画像を削除する権限がありません")
+	if err != nil {
+		return err
+	}
+
+	return s.purgeImage(ctx, image)
+}
+
+// PurgeExpired は保持期間を過ぎたゴミ箱の温泉メモと画像を保存した画像ファイルとともに完全に削除し、削除した件数を返します
+func (s *TrashService) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	before := now.Add(-s.retention)
+
+	purged := 0
+	for {
+		// 保持期間を過ぎた温泉メモを古い順に取得
+		onsenLogs, err := s.onsenLogRepo.FindDeletedBefore(ctx, before, trashPurgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, onsenLog := range onsenLogs {
+			if err := s.purge(ctx, onsenLog); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(onsenLogs) < trashPurgeBatchSize {
+			break
+		}
+	}
+
+	for {
+		// 保持期間を過ぎた画像を古い順に取得
+		// 温泉メモとともに削除した画像は温泉メモと同時に保持期間を過ぎるため、上の温泉メモの完全削除で削除済みです
+		images, err := s.imageRepo.FindDeletedBefore(ctx, before, trashPurgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, image := range images {
+			if err := s.purgeImage(ctx, image); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(images) < trashPurgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// findDeletedOnsenLog はゴミ箱の温泉メモを取得し、所有者を検証します
+func (s *TrashService) findDeletedOnsenLog(ctx context.Context, id, userID, forbiddenMessage string) (*entity.OnsenLog, error) {
+	onsenLog, err := s.onsenLogRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if onsenLog.UserID != userID {
+		return nil, errors.New(forbiddenMessage)
+	}
+
+	return onsenLog, nil
+}
+
+// findDeletedImage はゴミ箱の画像を取得し、所有者を検証します
+func (s *TrashService) findDeletedImage(ctx context.Context, id, userID, forbiddenMessage string) (*entity.OnsenImage, error) {
+	image, err := s.imageRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if image.UserID != userID {
+		return nil, errors.New(forbiddenMessage)
+	}
+
+	return image, nil
+}
+
+// purgeImage は画像のレコードと保存した画像ファイルを完全に削除します
+// 画像ファイルの削除に失敗した場合もレコードの削除は続けます
+func (s *TrashService) purgeImage(ctx context.Context, image *entity.OnsenImage) error {
+	if err := s.storageRepo.Delete(ctx, image.ImageURL); err != nil {
+		log.Printf("Failed to delete image file %s: %v", image.ImageURL, err)
+	}
+	return s.imageRepo.Delete(ctx, image.UUID)
+}
+
+// purge は温泉メモと画像のレコード、保存した画像ファイル、変更履歴を完全に削除します
+// 画像ファイルの削除に失敗した場合もレコードの削除は続けます
+func (s *TrashService) purge(ctx context.Context, onsenLog *entity.OnsenLog) error {
+	// 画像ファイルを削除
+	images, err := s.imageRepo.FindDeletedByOnsenID(ctx, onsenLog.UUID)
+	if err != nil {
+		return err
+	}
+	for _, image := range images {
+		if err := s.storageRepo.Delete(ctx, image.ImageURL); err != nil {
+			log.Printf("Failed to delete image file %s: %v", image.ImageURL, err)
+		}
+	}
+
+	// 画像のレコードを削除
+	if err := s.imageRepo.DeleteByOnsenID(ctx, onsenLog.UUID); err != nil {
+		return err
+	}
+
+	// 変更履歴を削除
+	if err := s.revisionRepo.DeleteByOnsenLogID(ctx, onsenLog.UUID); err != nil {
+		return err
+	}
+
+	// 温泉メモを削除
+	return s.onsenLogRepo.Delete(ctx, onsenLog.UUID)
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// TripService は旅行に関するドメインサービスです
+type TripService struct {
+	tripRepo     repository.TripRepository
+	onsenLogRepo repository.OnsenLogRepository
+}
+
+// NewTripService は新しい旅行サービスを作成します
+func NewTripService(tripRepo repository.TripRepository, onsenLogRepo repository.OnsenLogRepository) *TripService {
+	return &TripService{
+		tripRepo:     tripRepo,
+		onsenLogRepo: onsenLogRepo,
+	}
+}
+
+// CreateTrip は新しい旅行を作成します
+func (s *TripService) CreateTrip(ctx context.Context, userID string, params entity.TripParams) (*entity.Trip, error) {
+	// 入力値のバリデーション
+	if err := s.validateTripParams(ctx, userID, params); err != nil {
+		return nil, err
+	}
+
+	// 新しい旅行を作成
+	trip := entity.NewTrip(userID, params)
+
+	// 旅行を保存
+	if err := s.tripRepo.Create(ctx, trip); err != nil {
+		return nil, err
+	}
+
+	return trip, nil
+}
+
+// GetTrip は旅行を取得します
+func (s *TripService) GetTrip(ctx context.Context, id, userID string) (*entity.Trip, error) {
+	trip, err := s.tripRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if trip.UserID != userID {
+		return nil, errors.New("この旅行を閲覧する権限がありません")
+	}
+
+	return trip, nil
+}
+
+// GetTripsByUserID はユーザーIDに紐づく旅行を開始日の新しい順に取得します
+func (s *TripService) GetTripsByUserID(ctx context.Context, userID string) ([]*entity.Trip, error) {
+	return s.tripRepo.FindByUserID(ctx, userID)
+}
+
+// GetTripOnsenLogs は旅行に含まれる温泉メモを旅程の順に取得します（削除された温泉メモは含みません）
+func (s *TripService) GetTripOnsenLogs(ctx context.Context, trip *entity.Trip) ([]*entity.OnsenLog, error) {
+	return s.onsenLogRepo.FindByUserIDAndIDs(ctx, trip.UserID, trip.OnsenLogIDs)
+}
+
+// UpdateTrip は旅行を更新します
+func (s *TripService) UpdateTrip(ctx context.Context, id, userID string, params entity.TripParams) (*entity.Trip, error) {
+	// 入力値のバリデーション
+	if err := s.validateTripParams(ctx, userID, params); err != nil {
+		return nil, err
+	}
+
+	// 旅行を取得
+	trip, err := s.tripRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if trip.UserID != userID {
+		return nil, errors.New("この旅行を編集する権限がありません")
+	}
+
+	// 旅行を更新
+	trip.Update(params)
+
+	// 更新を保存
+	if err := s.tripRepo.Update(ctx, trip); err != nil {
+		return nil, err
+	}
+
+	return trip, nil
+}
+
+// DeleteTrip は旅行を削除します（旅行に含まれる温泉メモは削除しません）
+func (s *TripService) DeleteTrip(ctx context.Context, id, userID string) error {
+	// 旅行を取得
+	trip, err := s.tripRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// ユーザーIDの検証
+	if trip.UserID != userID {
+		return errors.New("この旅行を削除する権限がありません")
+	}
+
+	// 旅行を削除
+	return s.tripRepo.Delete(ctx, trip.UUID)
+}
+
+// validateTripParams は旅行の入力値を検証します
+// 温泉メモのIDはすべてユーザー自身の温泉メモである必要があります
+func (s *TripService) validateTripParams(ctx context.Context, userID string, params entity.TripParams) error {
+	params = params.Normalize()
+	if params.Title == "" {
+		return errors.New("旅行のタイトルは必須です")
+	}
+	if len([]rune(params.Title)) > entity.MaxTripTitleLength {
+		return errors.New("旅行のタイトルが長すぎます")
+	}
+	if len([]rune(params.Notes)) > entity.MaxTripNotesLength {
+		return errors.New("メモが長すぎます")
+	}
+	if params.StartDate.IsZero() || params.EndDate.IsZero() {
+		return errors.New("旅行の開始日と終了日は必須です")
+	}
+	if params.EndDate.Before(params.StartDate) {
+		return errors.New("旅行の終了日は開始日以降である必要があります")
+	}
+	if len(params.OnsenLogIDs) > entity.MaxOnsenLogsPerTrip {
+		return errors.New("旅行に含められる温泉メモの数を超えています")
+	}
+
+	onsenLogs, err := s.onsenLogRepo.FindByUserIDAndIDs(ctx, userID, params.OnsenLogIDs)
+	if err != nil {
+		return err
+	}
+	if len(onsenLogs) != len(params.OnsenLogIDs) {
+		return errors.New("温泉メモが見つかりません")
+	}
+	return nil
+}
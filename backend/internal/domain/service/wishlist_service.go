@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+)
+
+// WishlistService は行きたい温泉に関するドメインサービスです
+type WishlistService struct {
+	wishlistRepo repository.WishlistRepository
+}
+
+// NewWishlistService は新しい行きたい温泉サービスを作成します
+func NewWishlistService(wishlistRepo repository.WishlistRepository) *WishlistService {
+	return &WishlistService{
+		wishlistRepo: wishlistRepo,
+	}
+}
+
+// CreateWishlistItem は新しい行きたい温泉を作成します
+func (s *WishlistService) CreateWishlistItem(ctx context.Context, userID string, params entity.WishlistItemParams) (*entity.WishlistItem, error) {
+	// 入力値のバリデーション
+	if err := validateWishlistItemParams(params); err != nil {
+		return nil, err
+	}
+
+	// 新しい行きたい温泉を作成
+	item := entity.NewWishlistItem(userID, params)
+
+	// 行きたい温泉を保存
+	if err := s.wishlistRepo.Create(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// GetWishlistItem は行きたい温泉を取得します
+func (s *WishlistService) GetWishlistItem(ctx context.Context, id, userID string) (*entity.WishlistItem, error) {
+	item, err := s.wishlistRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if item.UserID != userID {
+		return nil, errors.New("この行きたい温泉を閲覧する権限がありません")
+	}
+
+	return item, nil
+}
+
+// GetWishlist はユーザーIDと条件に紐づく行きたい温泉を優先度の高い順に取得します
+func (s *WishlistService) GetWishlist(ctx context.Context, userID string, filter repository.WishlistFilter) ([]*entity.WishlistItem, error) {
+	if filter.TargetSeason != "" && !filter.TargetSeason.IsValid() {
+		return nil, errors.New("無効な季節です")
+	}
+	return s.wishlistRepo.FindByUserID(ctx, userID, filter)
+}
+
+// UpdateWishlistItem は行きたい温泉を更新します
+func (s *WishlistService) UpdateWishlistItem(ctx context.Context, id, userID string, params entity.WishlistItemParams) (*entity.WishlistItem, error) {
+	// 入力値のバリデーション
+	if err := validateWishlistItemParams(params); err != nil {
+		return nil, err
+	}
+
+	// 行きたい温泉を取得
+	item, err := s.wishlistRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if item.UserID != userID {
+		return nil, errors.New("この行きたい温泉を編集する権限がありません")
+	}
+
+	// 行きたい温泉を更新
+	item.Update(params)
+
+	// 更新を保存
+	if err := s.wishlistRepo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// DeleteWishlistItem は行きたい温泉を削除します
+// 変換済みの温泉メモは削除せず、温泉メモに残る変換元のIDのみが参照先を失います
+func (s *WishlistService) DeleteWishlistItem(ctx context.Context, id, userID string) error {
+	// 行きたい温泉を取得
+	item, err := s.wishlistRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// ユーザーIDの検証
+	if item.UserID != userID {
+		return errors.New("この行きたい温泉を削除する権限がありません")
+	}
+
+	// 行きたい温泉を削除
+	return s.wishlistRepo.Delete(ctx, item.UUID)
+}
+
+// PrepareConversion は温泉メモに変換する行きたい温泉を取得します（変換済みの場合はエラー）
+func (s *WishlistService) PrepareConversion(ctx context.Context, id, userID string) (*entity.WishlistItem, error) {
+	item, err := s.wishlistRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザーIDの検証
+	if item.UserID != userID {
+		return nil, errors.New("この行きたい温泉を編集する権限がありません")
+	}
+
+	if item.IsVisited() {
+		return nil, errors.New("この行きたい温泉は既に温泉メモに変換されています")
+	}
+
+	return item, nil
+}
+
+// MarkVisited は行きたい温泉を訪問済みにし、変換した温泉メモと紐づけます
+func (s *WishlistService) MarkVisited(ctx context.Context, item *entity.WishlistItem, onsenLogID string) error {
+	item.MarkVisited(onsenLogID)
+	return s.wishlistRepo.Update(ctx, item)
+}
+
+// validateWishlistItemParams は行きたい温泉の入力値を検証します
+func validateWishlistItemParams(params entity.WishlistItemParams) error {
+	params = params.Normalize()
+	if params.Name == "" {
+		return errors.New("温泉名は必須です")
+	}
+	if len([]rune(params.Notes)) > entity.MaxWishlistNotesLength {
+		return errors.New("メモが長すぎます")
+	}
+	if !params.Priority.IsValid() {
+		return errors.New("無効な優先度です")
+	}
+	if params.TargetSeason != "" && !params.TargetSeason.IsValid() {
+		return errors.New("無効な季節です")
+	}
+	return nil
+}
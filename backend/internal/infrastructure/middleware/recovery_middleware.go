@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HandleRecovery はgin.CustomRecoveryで復帰したpanicを処理します
+// http.ErrAbortHandlerはレスポンスを途中で中断するためのpanicなので、再度panicしてnet/httpに接続（HTTP/2ではストリーム）を中断させます
+// それ以外のpanicはgin.Recoveryと同じく500を返します
+func HandleRecovery(ctx *gin.Context, err interface{}) {
+	if err == http.ErrAbortHandler {
+		panic(err)
+	}
+	ctx.AbortWithStatus(http.StatusInternalServerError)
+}
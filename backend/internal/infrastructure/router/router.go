@@ -9,6 +9,7 @@ import (
 	"github.com/yourusername/yuroku/internal/adapter/controller"
 	"github.com/yourusername/yuroku/internal/adapter/gateway"
 	"github.com/yourusername/yuroku/internal/adapter/presenter"
+	"github.com/yourusername/yuroku/internal/domain/entity"
 	"github.com/yourusername/yuroku/internal/domain/service"
 	"github.com/yourusername/yuroku/internal/infrastructure/database"
 	"github.com/yourusername/yuroku/internal/infrastructure/middleware"
@@ -18,11 +19,23 @@ import (
 
 // Router はAPIルーターを提供します
 type Router struct {
-	engine               *gin.Engine
-	authMiddleware       *middleware.AuthMiddleware
-	authController       *controller.AuthController
-	onsenLogController   *controller.OnsenLogController
-	onsenImageController *controller.OnsenImageController
+	engine                     *gin.Engine
+	authMiddleware             *middleware.AuthMiddleware
+	authController             *controller.AuthController
+	onsenLogController         *controller.OnsenLogController
+	onsenImageController       *controller.OnsenImageController
+	onsenController            *controller.OnsenController
+	tagController              *controller.TagController
+	prefectureController       *controller.PrefectureController
+	achievementController      *controller.AchievementController
+	wishlistController         *controller.WishlistController
+	tripController             *controller.TripController
+	shareLinkController        *controller.ShareLinkController
+	followController           *controller.FollowController
+	trashController            *controller.TrashController
+	onsenLogRevisionController *controller.OnsenLogRevisionController
+	exportJobController        *controller.ExportJobController
+	calendarFeedController     *controller.CalendarFeedController
 }
 
 // NewRouter は新しいAPIルーターを作成します
@@ -31,8 +44,22 @@ func NewRouter(
 	authController *controller.AuthController,
 	onsenLogController *controller.OnsenLogController,
 	onsenImageController *controller.OnsenImageController,
+	onsenController *controller.OnsenController,
+	tagController *controller.TagController,
+	prefectureController *controller.PrefectureController,
+	achievementController *controller.AchievementController,
+	wishlistController *controller.WishlistController,
+	tripController *controller.TripController,
+	shareLinkController *controller.ShareLinkController,
+	followController *controller.FollowController,
+	trashController *controller.TrashController,
+	onsenLogRevisionController *controller.OnsenLogRevisionController,
+	exportJobController *controller.ExportJobController,
+	calendarFeedController *controller.CalendarFeedController,
 ) *Router {
-	engine := gin.Default()
+	// http.ErrAbortHandlerによるpanicはレスポンスの中断として扱うため、独自のリカバリーを使う
+	engine := gin.New()
+	engine.Use(gin.Logger(), gin.CustomRecovery(middleware.HandleRecovery))
 
 	// CORSミドルウェアを設定
 	config := cors.DefaultConfig()
@@ -43,11 +70,23 @@ func NewRouter(
 	engine.Use(cors.New(config))
 
 	return &Router{
-		engine:               engine,
-		authMiddleware:       authMiddleware,
-		authController:       authController,
-		onsenLogController:   onsenLogController,
-		onsenImageController: onsenImageController,
+		engine:                     engine,
+		authMiddleware:             authMiddleware,
+		authController:             authController,
+		onsenLogController:         onsenLogController,
+		onsenImageController:       onsenImageController,
+		onsenController:            onsenController,
+		tagController:              tagController,
+		prefectureController:       prefectureController,
+		achievementController:      achievementController,
+		wishlistController:         wishlistController,
+		tripController:             tripController,
+		shareLinkController:        shareLinkController,
+		followController:           followController,
+		trashController:            trashController,
+		onsenLogRevisionController: onsenLogRevisionController,
+		exportJobController:        exportJobController,
+		calendarFeedController:     calendarFeedController,
 	}
 }
 
@@ -80,10 +119,18 @@ func (r *Router) SetupRoutes() {
 		onsenLogs.POST("", r.onsenLogController.CreateOnsenLog)
 		onsenLogs.GET("", r.onsenLogController.GetOnsenLogs)
 		onsenLogs.GET("/filter", r.onsenLogController.GetFilteredOnsenLogs)
+		onsenLogs.GET("/stats", r.onsenLogController.GetOnsenLogStats)
 		onsenLogs.GET("/export", r.onsenLogController.ExportOnsenLogs)
+		onsenLogs.GET("/yearbook", r.onsenLogController.ExportYearbook)
+		onsenLogs.POST("/import", r.onsenLogController.ImportOnsenLogs)
+		onsenLogs.GET("/nearby", r.onsenLogController.GetNearbyOnsenLogs)
 		onsenLogs.GET("/:id", r.onsenLogController.GetOnsenLog)
 		onsenLogs.PUT("/:id", r.onsenLogController.UpdateOnsenLog)
 		onsenLogs.DELETE("/:id", r.onsenLogController.DeleteOnsenLog)
+		onsenLogs.POST("/:id/shares", r.shareLinkController.CreateShareLink)
+		onsenLogs.GET("/:id/revisions", r.onsenLogRevisionController.GetRevisions)
+		onsenLogs.GET("/:id/revisions/diff", r.onsenLogRevisionController.DiffRevisions)
+		onsenLogs.POST("/:id/revisions/:revision_id/revert", r.onsenLogRevisionController.RevertOnsenLog)
 	}
 
 	// 温泉画像関連のルート
@@ -94,6 +141,124 @@ func (r *Router) SetupRoutes() {
 		onsenImages.DELETE("/:image_id", r.onsenImageController.DeleteImage)
 	}
 
+	// 温泉施設関連のルート
+	onsens := api.Group("/onsens", r.authMiddleware.RequireAuth())
+	{
+		onsens.POST("", r.onsenController.CreateOnsen)
+		onsens.GET("", r.onsenController.GetOnsens)
+		onsens.GET("/:id", r.onsenController.GetOnsen)
+		onsens.GET("/:id/visits", r.onsenController.GetOnsenVisits)
+		onsens.PUT("/:id", r.onsenController.UpdateOnsen)
+		onsens.DELETE("/:id", r.onsenController.DeleteOnsen)
+	}
+
+	// タグ関連のルート
+	tags := api.Group("/tags", r.authMiddleware.RequireAuth())
+	{
+		tags.GET("", r.tagController.GetTags)
+		tags.POST("/merge", r.tagController.MergeTags)
+		tags.PUT("/:name", r.tagController.RenameTag)
+		tags.DELETE("/:name", r.tagController.DeleteTag)
+	}
+
+	// 都道府県関連のルート
+	prefectures := api.Group("/prefectures")
+	{
+		prefectures.GET("", r.prefectureController.GetPrefectures)
+		prefectures.GET("/completion", r.authMiddleware.RequireAuth(), r.prefectureController.GetCompletionMap)
+	}
+
+	// 実績関連のルート
+	achievements := api.Group("/achievements", r.authMiddleware.RequireAuth())
+	{
+		achievements.GET("", r.achievementController.GetAchievements)
+	}
+
+	// 行きたい温泉関連のルート
+	wishlist := api.Group("/wishlist", r.authMiddleware.RequireAuth())
+	{
+		wishlist.POST("", r.wishlistController.CreateWishlistItem)
+		wishlist.GET("", r.wishlistController.GetWishlist)
+		wishlist.GET("/:id", r.wishlistController.GetWishlistItem)
+		wishlist.PUT("/:id", r.wishlistController.UpdateWishlistItem)
+		wishlist.DELETE("/:id", r.wishlistController.DeleteWishlistItem)
+		wishlist.POST("/:id/convert", r.wishlistController.ConvertToOnsenLog)
+	}
+
+	// 旅行関連のルート
+	trips := api.Group("/trips", r.authMiddleware.RequireAuth())
+	{
+		trips.POST("", r.tripController.CreateTrip)
+		trips.GET("", r.tripController.GetTrips)
+		trips.GET("/:id", r.tripController.GetTrip)
+		trips.GET("/:id/export", r.tripController.ExportTrip)
+		trips.PUT("/:id", r.tripController.UpdateTrip)
+		trips.DELETE("/:id", r.tripController.DeleteTrip)
+	}
+
+	// 共有リンク関連のルート
+	shares := api.Group("/shares", r.authMiddleware.RequireAuth())
+	{
+		shares.GET("", r.shareLinkController.GetShareLinks)
+		shares.DELETE("/:id", r.shareLinkController.RevokeShareLink)
+	}
+
+	// 公開閲覧用のルート（認証は任意）
+	public := api.Group("/public", r.authMiddleware.OptionalAuth())
+	{
+		public.GET("/logs/:token", r.shareLinkController.GetPublicOnsenLog)
+	}
+
+	// フォロー関連のルート
+	follows := api.Group("/follows", r.authMiddleware.RequireAuth())
+	{
+		follows.POST("", r.followController.Follow)
+		follows.GET("/following", r.followController.GetFollowing)
+		follows.GET("/followers", r.followController.GetFollowers)
+		follows.DELETE("/:user_id", r.followController.Unfollow)
+		follows.GET("/requests", r.followController.GetFollowRequests)
+		follows.POST("/requests/:user_id/approve", r.followController.ApproveFollowRequest)
+		follows.DELETE("/requests/:user_id", r.followController.RejectFollowRequest)
+		follows.DELETE("/followers/:user_id", r.followController.RemoveFollower)
+	}
+
+	// フィード関連のルート
+	api.GET("/feed", r.authMiddleware.RequireAuth(), r.followController.GetFeed)
+
+	// ユーザーの公開温泉メモ関連のルート（認証は任意）
+	users := api.Group("/users", r.authMiddleware.OptionalAuth())
+	{
+		users.GET("/:id/onsen_logs", r.followController.GetUserOnsenLogs)
+	}
+
+	// ゴミ箱関連のルート
+	trash := api.Group("/trash", r.authMiddleware.RequireAuth())
+	{
+		trash.GET("", r.trashController.GetTrash)
+		trash.POST("/:id/restore", r.trashController.RestoreOnsenLog)
+		trash.DELETE("/:id", r.trashController.PurgeOnsenLog)
+		trash.POST("/images/:image_id/restore", r.trashController.RestoreImage)
+		trash.DELETE("/images/:image_id", r.trashController.PurgeImage)
+	}
+
+	// エクスポートジョブ関連のルート
+	exports := api.Group("/exports")
+	{
+		exports.POST("", r.authMiddleware.RequireAuth(), r.exportJobController.CreateExportJob)
+		exports.GET("", r.authMiddleware.RequireAuth(), r.exportJobController.GetExportJobs)
+		exports.GET("/:id", r.authMiddleware.RequireAuth(), r.exportJobController.GetExportJob)
+		exports.GET("/:id/download", r.exportJobController.DownloadExportFile)
+	}
+
+	// カレンダーフィード関連のルート
+	calendarFeed := api.Group("/calendar_feed")
+	{
+		calendarFeed.POST("", r.authMiddleware.RequireAuth(), r.calendarFeedController.IssueCalendarFeed)
+		calendarFeed.GET("", r.authMiddleware.RequireAuth(), r.calendarFeedController.GetCalendarFeed)
+		calendarFeed.DELETE("", r.authMiddleware.RequireAuth(), r.calendarFeedController.DeleteCalendarFeed)
+		calendarFeed.GET("/:token", r.calendarFeedController.GetCalendarFeedICS)
+	}
+
 	// ヘルスチェック
 	api.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -126,6 +291,15 @@ func SetupDependencies() (*Router, error) {
 	userRepo := gateway.NewMongoUserRepository(db)
 	onsenLogRepo := gateway.NewMongoOnsenLogRepository(db)
 	onsenImageRepo := gateway.NewMongoOnsenImageRepository(db)
+	onsenRepo := gateway.NewMongoOnsenRepository(db)
+	achievementRepo := gateway.NewMongoAchievementRepository(db)
+	wishlistRepo := gateway.NewMongoWishlistRepository(db)
+	tripRepo := gateway.NewMongoTripRepository(db)
+	shareLinkRepo := gateway.NewMongoShareLinkRepository(db)
+	followRepo := gateway.NewMongoFollowRepository(db)
+	onsenLogRevisionRepo := gateway.NewMongoOnsenLogRevisionRepository(db)
+	exportJobRepo := gateway.NewMongoExportJobRepository(db)
+	calendarFeedRepo := gateway.NewMongoCalendarFeedRepository(db)
 
 	// JWT設定
 	jwtSecret := os.Getenv("JWT_SECRET")
@@ -137,18 +311,54 @@ func SetupDependencies() (*Router, error) {
 
 	// ドメインサービスを作成
 	authService := service.NewAuthService(userRepo, jwtSecret)
-	onsenLogService := service.NewOnsenLogService(onsenLogRepo, onsenImageRepo)
+	onsenLogService := service.NewOnsenLogService(onsenLogRepo, onsenImageRepo, onsenRepo, onsenLogRevisionRepo)
 	onsenImageService := service.NewOnsenImageService(onsenImageRepo, onsenLogRepo, storageRepo)
+	onsenService := service.NewOnsenService(onsenRepo, onsenLogRepo)
+	tagService := service.NewTagService(onsenLogRepo, onsenLogRevisionRepo)
+	prefectureService := service.NewPrefectureService(onsenLogRepo)
+	achievementService := service.NewAchievementService(achievementRepo, onsenLogRepo)
+	wishlistService := service.NewWishlistService(wishlistRepo)
+	tripService := service.NewTripService(tripRepo, onsenLogRepo)
+	shareLinkService := service.NewShareLinkService(shareLinkRepo, onsenLogRepo, onsenImageRepo)
+	followService := service.NewFollowService(followRepo, userRepo, onsenLogRepo)
+	trashService := service.NewTrashService(onsenLogRepo, onsenImageRepo, storageRepo, onsenLogRevisionRepo, entity.DefaultTrashRetention)
+	onsenLogRevisionService := service.NewOnsenLogRevisionService(onsenLogRevisionRepo, onsenLogRepo, onsenRepo)
+	exportJobService := service.NewExportJobService(exportJobRepo, onsenLogRepo, tripRepo, storageRepo, entity.DefaultExportRetention)
+	calendarFeedService := service.NewCalendarFeedService(calendarFeedRepo, onsenLogRepo, tripRepo)
 
 	// プレゼンターを作成
 	authPresenter := presenter.NewAuthPresenter()
 	onsenLogPresenter := presenter.NewOnsenLogPresenter()
 	onsenImagePresenter := presenter.NewOnsenImagePresenter()
+	onsenPresenter := presenter.NewOnsenPresenter()
+	tagPresenter := presenter.NewTagPresenter()
+	prefecturePresenter := presenter.NewPrefecturePresenter()
+	achievementPresenter := presenter.NewAchievementPresenter()
+	wishlistPresenter := presenter.NewWishlistPresenter()
+	tripPresenter := presenter.NewTripPresenter()
+	shareLinkPresenter := presenter.NewShareLinkPresenter()
+	followPresenter := presenter.NewFollowPresenter()
+	trashPresenter := presenter.NewTrashPresenter()
+	onsenLogRevisionPresenter := presenter.NewOnsenLogRevisionPresenter()
+	exportJobPresenter := presenter.NewExportJobPresenter()
+	calendarFeedPresenter := presenter.NewCalendarFeedPresenter()
 
 	// プレゼンターをOutputPortにアダプト
 	authOutputPort := presenter.NewAuthOutputAdapter(authPresenter)
 	onsenLogOutputPort := presenter.NewOnsenLogOutputAdapter(onsenLogPresenter)
 	onsenImageOutputPort := presenter.NewOnsenImageOutputAdapter(onsenImagePresenter)
+	onsenOutputPort := presenter.NewOnsenOutputAdapter(onsenPresenter)
+	tagOutputPort := presenter.NewTagOutputAdapter(tagPresenter)
+	prefectureOutputPort := presenter.NewPrefectureOutputAdapter(prefecturePresenter)
+	achievementOutputPort := presenter.NewAchievementOutputAdapter(achievementPresenter)
+	wishlistOutputPort := presenter.NewWishlistOutputAdapter(wishlistPresenter)
+	tripOutputPort := presenter.NewTripOutputAdapter(tripPresenter)
+	shareLinkOutputPort := presenter.NewShareLinkOutputAdapter(shareLinkPresenter)
+	followOutputPort := presenter.NewFollowOutputAdapter(followPresenter)
+	trashOutputPort := presenter.NewTrashOutputAdapter(trashPresenter)
+	onsenLogRevisionOutputPort := presenter.NewOnsenLogRevisionOutputAdapter(onsenLogRevisionPresenter)
+	exportJobOutputPort := presenter.NewExportJobOutputAdapter(exportJobPresenter)
+	calendarFeedOutputPort := presenter.NewCalendarFeedOutputAdapter(calendarFeedPresenter)
 
 	// ユースケースを作成
 	authInteractor := interactor.NewAuthInteractor(
@@ -158,13 +368,37 @@ func SetupDependencies() (*Router, error) {
 		accessTokenDuration,
 		refreshTokenDuration,
 	)
-	onsenLogInteractor := interactor.NewOnsenLogInteractor(onsenLogService, onsenImageService, onsenLogOutputPort)
+	onsenLogInteractor := interactor.NewOnsenLogInteractor(onsenLogService, onsenImageService, achievementService, onsenLogOutputPort)
 	onsenImageInteractor := interactor.NewOnsenImageInteractor(onsenImageService, onsenImageOutputPort)
+	onsenInteractor := interactor.NewOnsenInteractor(onsenService, onsenOutputPort)
+	tagInteractor := interactor.NewTagInteractor(tagService, tagOutputPort)
+	prefectureInteractor := interactor.NewPrefectureInteractor(prefectureService, prefectureOutputPort)
+	achievementInteractor := interactor.NewAchievementInteractor(achievementService, achievementOutputPort)
+	wishlistInteractor := interactor.NewWishlistInteractor(wishlistService, onsenLogInteractor, wishlistOutputPort)
+	tripInteractor := interactor.NewTripInteractor(tripService, onsenLogInteractor, tripOutputPort)
+	shareLinkInteractor := interactor.NewShareLinkInteractor(shareLinkService, shareLinkOutputPort)
+	followInteractor := interactor.NewFollowInteractor(followService, followOutputPort)
+	trashInteractor := interactor.NewTrashInteractor(trashService, trashOutputPort)
+	onsenLogRevisionInteractor := interactor.NewOnsenLogRevisionInteractor(onsenLogRevisionService, onsenLogRevisionOutputPort)
+	exportJobInteractor := interactor.NewExportJobInteractor(exportJobService, onsenLogInteractor, tripInteractor, exportJobOutputPort)
+	calendarFeedInteractor := interactor.NewCalendarFeedInteractor(calendarFeedService, calendarFeedOutputPort, interactor.DefaultPublicBaseURL)
 
 	// コントローラーを作成
 	authController := controller.NewAuthController(authInteractor)
 	onsenLogController := controller.NewOnsenLogController(onsenLogInteractor)
 	onsenImageController := controller.NewOnsenImageController(onsenImageInteractor)
+	onsenController := controller.NewOnsenController(onsenInteractor)
+	tagController := controller.NewTagController(tagInteractor)
+	prefectureController := controller.NewPrefectureController(prefectureInteractor)
+	achievementController := controller.NewAchievementController(achievementInteractor)
+	wishlistController := controller.NewWishlistController(wishlistInteractor)
+	tripController := controller.NewTripController(tripInteractor)
+	shareLinkController := controller.NewShareLinkController(shareLinkInteractor)
+	followController := controller.NewFollowController(followInteractor)
+	trashController := controller.NewTrashController(trashInteractor)
+	onsenLogRevisionController := controller.NewOnsenLogRevisionController(onsenLogRevisionInteractor)
+	exportJobController := controller.NewExportJobController(exportJobInteractor)
+	calendarFeedController := controller.NewCalendarFeedController(calendarFeedInteractor)
 
 	// 認証ミドルウェアを作成
 	authMiddleware := middleware.NewAuthMiddleware(authService)
@@ -175,6 +409,18 @@ func SetupDependencies() (*Router, error) {
 		authController,
 		onsenLogController,
 		onsenImageController,
+		onsenController,
+		tagController,
+		prefectureController,
+		achievementController,
+		wishlistController,
+		tripController,
+		shareLinkController,
+		followController,
+		trashController,
+		onsenLogRevisionController,
+		exportJobController,
+		calendarFeedController,
 	)
 
 	return router, nil
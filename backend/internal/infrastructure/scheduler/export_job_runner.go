@@ -0,0 +1,106 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// DefaultExportJobInterval は実行待ちのエクスポートジョブを確認する既定の間隔です
+const DefaultExportJobInterval = 5 * time.Second
+
+// exportCleanupInterval は期限切れのエクスポートファイルを削除する間隔です
+const exportCleanupInterval = time.Hour
+
+// exportRequeueInterval は中断されたエクスポートジョブを実行待ちに戻す間隔です
+// 他のサーバーの停止で中断されたジョブも、実行中のまま一定時間が経過すると実行待ちに戻します
+const exportRequeueInterval = time.Minute
+
+// ExportJobRunner はエクスポートジョブをサーバー内で実行するジョブランナーです
+// 一定間隔で実行待ちのジョブを順に実行し、ダウンロードの有効期限が切れたファイルを定期的に削除します
+type ExportJobRunner struct {
+	exportJobUseCase port.ExportJobInputPort
+	interval         time.Duration
+}
+
+// NewExportJobRunner は新しいエクスポートジョブランナーを作成します
+// intervalが0以下の場合は既定の間隔で実行待ちのジョブを確認します
+func NewExportJobRunner(exportJobUseCase port.ExportJobInputPort, interval time.Duration) *ExportJobRunner {
+	if interval <= 0 {
+		interval = DefaultExportJobInterval
+	}
+	return &ExportJobRunner{
+		exportJobUseCase: exportJobUseCase,
+		interval:         interval,
+	}
+}
+
+// Run はコンテキストがキャンセルされるまでエクスポートジョブを実行します
+// 停止で中断されたジョブは定期的に実行待ちに戻してから実行します
+func (r *ExportJobRunner) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	var lastCleanup, lastRequeue time.Time
+	for {
+		if time.Since(lastCleanup) >= exportCleanupInterval {
+			r.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+		if time.Since(lastRequeue) >= exportRequeueInterval {
+			r.requeue(ctx)
+			lastRequeue = time.Now()
+		}
+		r.runPending(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// requeue は中断されたエクスポートジョブを実行待ちに戻し、結果をログに出力します
+func (r *ExportJobRunner) requeue(ctx context.Context) {
+	count, err := r.exportJobUseCase.RequeueInterruptedExportJobs(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Failed to requeue export jobs: %v", err)
+		}
+		return
+	}
+	if count > 0 {
+		log.Printf("Requeued %d interrupted export jobs", count)
+	}
+}
+
+// runPending は実行待ちのエクスポートジョブを実行し、結果をログに出力します
+func (r *ExportJobRunner) runPending(ctx context.Context) {
+	count, err := r.exportJobUseCase.RunPendingExportJobs(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Failed to run export jobs: %v", err)
+		}
+		return
+	}
+	if count > 0 {
+		log.Printf("Ran %d export jobs", count)
+	}
+}
+
+// cleanup は期限切れのエクスポートファイルを削除し、結果をログに出力します
+func (r *ExportJobRunner) cleanup(ctx context.Context) {
+	count, err := r.exportJobUseCase.PurgeExpiredExports(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Failed to purge expired exports: %v", err)
+		}
+		return
+	}
+	if count > 0 {
+		log.Printf("Purged %d expired export files", count)
+	}
+}
@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// DefaultTrashPurgeInterval はゴミ箱の定期削除を実行する既定の間隔です
+const DefaultTrashPurgeInterval = time.Hour
+
+// TrashPurger は保持期間を過ぎたゴミ箱の温泉メモと画像を定期的に完全削除します
+type TrashPurger struct {
+	trashUseCase port.TrashInputPort
+	interval     time.Duration
+}
+
+// NewTrashPurger は新しいゴミ箱の定期削除を作成します
+// intervalが0以下の場合は既定の間隔で実行します
+func NewTrashPurger(trashUseCase port.TrashInputPort, interval time.Duration) *TrashPurger {
+	if interval <= 0 {
+		interval = DefaultTrashPurgeInterval
+	}
+	return &TrashPurger{
+		trashUseCase: trashUseCase,
+		interval:     interval,
+	}
+}
+
+// Run はコンテキストがキャンセルされるまで定期削除を実行します
+// 起動直後に一度実行し、その後は一定間隔で実行します
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge は保持期間を過ぎたゴミ箱の温泉メモと画像を完全削除し、結果をログに出力します
+func (p *TrashPurger) purge(ctx context.Context) {
+	count, err := p.trashUseCase.PurgeExpiredTrash(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Failed to purge trash: %v", err)
+		}
+		return
+	}
+	if count > 0 {
+		log.Printf("Purged %d onsen logs and images from trash", count)
+	}
+}
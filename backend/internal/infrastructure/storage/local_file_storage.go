@@ -46,9 +46,11 @@ func (s *LocalFileStorage) Upload(ctx context.Context, file io.Reader, fileName,
 	}
 	defer dst.Close()
 
-	// ファイルデータを書き込み
+	// ファイルデータを書き込み（途中で失敗した場合は書きかけのファイルを削除する）
 	_, err = io.Copy(dst, file)
 	if err != nil {
+		dst.Close()
+		os.Remove(filePath)
 		return "", fmt.Errorf("ファイルの書き込みに失敗しました: %w", err)
 	}
 
@@ -77,6 +79,26 @@ func (s *LocalFileStorage) Delete(ctx context.Context, fileURL string) error {
 	return nil
 }
 
+// Open はファイルを読み取り用に開きます
+func (s *LocalFileStorage) Open(ctx context.Context, fileURL string) (io.ReadCloser, error) {
+	// ファイルURLからパスを抽出
+	filePath := s.extractPathFromURL(fileURL)
+	if filePath == "" {
+		return nil, fmt.Errorf("無効なファイルURLです: %s", fileURL)
+	}
+
+	// ファイルを開く
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("ファイルが存在しません: %s", filePath)
+		}
+		return nil, fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+	}
+
+	return file, nil
+}
+
 // extractPathFromURL はURLからファイルパスを抽出します
 func (s *LocalFileStorage) extractPathFromURL(fileURL string) string {
 	// URLからファイル名を抽出
@@ -0,0 +1,76 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// AchievementInteractor は実績ユースケースのインタラクターです
+type AchievementInteractor struct {
+	achievementService *service.AchievementService
+	outputPort         port.AchievementOutputPort
+}
+
+// NewAchievementInteractor は新しい実績インタラクターを作成します
+func NewAchievementInteractor(
+	achievementService *service.AchievementService,
+	outputPort port.AchievementOutputPort,
+) *AchievementInteractor {
+	return &AchievementInteractor{
+		achievementService: achievementService,
+		outputPort:         outputPort,
+	}
+}
+
+// GetAchievements はユーザーの獲得済みの実績と達成途中の実績を取得します
+func (i *AchievementInteractor) GetAchievements(ctx context.Context, userID string) (port.AchievementsOutputData, error) {
+	// ドメインサービスを呼び出し
+	progresses, err := i.achievementService.GetAchievements(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.AchievementsOutputData{}, err
+	}
+
+	// 出力データを作成（獲得済みと達成途中に分ける）
+	outputData := port.AchievementsOutputData{
+		Earned:     []port.AchievementOutputData{},
+		InProgress: []port.AchievementOutputData{},
+		TotalCount: len(progresses),
+	}
+	for _, progress := range progresses {
+		if progress.Unlocked() {
+			outputData.Earned = append(outputData.Earned, toAchievementOutputData(progress))
+		} else {
+			outputData.InProgress = append(outputData.InProgress, toAchievementOutputData(progress))
+		}
+	}
+	outputData.EarnedCount = len(outputData.Earned)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentAchievements(ctx, outputData); err != nil {
+		return port.AchievementsOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// toAchievementOutputData は実績の達成状況を出力データに変換します
+func toAchievementOutputData(progress entity.AchievementProgress) port.AchievementOutputData {
+	current := progress.Current
+	if current > progress.Rule.Target {
+		current = progress.Rule.Target
+	}
+	return port.AchievementOutputData{
+		ID:          progress.Rule.ID,
+		Name:        progress.Rule.Name,
+		Description: progress.Rule.Description,
+		Current:     current,
+		Target:      progress.Rule.Target,
+		Progress:    progress.Percent(),
+		Unlocked:    progress.Unlocked(),
+		UnlockedAt:  progress.UnlockedAt,
+	}
+}
@@ -0,0 +1,163 @@
+package interactor
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// iCalendarの書き出しで使用する定数
+const (
+	icsProductID     = "-//Yuroku//Onsen Calendar//JA"
+	icsCalendarName  = "湯録"
+	icsUIDDomain     = "yuroku"
+	icsRefreshPeriod = "PT6H"
+	icsDateLayout    = "20060102"
+	icsTimeLayout    = "20060102T150405Z"
+	// icsMaxLineOctets は折り返す前の1行の最大のバイト数です（RFC 5545）
+	icsMaxLineOctets = 75
+)
+
+// icsWriter は予定をiCalendar（RFC 5545）形式で書き出すライターです
+// 最初に発生したエラーを保持し、以降の書き出しは行いません
+type icsWriter struct {
+	w   io.Writer
+	err error
+}
+
+// begin はカレンダーの先頭を書き出します
+func (c *icsWriter) begin() {
+	c.line("BEGIN:VCALENDAR")
+	c.line("VERSION:2.0")
+	c.line("PRODID:" + icsProductID)
+	c.line("CALSCALE:GREGORIAN")
+	c.line("METHOD:PUBLISH")
+	c.line("X-WR-CALNAME:" + escapeICSText(icsCalendarName))
+	c.line("X-WR-CALDESC:" + escapeICSText("温泉メモの訪問日と旅行の予定"))
+	c.line("REFRESH-INTERVAL;VALUE=DURATION:" + icsRefreshPeriod)
+	c.line("X-PUBLISHED-TTL:" + icsRefreshPeriod)
+}
+
+// end はカレンダーの末尾を書き出します
+func (c *icsWriter) end() {
+	c.line("END:VCALENDAR")
+}
+
+// writeOnsenLog は温泉メモを訪問日の終日の予定として書き出します
+// 説明には評価・泉質・感想を含めます
+func (c *icsWriter) writeOnsenLog(onsenLog *entity.OnsenLog) {
+	location := onsenLog.Location
+	if location == "" {
+		location = onsenLog.Area.Prefecture + onsenLog.Area.Municipality
+	}
+
+	var description []string
+	if onsenLog.Rating > 0 {
+		description = append(description, "評価: "+ratingStars(onsenLog.Rating)+" ("+formatRating(onsenLog.Rating)+")")
+	}
+	if len(onsenLog.SpringTypes) > 0 {
+		springTypes := make([]string, len(onsenLog.SpringTypes))
+		for index, springType := range onsenLog.SpringTypes {
+			springTypes[index] = string(springType)
+		}
+		description = append(description, "泉質: "+strings.Join(springTypes, "・"))
+	}
+	if comment := strings.TrimSpace(onsenLog.Comment); comment != "" {
+		description = append(description, "", comment)
+	}
+
+	c.line("BEGIN:VEVENT")
+	c.line("UID:onsen-log-" + onsenLog.UUID + "@" + icsUIDDomain)
+	c.line("DTSTAMP:" + onsenLog.UpdatedAt.UTC().Format(icsTimeLayout))
+	c.line("LAST-MODIFIED:" + onsenLog.UpdatedAt.UTC().Format(icsTimeLayout))
+	c.writeAllDay(onsenLog.VisitDate, onsenLog.VisitDate)
+	c.line("SUMMARY:" + escapeICSText("♨ "+onsenLog.Name))
+	if location != "" {
+		c.line("LOCATION:" + escapeICSText(location))
+	}
+	if onsenLog.Coordinates != nil {
+		c.line("GEO:" + strconv.FormatFloat(onsenLog.Coordinates.Latitude(), 'f', -1, 64) + ";" + strconv.FormatFloat(onsenLog.Coordinates.Longitude(), 'f', -1, 64))
+	}
+	if len(description) > 0 {
+		c.line("DESCRIPTION:" + escapeICSText(strings.Join(description, "\n")))
+	}
+	c.line("CATEGORIES:" + escapeICSText("温泉"))
+	c.line("TRANSP:TRANSPARENT")
+	c.line("END:VEVENT")
+}
+
+// writeTrip は旅行を開始日から終了日までの終日の予定として書き出します
+func (c *icsWriter) writeTrip(trip *entity.Trip) {
+	c.line("BEGIN:VEVENT")
+	c.line("UID:trip-" + trip.UUID + "@" + icsUIDDomain)
+	c.line("DTSTAMP:" + trip.UpdatedAt.UTC().Format(icsTimeLayout))
+	c.line("LAST-MODIFIED:" + trip.UpdatedAt.UTC().Format(icsTimeLayout))
+	c.writeAllDay(trip.StartDate, trip.EndDate)
+	c.line("SUMMARY:" + escapeICSText("旅行の予定: "+trip.Title))
+	if trip.Notes != "" {
+		c.line("DESCRIPTION:" + escapeICSText(trip.Notes))
+	}
+	c.line("CATEGORIES:" + escapeICSText("旅行"))
+	c.line("TRANSP:TRANSPARENT")
+	c.line("END:VEVENT")
+}
+
+// writeAllDay は開始日から終了日までの終日の予定の日付を書き出します（DTENDは終了日の翌日です）
+func (c *icsWriter) writeAllDay(start, end time.Time) {
+	if end.Before(start) {
+		end = start
+	}
+	c.line("DTSTART;VALUE=DATE:" + start.Format(icsDateLayout))
+	c.line("DTEND;VALUE=DATE:" + end.AddDate(0, 0, 1).Format(icsDateLayout))
+}
+
+// line は1行をCRLFで書き出します
+// 75バイトを超える行はUTF-8の文字の途中で分けないように折り返し、続きの行の先頭に空白を付けます
+func (c *icsWriter) line(s string) {
+	if c.err != nil {
+		return
+	}
+
+	var sb strings.Builder
+	limit := icsMaxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		sb.WriteString(s[:cut])
+		sb.WriteString("\r\n ")
+		s = s[cut:]
+		limit = icsMaxLineOctets - 1
+	}
+	sb.WriteString(s)
+	sb.WriteString("\r\n")
+
+	_, c.err = io.WriteString(c.w, sb.String())
+}
+
+// escapeICSText はTEXT型の値の特殊文字（バックスラッシュ・セミコロン・カンマ・改行）をエスケープします
+// 改行以外の制御文字は取り除きます
+func escapeICSText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\', r == ';', r == ',':
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\t':
+			sb.WriteRune(' ')
+		case r < 0x20 || r == 0x7f:
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
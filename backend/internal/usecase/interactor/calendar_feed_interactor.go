@@ -0,0 +1,151 @@
+package interactor
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// calendarFeedPath はカレンダーフィードのURLのパスです
+const calendarFeedPath = "/api/calendar_feed/"
+
+// DefaultPublicBaseURL はカレンダーフィードのURLに使う既定の公開URL（スキームとホスト）です
+const DefaultPublicBaseURL = "http://localhost:8080"
+
+// CalendarFeedInteractor はカレンダーフィードユースケースのインタラクターです
+type CalendarFeedInteractor struct {
+	calendarFeedService *service.CalendarFeedService
+	outputPort          port.CalendarFeedOutputPort
+	publicBaseURL       string
+}
+
+// NewCalendarFeedInteractor は新しいカレンダーフィードインタラクターを作成します
+// publicBaseURLはカレンダーアプリからアクセスできるサーバーの公開URLで、空の場合は既定のURLを使用します
+func NewCalendarFeedInteractor(
+	calendarFeedService *service.CalendarFeedService,
+	outputPort port.CalendarFeedOutputPort,
+	publicBaseURL string,
+) *CalendarFeedInteractor {
+	if publicBaseURL == "" {
+		publicBaseURL = DefaultPublicBaseURL
+	}
+	return &CalendarFeedInteractor{
+		calendarFeedService: calendarFeedService,
+		outputPort:          outputPort,
+		publicBaseURL:       strings.TrimRight(publicBaseURL, "/"),
+	}
+}
+
+// IssueCalendarFeed はカレンダーフィードを発行します（発行済みの場合はトークンを再発行します）
+func (i *CalendarFeedInteractor) IssueCalendarFeed(ctx context.Context, userID string) (port.CalendarFeedOutputData, error) {
+	// ドメインサービスを呼び出し
+	feed, err := i.calendarFeedService.IssueCalendarFeed(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.CalendarFeedOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := i.toCalendarFeedOutputData(feed)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentCalendarFeed(ctx, outputData); err != nil {
+		return port.CalendarFeedOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// GetCalendarFeed はカレンダーフィードを取得します
+func (i *CalendarFeedInteractor) GetCalendarFeed(ctx context.Context, userID string) (port.CalendarFeedOutputData, error) {
+	// ドメインサービスを呼び出し
+	feed, err := i.calendarFeedService.GetCalendarFeed(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.CalendarFeedOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := i.toCalendarFeedOutputData(feed)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentCalendarFeed(ctx, outputData); err != nil {
+		return port.CalendarFeedOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// DeleteCalendarFeed はカレンダーフィードを削除します
+func (i *CalendarFeedInteractor) DeleteCalendarFeed(ctx context.Context, userID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.calendarFeedService.DeleteCalendarFeed(ctx, userID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// WriteCalendarFeed はトークンに対応するユーザーの温泉メモと今後の旅行をiCalendar形式でwに書き出します
+// 温泉メモは訪問日の終日の予定、旅行は開始日から終了日までの終日の予定として書き出します
+// トークンや旅行の取得に失敗した場合は何も書き出しません
+func (i *CalendarFeedInteractor) WriteCalendarFeed(ctx context.Context, token string, w io.Writer) error {
+	// ドメインサービスを呼び出し
+	feed, err := i.calendarFeedService.GetCalendarFeedByToken(ctx, token)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	trips, err := i.calendarFeedService.GetUpcomingTrips(ctx, feed.UserID, today)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	// カレンダーを書き出し（温泉メモは1件ずつ読み取りながら書き出す）
+	writer := bufio.NewWriter(w)
+	calendar := &icsWriter{w: writer}
+	calendar.begin()
+	for _, trip := range trips {
+		calendar.writeTrip(trip)
+	}
+	err = i.calendarFeedService.EachCalendarOnsenLog(ctx, feed.UserID, func(onsenLog *entity.OnsenLog) error {
+		calendar.writeOnsenLog(onsenLog)
+		return calendar.err
+	})
+	if err == nil {
+		calendar.end()
+		err = calendar.err
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// toCalendarFeedOutputData はカレンダーフィードエンティティを出力データに変換します
+// フィードのURLはカレンダーアプリで購読できるように公開URLを付けた絶対URLにします
+func (i *CalendarFeedInteractor) toCalendarFeedOutputData(feed *entity.CalendarFeed) port.CalendarFeedOutputData {
+	return port.CalendarFeedOutputData{
+		ID:        feed.UUID,
+		Token:     feed.Token,
+		FeedURL:   i.publicBaseURL + calendarFeedPath + url.PathEscape(feed.Token) + ".ics",
+		CreatedAt: feed.CreatedAt,
+		UpdatedAt: feed.UpdatedAt,
+	}
+}
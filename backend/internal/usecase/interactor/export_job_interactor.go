@@ -0,0 +1,318 @@
+package interactor
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// exportProgressInterval はエクスポートジョブの進捗を保存する最短の間隔です
+const exportProgressInterval = time.Second
+
+// exportDownloadPath はエクスポートファイルのダウンロードURLのパスです
+const exportDownloadPath = "/api/exports/"
+
+// ExportJobInteractor はエクスポートジョブユースケースのインタラクターです
+type ExportJobInteractor struct {
+	exportJobService *service.ExportJobService
+	onsenLogUseCase  port.OnsenLogInputPort
+	tripUseCase      port.TripInputPort
+	outputPort       port.ExportJobOutputPort
+}
+
+// NewExportJobInteractor は新しいエクスポートジョブインタラクターを作成します
+func NewExportJobInteractor(
+	exportJobService *service.ExportJobService,
+	onsenLogUseCase port.OnsenLogInputPort,
+	tripUseCase port.TripInputPort,
+	outputPort port.ExportJobOutputPort,
+) *ExportJobInteractor {
+	return &ExportJobInteractor{
+		exportJobService: exportJobService,
+		onsenLogUseCase:  onsenLogUseCase,
+		tripUseCase:      tripUseCase,
+		outputPort:       outputPort,
+	}
+}
+
+// CreateExportJob はエクスポートジョブを作成し、実行待ちとして登録します
+func (i *ExportJobInteractor) CreateExportJob(ctx context.Context, input port.CreateExportJobInput) (port.ExportJobOutputData, error) {
+	// エンティティを作成
+	job := entity.NewExportJob(input.UserID, input.Format, input.TripID, entity.ExportJobCSVOptions{
+		Encoding:       input.CSV.Encoding,
+		Delimiter:      input.CSV.Delimiter,
+		Columns:        input.CSV.Columns,
+		DateLayout:     input.CSV.DateLayout,
+		HeaderLanguage: input.CSV.HeaderLanguage,
+	}, input.FileName, input.ContentType)
+
+	// ドメインサービスを呼び出し
+	if err := i.exportJobService.CreateExportJob(ctx, job); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ExportJobOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toExportJobOutputData(job, time.Now())
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentExportJob(ctx, outputData); err != nil {
+		return port.ExportJobOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// GetExportJobs はユーザーのエクスポートジョブを作成日時の新しい順に取得します
+func (i *ExportJobInteractor) GetExportJobs(ctx context.Context, userID string) ([]port.ExportJobOutputData, error) {
+	// ドメインサービスを呼び出し
+	jobs, err := i.exportJobService.GetExportJobs(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 出力データを作成
+	now := time.Now()
+	outputData := make([]port.ExportJobOutputData, len(jobs))
+	for index, job := range jobs {
+		outputData[index] = toExportJobOutputData(job, now)
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentExportJobs(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// GetExportJob はエクスポートジョブの状態と進捗を取得します
+func (i *ExportJobInteractor) GetExportJob(ctx context.Context, id, userID string) (port.ExportJobOutputData, error) {
+	// ドメインサービスを呼び出し
+	job, err := i.exportJobService.GetExportJob(ctx, id, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ExportJobOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toExportJobOutputData(job, time.Now())
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentExportJob(ctx, outputData); err != nil {
+		return port.ExportJobOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// OpenExportFile はダウンロードトークンを検証し、エクスポートしたファイルを開きます
+func (i *ExportJobInteractor) OpenExportFile(ctx context.Context, id, token string) (port.ExportFileOutputData, io.ReadCloser, error) {
+	// ドメインサービスを呼び出し
+	job, reader, err := i.exportJobService.OpenExportFile(ctx, id, token)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ExportFileOutputData{}, nil, err
+	}
+
+	return port.ExportFileOutputData{
+		FileName:    job.FileName,
+		ContentType: job.ContentType,
+		Size:        job.Size,
+	}, reader, nil
+}
+
+// RunPendingExportJobs は実行待ちのエクスポートジョブがなくなるまで1件ずつ実行し、実行した件数を返します
+// 失敗したジョブは再試行の待ち時間が過ぎるまで実行待ちのまま残るため、同じ呼び出しの中では再実行しません
+func (i *ExportJobInteractor) RunPendingExportJobs(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		// 実行待ちのジョブを取得
+		job, err := i.exportJobService.ClaimNextExportJob(ctx, time.Now())
+		if err != nil {
+			return count, err
+		}
+		if job == nil {
+			return count, nil
+		}
+
+		// ジョブを実行（中断された場合は一定時間後に実行待ちに戻して再実行する）
+		if err := i.runExportJob(ctx, job); err != nil {
+			if ctx.Err() != nil {
+				return count, ctx.Err()
+			}
+			log.Printf("Export job %s failed (attempt %d/%d): %v", job.UUID, job.Attempts, job.MaxAttempts, err)
+		}
+		count++
+	}
+}
+
+// RequeueInterruptedExportJobs は実行中のまま中断されたエクスポートジョブを実行待ちに戻し、戻した件数を返します
+func (i *ExportJobInteractor) RequeueInterruptedExportJobs(ctx context.Context) (int, error) {
+	return i.exportJobService.RequeueInterruptedExportJobs(ctx, time.Now())
+}
+
+// PurgeExpiredExports はダウンロードの有効期限が切れたエクスポートファイルを削除し、削除した件数を返します
+func (i *ExportJobInteractor) PurgeExpiredExports(ctx context.Context) (int, error) {
+	return i.exportJobService.PurgeExpiredExports(ctx, time.Now())
+}
+
+// runExportJob はエクスポートジョブを実行し、結果をジョブに記録します
+// エクスポートはパイプでつないだゴルーチンで書き出し、ストレージに読み取りながら保存します
+func (i *ExportJobInteractor) runExportJob(ctx context.Context, job *entity.ExportJob) error {
+	reader, writer := io.Pipe()
+
+	// 書き出した件数と進捗（一定間隔でジョブに保存する）
+	count := 0
+	lastSaved := time.Now()
+	progress := func(processed int) {
+		count = processed
+		if time.Since(lastSaved) < exportProgressInterval {
+			return
+		}
+		lastSaved = time.Now()
+		if err := i.exportJobService.UpdateProgress(ctx, job, processed); err != nil {
+			log.Printf("Failed to update export job progress %s: %v", job.UUID, err)
+		}
+	}
+
+	// 実行中のあいだは一定間隔で更新日時を更新し、他のサーバーに中断されたジョブとして戻されないようにする
+	stopHeartbeat := i.startHeartbeat(ctx, job)
+	defer stopHeartbeat()
+
+	// エクスポートを書き出し（失敗した場合は読み取り側にエラーを伝える）
+	done := make(chan error, 1)
+	go func() {
+		err := i.export(ctx, job, writer, progress)
+		writer.CloseWithError(err)
+		done <- err
+	}()
+
+	// ストレージに保存（保存に失敗した場合は書き出し側を止める）
+	fileURL, size, err := i.exportJobService.SaveExportFile(ctx, job, reader)
+	reader.Close()
+	if exportErr := <-done; exportErr != nil {
+		err = exportErr
+	}
+
+	if err != nil {
+		// 中断された場合は実行中のまま残す（更新日時が古くなった時点で実行待ちに戻る）
+		if ctx.Err() != nil {
+			return err
+		}
+		if failErr := i.exportJobService.FailExportJob(ctx, job, err); failErr != nil {
+			log.Printf("Failed to record export job failure %s: %v", job.UUID, failErr)
+		}
+		return err
+	}
+
+	return i.exportJobService.CompleteExportJob(ctx, job, fileURL, size, count)
+}
+
+// startHeartbeat は実行中のエクスポートジョブの更新日時をExportJobHeartbeatIntervalごとに更新するゴルーチンを開始し、停止する関数を返します
+func (i *ExportJobInteractor) startHeartbeat(ctx context.Context, job *entity.ExportJob) func() {
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(entity.ExportJobHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if err := i.exportJobService.TouchExportJob(ctx, job, now); err != nil && ctx.Err() == nil {
+					log.Printf("Failed to update export job heartbeat %s: %v", job.UUID, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(stop)
+		<-stopped
+	}
+}
+
+// export はエクスポートジョブの内容（すべての温泉メモまたは旅行）をwに書き出します
+func (i *ExportJobInteractor) export(ctx context.Context, job *entity.ExportJob, w io.Writer, progress func(processed int)) error {
+	csvOptions := port.CSVExportOptions{
+		Encoding:       job.CSV.Encoding,
+		Delimiter:      job.CSV.Delimiter,
+		Columns:        job.CSV.Columns,
+		DateLayout:     job.CSV.DateLayout,
+		HeaderLanguage: job.CSV.HeaderLanguage,
+	}
+
+	if job.TripID != "" {
+		return i.tripUseCase.ExportTrip(ctx, port.ExportTripInput{
+			ID:       job.TripID,
+			UserID:   job.UserID,
+			Format:   job.Format,
+			CSV:      csvOptions,
+			Progress: progress,
+		}, w)
+	}
+
+	return i.onsenLogUseCase.ExportOnsenLogs(ctx, port.ExportOnsenLogsInput{
+		UserID:   job.UserID,
+		Format:   job.Format,
+		CSV:      csvOptions,
+		Progress: progress,
+	}, w)
+}
+
+// toExportJobOutputData はエクスポートジョブエンティティを出力データに変換します
+// ダウンロードURLはダウンロードできる場合のみ設定します
+func toExportJobOutputData(job *entity.ExportJob, now time.Time) port.ExportJobOutputData {
+	outputData := port.ExportJobOutputData{
+		ID:          job.UUID,
+		Format:      job.Format,
+		TripID:      job.TripID,
+		Status:      string(job.Status),
+		Processed:   job.Processed,
+		Total:       job.Total,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		Error:       job.Error,
+		FileName:    job.FileName,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   job.StartedAt,
+		CompletedAt: job.CompletedAt,
+	}
+
+	// 進捗の割合
+	switch {
+	case job.Status == entity.ExportJobStatusCompleted || job.Status == entity.ExportJobStatusExpired:
+		outputData.Progress = 100
+	case job.Total > 0:
+		outputData.Progress = job.Processed * 100 / job.Total
+		if outputData.Progress > 99 {
+			outputData.Progress = 99
+		}
+	}
+
+	// 再試行の予定日時
+	if job.Status == entity.ExportJobStatusPending && job.Attempts > 0 {
+		nextAttemptAt := job.NextAttemptAt
+		outputData.NextAttemptAt = &nextAttemptAt
+	}
+
+	// ダウンロードURL
+	if job.IsDownloadable(now) {
+		outputData.Size = job.Size
+		outputData.DownloadURL = exportDownloadPath + url.PathEscape(job.UUID) + "/download?token=" + url.QueryEscape(job.DownloadToken)
+		outputData.DownloadExpiresAt = job.DownloadExpiresAt
+	}
+
+	return outputData
+}
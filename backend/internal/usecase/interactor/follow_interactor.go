@@ -0,0 +1,226 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// FollowInteractor はフォローとフィードのユースケースのインタラクターです
+type FollowInteractor struct {
+	followService *service.FollowService
+	outputPort    port.FollowOutputPort
+}
+
+// NewFollowInteractor は新しいフォローインタラクターを作成します
+func NewFollowInteractor(followService *service.FollowService, outputPort port.FollowOutputPort) *FollowInteractor {
+	return &FollowInteractor{
+		followService: followService,
+		outputPort:    outputPort,
+	}
+}
+
+// Follow はユーザーにフォローリクエストを送ります
+func (i *FollowInteractor) Follow(ctx context.Context, input port.FollowInput) (port.FollowUserOutputData, error) {
+	// ドメインサービスを呼び出し
+	profile, err := i.followService.Follow(ctx, input.UserID, input.FolloweeID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.FollowUserOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toFollowUserOutputData(profile)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentFollowUser(ctx, outputData); err != nil {
+		return port.FollowUserOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// Unfollow はユーザーのフォローを解除します（フォローリクエストの取り消しを含みます）
+func (i *FollowInteractor) Unfollow(ctx context.Context, userID, followeeID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.followService.Unfollow(ctx, userID, followeeID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetFollowRequests は承認待ちのフォローリクエストを新しい順に取得します
+func (i *FollowInteractor) GetFollowRequests(ctx context.Context, userID string) ([]port.FollowUserOutputData, error) {
+	// ドメインサービスを呼び出し
+	profiles, err := i.followService.GetFollowRequests(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	return i.presentFollowUsers(ctx, profiles)
+}
+
+// ApproveFollowRequest はフォローリクエストを承認します
+func (i *FollowInteractor) ApproveFollowRequest(ctx context.Context, userID, followerID string) (port.FollowUserOutputData, error) {
+	// ドメインサービスを呼び出し
+	profile, err := i.followService.ApproveFollowRequest(ctx, userID, followerID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.FollowUserOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toFollowUserOutputData(profile)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentFollowUser(ctx, outputData); err != nil {
+		return port.FollowUserOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// RejectFollowRequest はフォローリクエストを拒否します
+func (i *FollowInteractor) RejectFollowRequest(ctx context.Context, userID, followerID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.followService.RejectFollowRequest(ctx, userID, followerID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveFollower はフォロワーを解除します
+func (i *FollowInteractor) RemoveFollower(ctx context.Context, userID, followerID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.followService.RemoveFollower(ctx, userID, followerID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetFollowing はフォローしているユーザーをフォローした日時の新しい順に取得します
+func (i *FollowInteractor) GetFollowing(ctx context.Context, userID string) ([]port.FollowUserOutputData, error) {
+	// ドメインサービスを呼び出し
+	profiles, err := i.followService.GetFollowing(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	return i.presentFollowUsers(ctx, profiles)
+}
+
+// GetFollowers はフォロワーをフォローされた日時の新しい順に取得します
+func (i *FollowInteractor) GetFollowers(ctx context.Context, userID string) ([]port.FollowUserOutputData, error) {
+	// ドメインサービスを呼び出し
+	profiles, err := i.followService.GetFollowers(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	return i.presentFollowUsers(ctx, profiles)
+}
+
+// GetFeed はフォローしているユーザーの温泉メモを作成日時の新しい順に取得します
+func (i *FollowInteractor) GetFeed(ctx context.Context, input port.GetFeedInput) (port.FeedOutputData, error) {
+	// ドメインサービスを呼び出し
+	onsenLogs, authors, nextCursor, err := i.followService.GetFeed(ctx, input.UserID, input.Cursor, input.Limit)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.FeedOutputData{}, err
+	}
+
+	return i.presentFeed(ctx, onsenLogs, authors, nextCursor)
+}
+
+// GetUserOnsenLogs はユーザーの温泉メモのうち閲覧者が閲覧できるものを作成日時の新しい順に取得します
+func (i *FollowInteractor) GetUserOnsenLogs(ctx context.Context, input port.GetUserOnsenLogsInput) (port.FeedOutputData, error) {
+	// ドメインサービスを呼び出し
+	onsenLogs, authors, nextCursor, err := i.followService.GetUserOnsenLogs(ctx, input.UserID, input.ViewerID, input.Cursor, input.Limit)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.FeedOutputData{}, err
+	}
+
+	return i.presentFeed(ctx, onsenLogs, authors, nextCursor)
+}
+
+// presentFollowUsers はフォロー中・フォロワーのユーザーを出力します
+func (i *FollowInteractor) presentFollowUsers(ctx context.Context, profiles []entity.FollowProfile) ([]port.FollowUserOutputData, error) {
+	// 出力データを作成
+	outputData := make([]port.FollowUserOutputData, len(profiles))
+	for i, profile := range profiles {
+		outputData[i] = toFollowUserOutputData(profile)
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentFollowUsers(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// presentFeed は温泉メモを作成者付きのフィードとして出力します
+func (i *FollowInteractor) presentFeed(ctx context.Context, onsenLogs []*entity.OnsenLog, authors map[string]*entity.User, nextCursor string) (port.FeedOutputData, error) {
+	// 出力データを作成
+	outputData := port.FeedOutputData{
+		Items:      make([]port.FeedItemOutputData, len(onsenLogs)),
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != "",
+	}
+	for i, onsenLog := range onsenLogs {
+		outputData.Items[i] = toFeedItemOutputData(onsenLog, authors[onsenLog.UserID])
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentFeed(ctx, outputData); err != nil {
+		return port.FeedOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// toFollowUserOutputData はユーザーの公開プロフィールを出力データに変換します
+func toFollowUserOutputData(profile entity.FollowProfile) port.FollowUserOutputData {
+	return port.FollowUserOutputData{
+		UserID:     profile.UserID,
+		Name:       profile.Name,
+		Status:     profile.Status,
+		FollowedAt: profile.FollowedAt,
+	}
+}
+
+// toFeedItemOutputData は温泉メモエンティティをフィードの出力データに変換します
+// 作成者が退会している場合は作成者名を空にします
+func toFeedItemOutputData(onsenLog *entity.OnsenLog, author *entity.User) port.FeedItemOutputData {
+	outputData := port.FeedItemOutputData{
+		ID:          onsenLog.UUID,
+		AuthorID:    onsenLog.UserID,
+		Visibility:  onsenLog.Visibility.OrDefault(),
+		Name:        onsenLog.Name,
+		Location:    onsenLog.Location,
+		Area:        onsenLog.Area,
+		SpringTypes: onsenLog.SpringTypes,
+		Features:    onsenLog.Features,
+		Tags:        onsenLog.Tags,
+		VisitDate:   onsenLog.VisitDate,
+		Rating:      onsenLog.Rating,
+		Comment:     onsenLog.Comment,
+		CreatedAt:   onsenLog.CreatedAt,
+	}
+	if author != nil {
+		outputData.AuthorName = author.Name
+	}
+	return outputData
+}
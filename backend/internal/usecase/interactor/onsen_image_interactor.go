@@ -102,7 +102,7 @@ func (i *OnsenImageInteractor) GetImagesByOnsenID(ctx context.Context, input por
 	return outputData, nil
 }
 
-// DeleteImage は温泉画像を削除します
+// DeleteImage は温泉画像をゴミ箱に移動します
 func (i *OnsenImageInteractor) DeleteImage(ctx context.Context, input port.DeleteImageInput) error {
 	// 入力値のバリデーション
 	if input.ImageID == "" || input.UserID == "" {
@@ -0,0 +1,200 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// OnsenInteractor は温泉施設ユースケースのインタラクターです
+type OnsenInteractor struct {
+	onsenService *service.OnsenService
+	outputPort   port.OnsenOutputPort
+}
+
+// NewOnsenInteractor は新しい温泉施設インタラクターを作成します
+func NewOnsenInteractor(
+	onsenService *service.OnsenService,
+	outputPort port.OnsenOutputPort,
+) *OnsenInteractor {
+	return &OnsenInteractor{
+		onsenService: onsenService,
+		outputPort:   outputPort,
+	}
+}
+
+// CreateOnsen は新しい温泉施設を作成します
+func (i *OnsenInteractor) CreateOnsen(ctx context.Context, input port.CreateOnsenInput) (port.OnsenOutputData, error) {
+	// 入力値のバリデーション
+	if input.Name == "" {
+		err := errors.New("温泉名は必須です")
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenOutputData{}, err
+	}
+
+	// ドメインサービスを呼び出し
+	onsen, err := i.onsenService.CreateOnsen(ctx, input.UserID, input.Name, input.Location)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenOutputData{}, err
+	}
+
+	// 出力データを作成（新規作成時は訪問記録なし）
+	outputData := toOnsenOutputData(onsen, nil)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentOnsen(ctx, outputData); err != nil {
+		return port.OnsenOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// GetOnsen は温泉施設を訪問集計付きで取得します
+func (i *OnsenInteractor) GetOnsen(ctx context.Context, id, userID string) (port.OnsenOutputData, error) {
+	// ドメインサービスを呼び出し
+	onsen, err := i.onsenService.GetOnsen(ctx, id, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenOutputData{}, err
+	}
+
+	// 訪問集計を取得
+	summaries, err := i.onsenService.GetVisitSummaries(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toOnsenOutputData(onsen, findVisitSummary(summaries, onsen.UUID))
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentOnsen(ctx, outputData); err != nil {
+		return port.OnsenOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// GetOnsens はユーザーIDに紐づく温泉施設を訪問集計付きで取得します
+func (i *OnsenInteractor) GetOnsens(ctx context.Context, userID string) ([]port.OnsenOutputData, error) {
+	// ドメインサービスを呼び出し
+	onsens, err := i.onsenService.GetOnsensByUserID(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 訪問集計を取得
+	summaries, err := i.onsenService.GetVisitSummaries(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 出力データを作成
+	outputData := make([]port.OnsenOutputData, len(onsens))
+	for i, onsen := range onsens {
+		outputData[i] = toOnsenOutputData(onsen, findVisitSummary(summaries, onsen.UUID))
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentOnsens(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// GetOnsenVisits は温泉施設に紐づく温泉メモを取得します
+func (i *OnsenInteractor) GetOnsenVisits(ctx context.Context, id, userID string) ([]port.OnsenLogOutputData, error) {
+	// ドメインサービスを呼び出し
+	onsenLogs, err := i.onsenService.GetOnsenLogs(ctx, id, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	return toOnsenLogsOutputData(onsenLogs), nil
+}
+
+// UpdateOnsen は温泉施設を更新します
+func (i *OnsenInteractor) UpdateOnsen(ctx context.Context, input port.UpdateOnsenInput) (port.OnsenOutputData, error) {
+	// 入力値のバリデーション
+	if input.Name == "" {
+		err := errors.New("温泉名は必須です")
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenOutputData{}, err
+	}
+
+	// ドメインサービスを呼び出し
+	onsen, err := i.onsenService.UpdateOnsen(ctx, input.ID, input.UserID, input.Name, input.Location)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenOutputData{}, err
+	}
+
+	// 訪問集計を取得
+	summaries, err := i.onsenService.GetVisitSummaries(ctx, input.UserID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toOnsenOutputData(onsen, findVisitSummary(summaries, onsen.UUID))
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentOnsen(ctx, outputData); err != nil {
+		return port.OnsenOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// DeleteOnsen は温泉施設を削除します
+func (i *OnsenInteractor) DeleteOnsen(ctx context.Context, id, userID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.onsenService.DeleteOnsen(ctx, id, userID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// findVisitSummary は温泉施設IDに対応する訪問集計を探します
+func findVisitSummary(summaries []*entity.OnsenVisitSummary, onsenID string) *entity.OnsenVisitSummary {
+	for _, summary := range summaries {
+		if summary.OnsenID == onsenID {
+			return summary
+		}
+	}
+	return nil
+}
+
+// toOnsenOutputData は温泉施設エンティティと訪問集計を出力データに変換します
+func toOnsenOutputData(onsen *entity.Onsen, summary *entity.OnsenVisitSummary) port.OnsenOutputData {
+	outputData := port.OnsenOutputData{
+		ID:        onsen.UUID,
+		Name:      onsen.Name,
+		Location:  onsen.Location,
+		CreatedAt: onsen.CreatedAt,
+		UpdatedAt: onsen.UpdatedAt,
+	}
+
+	if summary != nil {
+		firstVisit := summary.FirstVisit
+		lastVisit := summary.LastVisit
+		outputData.VisitCount = summary.VisitCount
+		outputData.FirstVisit = &firstVisit
+		outputData.LastVisit = &lastVisit
+		outputData.AverageRating = summary.AverageRating
+	}
+
+	return outputData
+}
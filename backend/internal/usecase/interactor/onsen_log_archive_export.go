@@ -0,0 +1,277 @@
+package interactor
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// ZIPアーカイブのエクスポートで使用する定数
+const (
+	archiveManifestVersion = 1
+	archiveManifestFile    = "manifest.json"
+	archiveLogsJSONFile    = "logs.json"
+	archiveLogsCSVFile     = "logs.csv"
+	archiveLogsDir         = "onsen_logs"
+	archiveLogFile         = "onsen_log.json"
+	archiveImagesDir       = "images"
+	archiveMaxFolderName   = 40
+)
+
+// archiveManifest はZIPアーカイブの内容を説明するマニフェストです
+type archiveManifest struct {
+	Version           int                    `json:"version"`
+	App               string                 `json:"app"`
+	ExportedAt        time.Time              `json:"exported_at"`
+	LogsJSON          string                 `json:"logs_json"`
+	LogsCSV           string                 `json:"logs_csv"`
+	OnsenLogCount     int                    `json:"onsen_log_count"`
+	ImageCount        int                    `json:"image_count"`
+	MissingImageCount int                    `json:"missing_image_count"`
+	OnsenLogs         []archiveManifestEntry `json:"onsen_logs"`
+}
+
+// archiveManifestEntry はマニフェストの温泉メモごとの項目です
+type archiveManifestEntry struct {
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`
+	VisitDate     string                 `json:"visit_date"`
+	Folder        string                 `json:"folder"`
+	Images        []archiveManifestImage `json:"images"`
+	MissingImages []archiveManifestImage `json:"missing_images,omitempty"`
+}
+
+// archiveManifestImage はマニフェストの画像ごとの項目です
+// Fileはアーカイブ内のパスで、ストレージから読み取れなかった画像では空です
+type archiveManifestImage struct {
+	ID          string `json:"id"`
+	File        string `json:"file,omitempty"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+	Error       string `json:"error,omitempty"`
+}
+
+// exportAsArchive は温泉メモと画像のファイルをZIPアーカイブとして書き出し、温泉メモの件数を返します
+// 全件のJSON（logs.json）とCSV（logs.csv）、温泉メモごとのフォルダ（温泉メモのJSONと画像のファイル）、マニフェストを含みます
+// 温泉メモは各ファイルごとにeachで読み取り直し、画像はストレージから1件ずつ読み取って書き出します
+// 進捗（progress）は最も時間のかかる温泉メモごとのフォルダの書き出しで通知します
+func (i *OnsenLogInteractor) exportAsArchive(ctx context.Context, w io.Writer, options port.CSVExportOptions, each func(fn func(onsenLog *entity.OnsenLog) error) error, progress func(processed int)) (int, error) {
+	archive := zip.NewWriter(w)
+	exportedAt := time.Now()
+
+	// 全件のJSONとCSV
+	if _, err := runOnsenLogExporter(&jsonOnsenLogExporter{w: &zipEntryWriter{archive: archive, name: archiveLogsJSONFile, modified: exportedAt}}, each); err != nil {
+		return 0, err
+	}
+	if _, err := runOnsenLogExporter(newCSVOnsenLogExporter(&zipEntryWriter{archive: archive, name: archiveLogsCSVFile, modified: exportedAt}, options), each); err != nil {
+		return 0, err
+	}
+
+	// 温泉メモごとのフォルダ
+	folders := &archiveFolderExporter{
+		ctx:        ctx,
+		archive:    archive,
+		interactor: i,
+		manifest: archiveManifest{
+			Version:    archiveManifestVersion,
+			App:        mapExportApp,
+			ExportedAt: exportedAt,
+			LogsJSON:   archiveLogsJSONFile,
+			LogsCSV:    archiveLogsCSVFile,
+			OnsenLogs:  []archiveManifestEntry{},
+		},
+	}
+	count, err := runOnsenLogExporter(folders, withExportProgress(each, progress))
+	if err != nil {
+		return 0, err
+	}
+
+	// マニフェスト
+	folders.manifest.OnsenLogCount = count
+	if err := writeArchiveJSON(archive, archiveManifestFile, exportedAt, folders.manifest); err != nil {
+		return 0, err
+	}
+
+	return count, archive.Close()
+}
+
+// zipEntryWriter は最初に書き込んだ時点でZIPアーカイブにファイルを追加するライターです
+type zipEntryWriter struct {
+	archive  *zip.Writer
+	name     string
+	modified time.Time
+	w        io.Writer
+}
+
+// Write はファイルを追加していなければ追加してから、データを書き込みます
+func (z *zipEntryWriter) Write(p []byte) (int, error) {
+	if z.w == nil {
+		w, err := createArchiveFile(z.archive, z.name, zip.Deflate, z.modified)
+		if err != nil {
+			return 0, err
+		}
+		z.w = w
+	}
+	return z.w.Write(p)
+}
+
+// archiveFolderExporter は温泉メモごとのフォルダに温泉メモのJSONと画像のファイルを書き出すエクスポーターです
+// 書き出した内容はマニフェストに記録します
+type archiveFolderExporter struct {
+	ctx        context.Context
+	archive    *zip.Writer
+	interactor *OnsenLogInteractor
+	manifest   archiveManifest
+}
+
+// Begin は何もしません
+func (e *archiveFolderExporter) Begin() error {
+	return nil
+}
+
+// Write は温泉メモのフォルダを書き出します
+// ストレージから読み取れなかった画像はマニフェストに記録して書き出しを続けます
+func (e *archiveFolderExporter) Write(onsenLog *entity.OnsenLog) error {
+	folder := archiveLogsDir + "/" + archiveFolderName(onsenLog)
+	entry := archiveManifestEntry{
+		ID:        onsenLog.UUID,
+		Name:      onsenLog.Name,
+		VisitDate: onsenLog.VisitDate.Format("2006-01-02"),
+		Folder:    folder,
+		Images:    []archiveManifestImage{},
+	}
+
+	// 画像を取得
+	images, err := e.interactor.onsenImageService.GetImagesByOnsenLog(e.ctx, onsenLog)
+	if err != nil {
+		return err
+	}
+
+	// 温泉メモのJSON（画像の情報を含む）
+	outputData := toOnsenLogOutputData(onsenLog)
+	outputData.Images = toImageOutputData(images)
+	if err := writeArchiveJSON(e.archive, folder+"/"+archiveLogFile, e.manifest.ExportedAt, outputData); err != nil {
+		return err
+	}
+
+	// 画像のファイル
+	for index, image := range images {
+		manifestImage := archiveManifestImage{
+			ID:          image.UUID,
+			URL:         image.ImageURL,
+			Description: image.Description,
+		}
+
+		file := fmt.Sprintf("%s/%s/%02d_%s%s", folder, archiveImagesDir, index+1, image.UUID, archiveImageExt(image.ImageURL))
+		if err := e.writeImage(image, file); err != nil {
+			if _, ok := err.(archiveImageError); !ok {
+				return err
+			}
+			manifestImage.Error = err.Error()
+			entry.MissingImages = append(entry.MissingImages, manifestImage)
+			e.manifest.MissingImageCount++
+			continue
+		}
+
+		manifestImage.File = file
+		entry.Images = append(entry.Images, manifestImage)
+		e.manifest.ImageCount++
+	}
+
+	e.manifest.OnsenLogs = append(e.manifest.OnsenLogs, entry)
+	return nil
+}
+
+// End は何もしません（マニフェストは呼び出し元で書き出します）
+func (e *archiveFolderExporter) End() error {
+	return nil
+}
+
+// archiveImageError はストレージから画像を読み取れなかったことを表すエラーです
+type archiveImageError struct {
+	err error
+}
+
+// Error はエラーメッセージを返します
+func (e archiveImageError) Error() string {
+	return e.err.Error()
+}
+
+// writeImage はストレージから画像を読み取り、アーカイブに書き出します
+// 画像を開けなかった場合はアーカイブに何も書き出さずにarchiveImageErrorを返します
+func (e *archiveFolderExporter) writeImage(image *entity.OnsenImage, file string) error {
+	reader, err := e.interactor.onsenImageService.OpenImageFile(e.ctx, image)
+	if err != nil {
+		return archiveImageError{err: err}
+	}
+	defer reader.Close()
+
+	// 画像は圧縮済みの形式が多いため無圧縮で格納する
+	writer, err := createArchiveFile(e.archive, file, zip.Store, image.CreatedAt)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(writer, reader)
+	return err
+}
+
+// createArchiveFile は圧縮方式と更新日時を指定してアーカイブにファイルを追加します
+func createArchiveFile(archive *zip.Writer, name string, method uint16, modified time.Time) (io.Writer, error) {
+	return archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   method,
+		Modified: modified,
+	})
+}
+
+// writeArchiveJSON は値をインデント付きのJSONにしてアーカイブに書き出します
+func writeArchiveJSON(archive *zip.Writer, name string, modified time.Time, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	writer, err := createArchiveFile(archive, name, zip.Deflate, modified)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	return err
+}
+
+// archiveFolderName は温泉メモのフォルダ名を作成します（訪問日_温泉名_温泉メモID）
+// 温泉名のうちファイル名に使用できない文字は「_」に置き換え、長い場合は切り詰めます
+func archiveFolderName(onsenLog *entity.OnsenLog) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, onsenLog.Name)
+	name = strings.Trim(strings.TrimSpace(name), ".")
+	if runes := []rune(name); len(runes) > archiveMaxFolderName {
+		name = string(runes[:archiveMaxFolderName])
+	}
+	if name == "" {
+		name = "onsen"
+	}
+
+	return onsenLog.VisitDate.Format("2006-01-02") + "_" + name + "_" + onsenLog.UUID
+}
+
+// archiveImageExt は画像のURLから拡張子を取得します
+func archiveImageExt(imageURL string) string {
+	if index := strings.IndexAny(imageURL, "?#"); index >= 0 {
+		imageURL = imageURL[:index]
+	}
+	return strings.ToLower(path.Ext(imageURL))
+}
@@ -0,0 +1,217 @@
+package interactor
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+	"golang.org/x/text/encoding"
+)
+
+// onsenLogExporter は温泉メモを1件ずつファイルに書き出すエクスポーターです
+// Begin・Write（温泉メモの件数分）・Endの順に呼び出します
+type onsenLogExporter interface {
+	// Begin はファイルの先頭（ヘッダーなど）を書き出します
+	Begin() error
+
+	// Write は温泉メモを1件書き出します
+	Write(onsenLog *entity.OnsenLog) error
+
+	// End はファイルの末尾を書き出します
+	End() error
+}
+
+// newOnsenLogExporter はフォーマットに応じたエクスポーターを作成します
+func newOnsenLogExporter(w io.Writer, format string, options port.CSVExportOptions) (onsenLogExporter, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		return &jsonOnsenLogExporter{w: w}, nil
+	case "csv":
+		return newCSVOnsenLogExporter(w, options), nil
+	case "xlsx":
+		return newXLSXOnsenLogExporter(w, options), nil
+	case "geojson":
+		return &geoJSONOnsenLogExporter{w: w}, nil
+	case "kml":
+		return newKMLOnsenLogExporter(w), nil
+	case "gpx":
+		return newGPXOnsenLogExporter(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// runOnsenLogExporter はeachで読み取った温泉メモをエクスポーターで書き出し、書き出した件数を返します
+// ファイルの先頭は最初の温泉メモを読み取った時点で書き出すため、読み取りの開始時のエラーでは何も書き出しません
+func runOnsenLogExporter(exporter onsenLogExporter, each func(fn func(onsenLog *entity.OnsenLog) error) error) (int, error) {
+	count := 0
+	err := each(func(onsenLog *entity.OnsenLog) error {
+		if count == 0 {
+			if err := exporter.Begin(); err != nil {
+				return err
+			}
+		}
+		count++
+		return exporter.Write(onsenLog)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// 温泉メモがない場合もファイルの先頭と末尾は書き出す
+	if count == 0 {
+		if err := exporter.Begin(); err != nil {
+			return 0, err
+		}
+	}
+	if err := exporter.End(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// withExportProgress は温泉メモを1件書き出すごとにprogressを呼び出すようeachをラップします
+// progressがnilの場合はeachをそのまま返します
+func withExportProgress(each func(fn func(onsenLog *entity.OnsenLog) error) error, progress func(processed int)) func(fn func(onsenLog *entity.OnsenLog) error) error {
+	if progress == nil {
+		return each
+	}
+	return func(fn func(onsenLog *entity.OnsenLog) error) error {
+		processed := 0
+		return each(func(onsenLog *entity.OnsenLog) error {
+			if err := fn(onsenLog); err != nil {
+				return err
+			}
+			processed++
+			progress(processed)
+			return nil
+		})
+	}
+}
+
+// jsonOnsenLogExporter は温泉メモをJSON配列として書き出すエクスポーターです
+type jsonOnsenLogExporter struct {
+	w     io.Writer
+	count int
+}
+
+// Begin はJSON配列の先頭を書き出します（温泉メモがない場合は空の配列になります）
+func (e *jsonOnsenLogExporter) Begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+// Write は温泉メモを配列の要素として書き出します
+func (e *jsonOnsenLogExporter) Write(onsenLog *entity.OnsenLog) error {
+	data, err := json.MarshalIndent(toOnsenLogOutputData(onsenLog), "  ", "  ")
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n  "
+	if e.count == 0 {
+		separator = "\n  "
+	}
+	e.count++
+
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+// End はJSON配列の末尾を書き出します
+func (e *jsonOnsenLogExporter) End() error {
+	end := "\n]"
+	if e.count == 0 {
+		end = "]"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+// csvOnsenLogExporter は温泉メモをCSVの行として書き出すエクスポーターです
+// 列・区切り文字・日付の書式・ヘッダーの言語・文字コードは出力オプションに従います
+type csvOnsenLogExporter struct {
+	w          io.Writer
+	options    port.CSVExportOptions
+	columns    []entity.CSVColumn
+	dateLayout string
+	encoder    *encoding.Encoder
+	buf        bytes.Buffer
+	writer     *csv.Writer
+}
+
+// newCSVOnsenLogExporter は出力オプションの既定値を設定したCSVのエクスポーターを作成します
+func newCSVOnsenLogExporter(w io.Writer, options port.CSVExportOptions) *csvOnsenLogExporter {
+	e := &csvOnsenLogExporter{
+		w:          w,
+		options:    options,
+		columns:    options.Columns,
+		dateLayout: options.DateLayout,
+		encoder:    newCSVEncoder(options.Encoding),
+	}
+	if len(e.columns) == 0 {
+		e.columns = entity.DefaultCSVColumns()
+	}
+	if e.dateLayout == "" {
+		e.dateLayout = "2006-01-02"
+	}
+
+	// 1行ずつ文字コードを変換して書き出すため、CSVはバッファに書き込む
+	e.writer = csv.NewWriter(&e.buf)
+	if options.Delimiter != 0 {
+		e.writer.Comma = options.Delimiter
+	}
+	return e
+}
+
+// Begin はBOM（指定した場合）とヘッダーを書き出します
+func (e *csvOnsenLogExporter) Begin() error {
+	if e.options.Encoding == entity.CSVEncodingUTF8BOM {
+		if _, err := io.WriteString(e.w, utf8BOM); err != nil {
+			return err
+		}
+	}
+
+	header := make([]string, len(e.columns))
+	for i, column := range e.columns {
+		header[i] = column.Label(e.options.HeaderLanguage)
+	}
+	return e.writeRow(header)
+}
+
+// Write は温泉メモを1行書き出します
+func (e *csvOnsenLogExporter) Write(onsenLog *entity.OnsenLog) error {
+	row := make([]string, len(e.columns))
+	for i, column := range e.columns {
+		row[i] = csvColumnValue(onsenLog, column, e.dateLayout)
+	}
+	return e.writeRow(row)
+}
+
+// End は何もしません（各行は書き込み時に書き出し済みです）
+func (e *csvOnsenLogExporter) End() error {
+	return nil
+}
+
+// writeRow は1行をCSVにして文字コードを変換し、書き出します
+func (e *csvOnsenLogExporter) writeRow(row []string) error {
+	if err := e.writer.Write(row); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	if err := e.writer.Error(); err != nil {
+		return err
+	}
+
+	_, err := e.w.Write(encodeCSV(e.buf.Bytes(), e.encoder))
+	e.buf.Reset()
+	return err
+}
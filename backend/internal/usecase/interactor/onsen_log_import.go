@@ -0,0 +1,283 @@
+package interactor
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// インポートで使用する定数
+const (
+	// importOtherCostsLabel はCSVのその他の費用を取り込む際の費用の名前です
+	importOtherCostsLabel = "その他の費用"
+	// utf8BOM はUTF-8のBOMです
+	utf8BOM = "\xef\xbb\xbf"
+)
+
+// onsenLogImportRecord はJSONエクスポートの温泉メモ1件分の読み取り用の形式です
+// ID・作成日時など保存時に決まる項目は読み取りません
+type onsenLogImportRecord struct {
+	Name             string               `json:"name"`
+	Location         string               `json:"location"`
+	Latitude         *float64             `json:"latitude"`
+	Longitude        *float64             `json:"longitude"`
+	SpringTypes      []entity.SpringType  `json:"spring_types"`
+	WaterAnalysis    entity.WaterAnalysis `json:"water_analysis"`
+	Features         []entity.Feature     `json:"features"`
+	Tags             []string             `json:"tags"`
+	VisitDate        string               `json:"visit_date"`
+	Visit            entity.VisitDetails  `json:"visit"`
+	Rating           *float64             `json:"rating"`
+	RatingScores     entity.RatingScores  `json:"rating_scores"`
+	RatingOverridden *bool                `json:"rating_overridden"`
+	Comment          string               `json:"comment"`
+	Visibility       entity.Visibility    `json:"visibility"`
+}
+
+// parseImportJSON はJSONエクスポートの形式（温泉メモの配列）のデータを読み取ります
+func parseImportJSON(data []byte) ([]entity.ImportRow, error) {
+	var records []json.RawMessage
+	if err := json.Unmarshal(trimBOM(data), &records); err != nil {
+		return nil, errors.New("JSONは温泉メモの配列で指定してください")
+	}
+
+	rows := make([]entity.ImportRow, len(records))
+	for i, raw := range records {
+		rows[i].Row = i + 1
+
+		var record onsenLogImportRecord
+		if err := json.Unmarshal(raw, &record); err != nil {
+			rows[i].Errors = []string{"温泉メモの形式が無効です"}
+			continue
+		}
+
+		visitDate, err := parseImportDate(record.VisitDate, "")
+		if err != nil {
+			rows[i].Errors = append(rows[i].Errors, err.Error())
+		}
+
+		rows[i].Params = entity.OnsenLogParams{
+			Name:          strings.TrimSpace(record.Name),
+			Location:      strings.TrimSpace(record.Location),
+			Latitude:      record.Latitude,
+			Longitude:     record.Longitude,
+			SpringTypes:   record.SpringTypes,
+			WaterAnalysis: record.WaterAnalysis,
+			Features:      record.Features,
+			Tags:          record.Tags,
+			VisitDate:     visitDate,
+			Visit:         record.Visit,
+			Rating:        importRating(record.Rating, record.RatingOverridden, record.RatingScores),
+			RatingScores:  record.RatingScores,
+			Comment:       record.Comment,
+			Visibility:    record.Visibility,
+		}
+	}
+
+	return rows, nil
+}
+
+// parseImportCSV はCSVエクスポートの形式のデータを読み取ります
+// 列はヘッダーの列名（日本語・英語・列のキー）で判定するため、列の順序や未知の列は問いません
+// 区切り文字はヘッダーから判定し、UTF-8として読めない場合はShift_JISとして読み取ります
+// dateLayoutはエクスポート時に指定した訪問日の日付レイアウトです（空の場合は標準の形式のみ読み取ります）
+func parseImportCSV(data []byte, dateLayout string) ([]entity.ImportRow, error) {
+	data = trimBOM(data)
+	if !utf8.Valid(data) {
+		decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(data)
+		if err != nil {
+			return nil, errors.New("CSVの文字コードはUTF-8またはShift_JISで指定してください")
+		}
+		data = decoded
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectCSVDelimiter(data)
+	reader.FieldsPerRecord = -1
+
+	// ヘッダーを読み取り
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("CSVのヘッダーを読み取れません")
+	}
+	columns := make(map[entity.CSVColumn]int)
+	for i, label := range header {
+		if column, ok := entity.CSVColumnFromHeader(label); ok {
+			columns[column] = i
+		}
+	}
+	if _, ok := columns[entity.CSVColumnName]; !ok {
+		return nil, errors.New("CSVのヘッダーに温泉名の列がありません")
+	}
+
+	// データを読み取り
+	var rows []entity.ImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSVを読み取れません: %v", err)
+		}
+		rows = append(rows, parseImportCSVRecord(len(rows)+1, record, columns, dateLayout))
+	}
+
+	return rows, nil
+}
+
+// parseImportCSVRecord はCSVの1行を温泉メモの入力値に変換します
+func parseImportCSVRecord(row int, record []string, columns map[entity.CSVColumn]int, dateLayout string) entity.ImportRow {
+	result := entity.ImportRow{Row: row}
+	value := func(column entity.CSVColumn) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	// 数値の列を読み取り（空の場合は未記録）
+	parseNumber := func(column entity.CSVColumn, label string) *float64 {
+		s := value(column)
+		if s == "" {
+			return nil
+		}
+		number, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			result.Errors = append(result.Errors, label+"は数値で指定してください")
+			return nil
+		}
+		return &number
+	}
+
+	params := entity.OnsenLogParams{
+		Name:     value(entity.CSVColumnName),
+		Location: value(entity.CSVColumnLocation),
+		Tags:     splitImportList(value(entity.CSVColumnTags)),
+		Visit: entity.VisitDetails{
+			ArrivalTime: value(entity.CSVColumnArrivalTime),
+			StayType:    entity.StayType(value(entity.CSVColumnStayType)),
+			Currency:    value(entity.CSVColumnCurrency),
+		},
+		Comment:    value(entity.CSVColumnComment),
+		Visibility: entity.Visibility(value(entity.CSVColumnVisibility)),
+	}
+	for _, value := range splitImportList(value(entity.CSVColumnSpringTypes)) {
+		// 以前の泉質の名前は療養泉の泉質に読み替える
+		springType, ok := entity.SpringTypeFromLegacy(value)
+		if !ok {
+			springType = entity.SpringType(value)
+		}
+		params.SpringTypes = append(params.SpringTypes, springType)
+	}
+	for _, feature := range splitImportList(value(entity.CSVColumnFeatures)) {
+		params.Features = append(params.Features, entity.Feature(feature))
+	}
+	for _, companion := range splitImportList(value(entity.CSVColumnCompanions)) {
+		params.Visit.Companions = append(params.Visit.Companions, entity.Companion(companion))
+	}
+
+	visitDate, err := parseImportDate(value(entity.CSVColumnVisitDate), dateLayout)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+	params.VisitDate = visitDate
+
+	if duration := parseNumber(entity.CSVColumnDurationMinutes, "滞在時間"); duration != nil {
+		params.Visit.DurationMinutes = int(*duration)
+	}
+	params.Latitude = parseNumber(entity.CSVColumnLatitude, "緯度")
+	params.Longitude = parseNumber(entity.CSVColumnLongitude, "経度")
+	params.Visit.EntranceFee = parseNumber(entity.CSVColumnEntranceFee, "入浴料")
+	if otherCosts := parseNumber(entity.CSVColumnOtherCosts, "その他の費用"); otherCosts != nil {
+		params.Visit.OtherCosts = []entity.VisitCost{{Label: importOtherCostsLabel, Amount: *otherCosts}}
+	}
+	for _, criterion := range entity.RatingCriteria {
+		if score := parseNumber(entity.CSVColumn(criterion), criterion.Label()); score != nil {
+			params.RatingScores.SetScore(criterion, int(*score))
+		}
+	}
+	params.Rating = importRating(parseNumber(entity.CSVColumnRating, "評価"), nil, params.RatingScores)
+
+	result.Params = params
+	return result
+}
+
+// importRating は取り込む総合評価を手動指定として扱うかどうかを判定します
+// 手動指定かどうかが不明な場合（CSVなど）は、項目別評価がないか項目別評価の平均と異なる場合に手動指定とみなします
+func importRating(rating *float64, overridden *bool, scores entity.RatingScores) *float64 {
+	if rating == nil {
+		return nil
+	}
+	if overridden != nil {
+		if *overridden || scores.IsEmpty() {
+			return rating
+		}
+		return nil
+	}
+	if scores.IsEmpty() || *rating != scores.Overall() {
+		return rating
+	}
+	return nil
+}
+
+// parseImportDate は訪問日を読み取ります（YYYY-MM-DD・YYYY/MM/DD・RFC3339形式）
+// dateLayoutを指定した場合はその日付レイアウトも読み取ります
+func parseImportDate(s, dateLayout string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, errors.New("訪問日は必須です")
+	}
+	layouts := []string{"2006-01-02", "2006/01/02", time.RFC3339}
+	if dateLayout != "" {
+		layouts = append([]string{dateLayout}, layouts...)
+	}
+	for _, layout := range layouts {
+		if date, err := time.Parse(layout, s); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, errors.New("訪問日の形式が無効です（YYYY-MM-DD）")
+}
+
+// splitImportList はカンマ（全角の読点を含む）区切りの値を分割し、空の値を除きます
+func splitImportList(s string) []string {
+	values := []string{}
+	for _, value := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '、' }) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// detectCSVDelimiter はCSVの1行目に含まれる区切り文字（カンマ・タブ・セミコロン・パイプ）のうち最も多いものを返します
+func detectCSVDelimiter(data []byte) rune {
+	firstLine := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		firstLine = data[:i]
+	}
+
+	delimiter, count := ',', bytes.Count(firstLine, []byte{','})
+	for _, candidate := range []rune{'\t', ';', '|'} {
+		if n := bytes.Count(firstLine, []byte(string(candidate))); n > count {
+			delimiter, count = candidate, n
+		}
+	}
+	return delimiter
+}
+
+// trimBOM は先頭のUTF-8のBOMを取り除きます
+func trimBOM(data []byte) []byte {
+	return bytes.TrimPrefix(data, []byte(utf8BOM))
+}
@@ -1,35 +1,43 @@
 package interactor
 
 import (
+	"bytes"
 	"context"
-	"encoding/csv"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log"
+	"strconv"
 	"strings"
 
 	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
 	"github.com/yourusername/yuroku/internal/domain/service"
 	"github.com/yourusername/yuroku/internal/usecase/port"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
 )
 
 // OnsenLogInteractor は温泉メモユースケースのインタラクターです
 type OnsenLogInteractor struct {
-	onsenLogService   *service.OnsenLogService
-	onsenImageService *service.OnsenImageService
-	outputPort        port.OnsenLogOutputPort
+	onsenLogService    *service.OnsenLogService
+	onsenImageService  *service.OnsenImageService
+	achievementService *service.AchievementService
+	outputPort         port.OnsenLogOutputPort
 }
 
 // NewOnsenLogInteractor は新しい温泉メモインタラクターを作成します
 func NewOnsenLogInteractor(
 	onsenLogService *service.OnsenLogService,
 	onsenImageService *service.OnsenImageService,
+	achievementService *service.AchievementService,
 	outputPort port.OnsenLogOutputPort,
 ) *OnsenLogInteractor {
 	return &OnsenLogInteractor{
-		onsenLogService:   onsenLogService,
-		onsenImageService: onsenImageService,
-		outputPort:        outputPort,
+		onsenLogService:    onsenLogService,
+		onsenImageService:  onsenImageService,
+		achievementService: achievementService,
+		outputPort:         outputPort,
 	}
 }
 
@@ -43,36 +51,32 @@ func (i *OnsenLogInteractor) CreateOnsenLog(ctx context.Context, input port.Crea
 	}
 
 	// ドメインサービスを呼び出し
-	onsenLog, err := i.onsenLogService.CreateOnsenLog(
-		ctx,
-		input.UserID,
-		input.Name,
-		input.Location,
-		input.SpringType,
-		input.Features,
-		input.VisitDate,
-		input.Rating,
-		input.Comment,
-	)
+	onsenLog, err := i.onsenLogService.CreateOnsenLog(ctx, input.UserID, entity.OnsenLogParams{
+		OnsenID:        input.OnsenID,
+		Name:           input.Name,
+		Location:       input.Location,
+		Latitude:       input.Latitude,
+		Longitude:      input.Longitude,
+		SpringTypes:    input.SpringTypes,
+		WaterAnalysis:  input.WaterAnalysis,
+		Features:       input.Features,
+		Tags:           input.Tags,
+		VisitDate:      input.VisitDate,
+		Visit:          input.Visit,
+		Rating:         input.Rating,
+		RatingScores:   input.RatingScores,
+		Comment:        input.Comment,
+		Visibility:     input.Visibility,
+		WishlistItemID: input.WishlistItemID,
+	})
 	if err != nil {
 		_ = i.outputPort.PresentError(ctx, err)
 		return port.OnsenLogOutputData{}, err
 	}
 
 	// 出力データを作成
-	outputData := port.OnsenLogOutputData{
-		ID:         onsenLog.UUID,
-		UserID:     onsenLog.UserID,
-		Name:       onsenLog.Name,
-		Location:   onsenLog.Location,
-		SpringType: onsenLog.SpringType,
-		Features:   onsenLog.Features,
-		VisitDate:  onsenLog.VisitDate,
-		Rating:     onsenLog.Rating,
-		Comment:    onsenLog.Comment,
-		CreatedAt:  onsenLog.CreatedAt,
-		UpdatedAt:  onsenLog.UpdatedAt,
-	}
+	outputData := toOnsenLogOutputData(onsenLog)
+	outputData.NewAchievements = i.evaluateAchievements(ctx, input.UserID)
 
 	// 出力ポートを呼び出し
 	if err := i.outputPort.PresentOnsenLog(ctx, outputData); err != nil {
@@ -106,32 +110,11 @@ func (i *OnsenLogInteractor) GetOnsenLog(ctx context.Context, id, userID string)
 	}
 
 	// 画像の出力データを作成
-	imageOutputData := make([]port.ImageOutputData, len(images))
-	for i, image := range images {
-		imageOutputData[i] = port.ImageOutputData{
-			ID:          image.UUID,
-			OnsenID:     image.OnsenID,
-			URL:         image.ImageURL,
-			Description: image.Description,
-			CreatedAt:   image.CreatedAt,
-		}
-	}
+	imageOutputData := toImageOutputData(images)
 
 	// 出力データを作成
-	outputData := port.OnsenLogOutputData{
-		ID:         onsenLog.UUID,
-		UserID:     onsenLog.UserID,
-		Name:       onsenLog.Name,
-		Location:   onsenLog.Location,
-		SpringType: onsenLog.SpringType,
-		Features:   onsenLog.Features,
-		VisitDate:  onsenLog.VisitDate,
-		Rating:     onsenLog.Rating,
-		Comment:    onsenLog.Comment,
-		CreatedAt:  onsenLog.CreatedAt,
-		UpdatedAt:  onsenLog.UpdatedAt,
-		Images:     imageOutputData,
-	}
+	outputData := toOnsenLogOutputData(onsenLog)
+	outputData.Images = imageOutputData
 
 	// 出力ポートを呼び出し
 	if err := i.outputPort.PresentOnsenLog(ctx, outputData); err != nil {
@@ -151,22 +134,7 @@ func (i *OnsenLogInteractor) GetOnsenLogs(ctx context.Context, userID string, pa
 	}
 
 	// 出力データを作成
-	onsenLogOutputData := make([]port.OnsenLogOutputData, len(onsenLogs))
-	for i, onsenLog := range onsenLogs {
-		onsenLogOutputData[i] = port.OnsenLogOutputData{
-			ID:         onsenLog.UUID,
-			UserID:     onsenLog.UserID,
-			Name:       onsenLog.Name,
-			Location:   onsenLog.Location,
-			SpringType: onsenLog.SpringType,
-			Features:   onsenLog.Features,
-			VisitDate:  onsenLog.VisitDate,
-			Rating:     onsenLog.Rating,
-			Comment:    onsenLog.Comment,
-			CreatedAt:  onsenLog.CreatedAt,
-			UpdatedAt:  onsenLog.UpdatedAt,
-		}
-	}
+	onsenLogOutputData := toOnsenLogsOutputData(onsenLogs)
 
 	outputData := port.OnsenLogsOutputData{
 		OnsenLogs:  onsenLogOutputData,
@@ -189,11 +157,7 @@ func (i *OnsenLogInteractor) GetFilteredOnsenLogs(ctx context.Context, input por
 	onsenLogs, totalCount, err := i.onsenLogService.GetOnsenLogsByUserIDAndFilter(
 		ctx,
 		input.UserID,
-		input.SpringType,
-		input.Location,
-		input.MinRating,
-		input.StartDate,
-		input.EndDate,
+		toOnsenLogFilter(input),
 		input.Page,
 		input.Limit,
 	)
@@ -203,22 +167,7 @@ func (i *OnsenLogInteractor) GetFilteredOnsenLogs(ctx context.Context, input por
 	}
 
 	// 出力データを作成
-	onsenLogOutputData := make([]port.OnsenLogOutputData, len(onsenLogs))
-	for i, onsenLog := range onsenLogs {
-		onsenLogOutputData[i] = port.OnsenLogOutputData{
-			ID:         onsenLog.UUID,
-			UserID:     onsenLog.UserID,
-			Name:       onsenLog.Name,
-			Location:   onsenLog.Location,
-			SpringType: onsenLog.SpringType,
-			Features:   onsenLog.Features,
-			VisitDate:  onsenLog.VisitDate,
-			Rating:     onsenLog.Rating,
-			Comment:    onsenLog.Comment,
-			CreatedAt:  onsenLog.CreatedAt,
-			UpdatedAt:  onsenLog.UpdatedAt,
-		}
-	}
+	onsenLogOutputData := toOnsenLogsOutputData(onsenLogs)
 
 	outputData := port.OnsenLogsOutputData{
 		OnsenLogs:  onsenLogOutputData,
@@ -235,6 +184,72 @@ func (i *OnsenLogInteractor) GetFilteredOnsenLogs(ctx context.Context, input por
 	return outputData, nil
 }
 
+// GetOnsenLogStats はユーザーIDと条件に紐づく温泉メモの統計を取得します
+func (i *OnsenLogInteractor) GetOnsenLogStats(ctx context.Context, input port.FilterOnsenLogsInput) (port.OnsenLogStatsOutputData, error) {
+	// ドメインサービスを呼び出し
+	stats, err := i.onsenLogService.GetOnsenLogStats(ctx, input.UserID, toOnsenLogFilter(input))
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenLogStatsOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := port.OnsenLogStatsOutputData{
+		TotalCount:         stats.TotalCount,
+		AverageRating:      stats.AverageRating,
+		FirstVisit:         stats.FirstVisit,
+		LastVisit:          stats.LastVisit,
+		BySpringType:       stats.BySpringType,
+		ByPrefecture:       stats.ByPrefecture,
+		ByMonth:            stats.ByMonth,
+		ByYear:             stats.ByYear,
+		RatingDistribution: stats.RatingDistribution,
+		RatingByFeature:    stats.RatingByFeature,
+		Spending:           stats.Spending,
+		TopFacilities:      stats.TopFacilities,
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentOnsenLogStats(ctx, outputData); err != nil {
+		return port.OnsenLogStatsOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// GetNearbyOnsenLogs は指定地点の周辺にある温泉メモを距離の近い順に取得します
+func (i *OnsenLogInteractor) GetNearbyOnsenLogs(ctx context.Context, input port.NearbyOnsenLogsInput) ([]port.NearbyOnsenLogOutputData, error) {
+	// ドメインサービスを呼び出し
+	nearbyLogs, err := i.onsenLogService.GetNearbyOnsenLogs(
+		ctx,
+		input.UserID,
+		input.Latitude,
+		input.Longitude,
+		input.RadiusKm,
+		input.Limit,
+	)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 出力データを作成
+	outputData := make([]port.NearbyOnsenLogOutputData, len(nearbyLogs))
+	for i, nearbyLog := range nearbyLogs {
+		outputData[i] = port.NearbyOnsenLogOutputData{
+			OnsenLogOutputData: toOnsenLogOutputData(nearbyLog.OnsenLog),
+			DistanceKm:         nearbyLog.DistanceKm,
+		}
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentNearbyOnsenLogs(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
 // UpdateOnsenLog は温泉メモを更新します
 func (i *OnsenLogInteractor) UpdateOnsenLog(ctx context.Context, input port.UpdateOnsenLogInput) (port.OnsenLogOutputData, error) {
 	// 入力値のバリデーション
@@ -245,18 +260,23 @@ func (i *OnsenLogInteractor) UpdateOnsenLog(ctx context.Context, input port.Upda
 	}
 
 	// ドメインサービスを呼び出し
-	onsenLog, err := i.onsenLogService.UpdateOnsenLog(
-		ctx,
-		input.ID,
-		input.UserID,
-		input.Name,
-		input.Location,
-		input.SpringType,
-		input.Features,
-		input.VisitDate,
-		input.Rating,
-		input.Comment,
-	)
+	onsenLog, err := i.onsenLogService.UpdateOnsenLog(ctx, input.ID, input.UserID, entity.OnsenLogParams{
+		OnsenID:       input.OnsenID,
+		Name:          input.Name,
+		Location:      input.Location,
+		Latitude:      input.Latitude,
+		Longitude:     input.Longitude,
+		SpringTypes:   input.SpringTypes,
+		WaterAnalysis: input.WaterAnalysis,
+		Features:      input.Features,
+		Tags:          input.Tags,
+		VisitDate:     input.VisitDate,
+		Visit:         input.Visit,
+		Rating:        input.Rating,
+		RatingScores:  input.RatingScores,
+		Comment:       input.Comment,
+		Visibility:    input.Visibility,
+	})
 	if err != nil {
 		_ = i.outputPort.PresentError(ctx, err)
 		return port.OnsenLogOutputData{}, err
@@ -270,32 +290,12 @@ func (i *OnsenLogInteractor) UpdateOnsenLog(ctx context.Context, input port.Upda
 	}
 
 	// 画像の出力データを作成
-	imageOutputData := make([]port.ImageOutputData, len(images))
-	for i, image := range images {
-		imageOutputData[i] = port.ImageOutputData{
-			ID:          image.UUID,
-			OnsenID:     image.OnsenID,
-			URL:         image.ImageURL,
-			Description: image.Description,
-			CreatedAt:   image.CreatedAt,
-		}
-	}
+	imageOutputData := toImageOutputData(images)
 
 	// 出力データを作成
-	outputData := port.OnsenLogOutputData{
-		ID:         onsenLog.UUID,
-		UserID:     onsenLog.UserID,
-		Name:       onsenLog.Name,
-		Location:   onsenLog.Location,
-		SpringType: onsenLog.SpringType,
-		Features:   onsenLog.Features,
-		VisitDate:  onsenLog.VisitDate,
-		Rating:     onsenLog.Rating,
-		Comment:    onsenLog.Comment,
-		CreatedAt:  onsenLog.CreatedAt,
-		UpdatedAt:  onsenLog.UpdatedAt,
-		Images:     imageOutputData,
-	}
+	outputData := toOnsenLogOutputData(onsenLog)
+	outputData.Images = imageOutputData
+	outputData.NewAchievements = i.evaluateAchievements(ctx, input.UserID)
 
 	// 出力ポートを呼び出し
 	if err := i.outputPort.PresentOnsenLog(ctx, outputData); err != nil {
@@ -305,6 +305,22 @@ func (i *OnsenLogInteractor) UpdateOnsenLog(ctx context.Context, input port.Upda
 	return outputData, nil
 }
 
+// evaluateAchievements は温泉メモの作成・更新後に実績を判定し、新たに獲得した実績を返します
+// 実績の判定に失敗しても温泉メモの保存は完了しているため、エラーは記録のみ行います（次回の判定で獲得されます）
+func (i *OnsenLogInteractor) evaluateAchievements(ctx context.Context, userID string) []port.AchievementOutputData {
+	unlocked, err := i.achievementService.EvaluateAchievements(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to evaluate achievements: %v", err)
+		return nil
+	}
+
+	outputData := make([]port.AchievementOutputData, len(unlocked))
+	for i, progress := range unlocked {
+		outputData[i] = toAchievementOutputData(progress)
+	}
+	return outputData
+}
+
 // DeleteOnsenLog は温泉メモを削除します
 func (i *OnsenLogInteractor) DeleteOnsenLog(ctx context.Context, id, userID string) error {
 	// ドメインサービスを呼び出し
@@ -316,107 +332,320 @@ func (i *OnsenLogInteractor) DeleteOnsenLog(ctx context.Context, id, userID stri
 	return nil
 }
 
-// ExportOnsenLogs はユーザーIDに紐づく温泉メモをエクスポートします
-func (i *OnsenLogInteractor) ExportOnsenLogs(ctx context.Context, userID string, format string) ([]byte, error) {
-	// ドメインサービスを呼び出し
-	onsenLogs, err := i.onsenLogService.GetOnsenLogsByUserID(ctx, userID)
+// ExportOnsenLogs はユーザーIDに紐づく温泉メモをwに書き出します
+// 温泉メモは1件ずつ読み取りながら書き出すため、件数が多くてもすべてをメモリに読み込みません
+// 書き出しを始める前（最初の温泉メモを読み取る前）にエラーになった場合はwに何も書き込みません
+func (i *OnsenLogInteractor) ExportOnsenLogs(ctx context.Context, input port.ExportOnsenLogsInput, w io.Writer) error {
+	// 温泉メモを1件ずつ読み取る関数（ZIP形式では複数回読み取る）
+	each := func(fn func(onsenLog *entity.OnsenLog) error) error {
+		if input.OnsenLogIDs == nil {
+			return i.onsenLogService.EachOnsenLogByUserID(ctx, input.UserID, fn)
+		}
+
+		onsenLogs, err := i.onsenLogService.GetOnsenLogsByIDs(ctx, input.UserID, input.OnsenLogIDs)
+		if err != nil {
+			return err
+		}
+		for _, onsenLog := range onsenLogs {
+			if err := fn(onsenLog); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// フォーマットに応じてエクスポート
+	var count int
+	var err error
+	if strings.ToLower(input.Format) == "zip" {
+		count, err = i.exportAsArchive(ctx, w, input.CSV, each, input.Progress)
+	} else {
+		var exporter onsenLogExporter
+		exporter, err = newOnsenLogExporter(w, input.Format, input.CSV)
+		if err == nil {
+			count, err = runOnsenLogExporter(exporter, withExportProgress(each, input.Progress))
+		}
+	}
 	if err != nil {
 		_ = i.outputPort.PresentError(ctx, err)
-		return nil, err
+		return err
 	}
 
-	// フォーマットに応じてエクスポート
-	var data []byte
-	switch strings.ToLower(format) {
+	// 出力ポートを呼び出し
+	return i.outputPort.PresentExportedData(ctx, input.Format, count)
+}
+
+// ImportOnsenLogs はエクスポートと同じ形式のファイルから温泉メモをインポートします
+func (i *OnsenLogInteractor) ImportOnsenLogs(ctx context.Context, input port.ImportOnsenLogsInput) (port.ImportOnsenLogsOutputData, error) {
+	// フォーマットに応じてファイルを読み取り
+	var rows []entity.ImportRow
+	var err error
+	switch strings.ToLower(input.Format) {
 	case "json":
-		data, err = i.exportAsJSON(onsenLogs)
+		rows, err = parseImportJSON(input.Data)
 	case "csv":
-		data, err = i.exportAsCSV(onsenLogs)
+		rows, err = parseImportCSV(input.Data, input.DateLayout)
 	default:
-		err = fmt.Errorf("unsupported format: %s", format)
+		err = fmt.Errorf("unsupported format: %s", input.Format)
+	}
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ImportOnsenLogsOutputData{}, err
 	}
 
+	// ドメインサービスを呼び出し
+	summary, err := i.onsenLogService.ImportOnsenLogs(ctx, input.UserID, rows, input.Policy, input.DryRun)
 	if err != nil {
 		_ = i.outputPort.PresentError(ctx, err)
-		return nil, err
+		return port.ImportOnsenLogsOutputData{}, err
+	}
+
+	// 出力データを作成（温泉メモを保存した場合は実績を評価）
+	outputData := port.ImportOnsenLogsOutputData{ImportSummary: *summary}
+	if !input.DryRun && summary.Created+summary.Updated > 0 {
+		outputData.NewAchievements = i.evaluateAchievements(ctx, input.UserID)
 	}
 
 	// 出力ポートを呼び出し
-	if err := i.outputPort.PresentExportedData(ctx, data, format); err != nil {
-		return nil, err
+	if err := i.outputPort.PresentImportResult(ctx, outputData); err != nil {
+		return port.ImportOnsenLogsOutputData{}, err
 	}
 
-	return data, nil
+	return outputData, nil
 }
 
-// exportAsJSON はJSONフォーマットでエクスポートします
-func (i *OnsenLogInteractor) exportAsJSON(onsenLogs []*entity.OnsenLog) ([]byte, error) {
-	// 出力データを作成
-	onsenLogOutputData := make([]port.OnsenLogOutputData, len(onsenLogs))
-	for i, onsenLog := range onsenLogs {
-		onsenLogOutputData[i] = port.OnsenLogOutputData{
-			ID:         onsenLog.UUID,
-			UserID:     onsenLog.UserID,
-			Name:       onsenLog.Name,
-			Location:   onsenLog.Location,
-			SpringType: onsenLog.SpringType,
-			Features:   onsenLog.Features,
-			VisitDate:  onsenLog.VisitDate,
-			Rating:     onsenLog.Rating,
-			Comment:    onsenLog.Comment,
-			CreatedAt:  onsenLog.CreatedAt,
-			UpdatedAt:  onsenLog.UpdatedAt,
+// csvColumnValue は温泉メモの列の値を文字列に変換します（未記録の項目は空文字）
+func csvColumnValue(onsenLog *entity.OnsenLog, column entity.CSVColumn, dateLayout string) string {
+	switch column {
+	case entity.CSVColumnID:
+		return onsenLog.UUID
+	case entity.CSVColumnName:
+		return onsenLog.Name
+	case entity.CSVColumnLocation:
+		return onsenLog.Location
+	case entity.CSVColumnPrefecture:
+		return onsenLog.Area.Prefecture
+	case entity.CSVColumnMunicipality:
+		return onsenLog.Area.Municipality
+	case entity.CSVColumnLatitude:
+		if onsenLog.Coordinates == nil {
+			return ""
 		}
-	}
+		return strconv.FormatFloat(onsenLog.Coordinates.Latitude(), 'f', -1, 64)
+	case entity.CSVColumnLongitude:
+		if onsenLog.Coordinates == nil {
+			return ""
+		}
+		return strconv.FormatFloat(onsenLog.Coordinates.Longitude(), 'f', -1, 64)
+	case entity.CSVColumnSpringTypes:
+		return entity.JoinSpringTypes(onsenLog.SpringTypes, ", ")
+	case entity.CSVColumnFeatures:
+		features := make([]string, len(onsenLog.Features))
+		for i, feature := range onsenLog.Features {
+			features[i] = string(feature)
+		}
+		return strings.Join(features, ", ")
+	case entity.CSVColumnTags:
+		return strings.Join(onsenLog.Tags, ", ")
+	case entity.CSVColumnVisitDate:
+		return onsenLog.VisitDate.Format(dateLayout)
+	case entity.CSVColumnRating:
+		return formatRating(onsenLog.Rating)
+	case entity.CSVColumnComment:
+		return onsenLog.Comment
+	case entity.CSVColumnVisibility:
+		return string(onsenLog.Visibility.OrDefault())
+	case entity.CSVColumnCreatedAt:
+		return onsenLog.CreatedAt.Format(dateLayout + " 15:04:05")
+	case entity.CSVColumnUpdatedAt:
+		return onsenLog.UpdatedAt.Format(dateLayout + " 15:04:05")
+	}
+
+	// 評価項目の列
+	if criterion := entity.RatingCriterion(column); criterion.IsValid() {
+		return formatRating(float64(onsenLog.RatingScores.Score(criterion)))
+	}
+
+	// 訪問時の状況の列
+	return visitColumnValue(onsenLog.Visit, column)
+}
+
+// cp932Replacer はShift_JISの変換表にないがCP932（Windows）で使われる文字を、CP932の対応する文字に置き換えます
+// macOSなどで入力した波ダッシュやマイナス記号がExcelで「?」にならないようにします
+var cp932Replacer = strings.NewReplacer(
+	"\u301C", "\uFF5E", // 〜 → ～
+	"\u2212", "\uFF0D", // − → －
+	"\u2014", "\u2015", // — → ―
+	"\u2016", "\u2225", // ‖ → ∥
+	"\u00A2", "\uFFE0", // ¢ → ￠
+	"\u00A3", "\uFFE1", // £ → ￡
+	"\u00AC", "\uFFE2", // ¬ → ￢
+)
 
-	// JSONにエンコード
-	return json.MarshalIndent(onsenLogOutputData, "", "  ")
+// newCSVEncoder は文字コードに応じたCSVのエンコーダーを作成します（UTF-8の場合はnil）
+func newCSVEncoder(csvEncoding entity.CSVEncoding) *encoding.Encoder {
+	if csvEncoding != entity.CSVEncodingShiftJIS {
+		return nil
+	}
+	return japanese.ShiftJIS.NewEncoder()
 }
 
-// exportAsCSV はCSVフォーマットでエクスポートします
-func (i *OnsenLogInteractor) exportAsCSV(onsenLogs []*entity.OnsenLog) ([]byte, error) {
-	// CSVデータを作成
-	var sb strings.Builder
-	writer := csv.NewWriter(&sb)
+// encodeCSV はCSVをエンコーダーの文字コードに変換します（BOMは含めません。エンコーダーがnilの場合はそのまま返します）
+// CP932の文字に置き換えたうえで、Shift_JISで表せない文字は「?」に置き換えます
+func encodeCSV(data []byte, encoder *encoding.Encoder) []byte {
+	if encoder == nil {
+		return data
+	}
 
-	// ヘッダーを書き込み
-	header := []string{"ID", "温泉名", "所在地", "泉質", "特徴", "訪問日", "評価", "コメント", "作成日", "更新日"}
-	if err := writer.Write(header); err != nil {
-		return nil, err
+	var buf bytes.Buffer
+	for _, r := range cp932Replacer.Replace(string(data)) {
+		encoded, err := encoder.Bytes([]byte(string(r)))
+		if err != nil {
+			buf.WriteByte('?')
+			continue
+		}
+		buf.Write(encoded)
 	}
+	return buf.Bytes()
+}
 
-	// データを書き込み
-	for _, onsenLog := range onsenLogs {
-		// 特徴を文字列に変換
-		features := make([]string, len(onsenLog.Features))
-		for i, feature := range onsenLog.Features {
-			features[i] = string(feature)
+// visitColumnValue は訪問時の状況の列の値を文字列に変換します（未記録の項目は空文字）
+func visitColumnValue(visit entity.VisitDetails, column entity.CSVColumn) string {
+	switch column {
+	case entity.CSVColumnArrivalTime:
+		return visit.ArrivalTime
+	case entity.CSVColumnDurationMinutes:
+		if visit.DurationMinutes > 0 {
+			return strconv.Itoa(visit.DurationMinutes)
 		}
-		featuresStr := strings.Join(features, ", ")
-
-		// 行データを作成
-		row := []string{
-			onsenLog.UUID,
-			onsenLog.Name,
-			onsenLog.Location,
-			string(onsenLog.SpringType),
-			featuresStr,
-			onsenLog.VisitDate.Format("2006-01-02"),
-			fmt.Sprintf("%d", onsenLog.Rating),
-			onsenLog.Comment,
-			onsenLog.CreatedAt.Format("2006-01-02 15:04:05"),
-			onsenLog.UpdatedAt.Format("2006-01-02 15:04:05"),
+	case entity.CSVColumnStayType:
+		return string(visit.StayType)
+	case entity.CSVColumnCompanions:
+		return entity.JoinCompanions(visit.Companions, ", ")
+	case entity.CSVColumnCurrency:
+		return visit.Currency
+	case entity.CSVColumnEntranceFee:
+		if visit.EntranceFee != nil {
+			return formatAmount(*visit.EntranceFee)
 		}
-
-		if err := writer.Write(row); err != nil {
-			return nil, err
+	case entity.CSVColumnOtherCosts:
+		if len(visit.OtherCosts) > 0 {
+			return formatAmount(visit.OtherCostTotal())
+		}
+	case entity.CSVColumnTotalCost:
+		if visit.HasCosts() {
+			return formatAmount(visit.TotalCost)
 		}
 	}
+	return ""
+}
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return nil, err
+// formatAmount は金額を文字列に変換します
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+// formatRating は評価を文字列に変換します（未評価の場合は空文字）
+func formatRating(rating float64) string {
+	if rating == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(rating, 'f', -1, 64)
+}
+
+// toOnsenLogFilter は絞り込みの入力データを温泉メモの検索条件に変換します
+func toOnsenLogFilter(input port.FilterOnsenLogsInput) repository.OnsenLogFilter {
+	return repository.OnsenLogFilter{
+		SpringTypes:        input.SpringTypes,
+		Location:           input.Location,
+		PrefectureCodes:    input.Prefectures,
+		MinRating:          input.MinRating,
+		MinScores:          input.MinScores,
+		MinPH:              input.MinPH,
+		MaxPH:              input.MaxPH,
+		MinTemperature:     input.MinTemperature,
+		MaxTemperature:     input.MaxTemperature,
+		Tonicity:           input.Tonicity,
+		StartDate:          input.StartDate,
+		EndDate:            input.EndDate,
+		StayType:           input.StayType,
+		Companions:         input.Companions,
+		ArrivalFrom:        input.ArrivalFrom,
+		ArrivalTo:          input.ArrivalTo,
+		MinDurationMinutes: input.MinDuration,
+		MaxDurationMinutes: input.MaxDuration,
+		MinTotalCost:       input.MinTotalCost,
+		MaxTotalCost:       input.MaxTotalCost,
+		Currency:           input.Currency,
+		Query:              input.Query,
+		Tags:               input.Tags,
+		TagMatch:           input.TagMatch,
+		Sort: repository.OnsenLogSort{
+			Field:     input.SortBy,
+			Ascending: strings.EqualFold(input.SortOrder, "asc"),
+		},
+	}
+}
+
+// toOnsenLogOutputData は温泉メモエンティティを出力データに変換します
+func toOnsenLogOutputData(onsenLog *entity.OnsenLog) port.OnsenLogOutputData {
+	outputData := port.OnsenLogOutputData{
+		ID:               onsenLog.UUID,
+		UserID:           onsenLog.UserID,
+		OnsenID:          onsenLog.OnsenID,
+		Name:             onsenLog.Name,
+		Location:         onsenLog.Location,
+		Area:             onsenLog.Area,
+		SpringTypes:      onsenLog.SpringTypes,
+		LegacySpringType: onsenLog.LegacySpringType,
+		WaterAnalysis:    onsenLog.WaterAnalysis,
+		LiquidClass:      onsenLog.WaterAnalysis.LiquidClass(),
+		TemperatureClass: onsenLog.WaterAnalysis.TemperatureClass(),
+		Features:         onsenLog.Features,
+		Tags:             onsenLog.Tags,
+		VisitDate:        onsenLog.VisitDate,
+		Visit:            onsenLog.Visit,
+		TimeOfDay:        onsenLog.Visit.TimeOfDay(),
+		Rating:           onsenLog.Rating,
+		RatingScores:     onsenLog.RatingScores,
+		RatingOverridden: onsenLog.RatingOverridden,
+		Comment:          onsenLog.Comment,
+		Visibility:       onsenLog.Visibility.OrDefault(),
+		WishlistItemID:   onsenLog.WishlistItemID,
+		CreatedAt:        onsenLog.CreatedAt,
+		UpdatedAt:        onsenLog.UpdatedAt,
+	}
+
+	if onsenLog.Coordinates != nil {
+		latitude := onsenLog.Coordinates.Latitude()
+		longitude := onsenLog.Coordinates.Longitude()
+		outputData.Latitude = &latitude
+		outputData.Longitude = &longitude
+	}
+
+	return outputData
+}
+
+// toOnsenLogsOutputData は温泉メモエンティティのリストを出力データに変換します
+func toOnsenLogsOutputData(onsenLogs []*entity.OnsenLog) []port.OnsenLogOutputData {
+	outputData := make([]port.OnsenLogOutputData, len(onsenLogs))
+	for i, onsenLog := range onsenLogs {
+		outputData[i] = toOnsenLogOutputData(onsenLog)
 	}
+	return outputData
+}
 
-	return []byte(sb.String()), nil
+// toImageOutputData は温泉画像エンティティのリストを出力データに変換します
+func toImageOutputData(images []*entity.OnsenImage) []port.ImageOutputData {
+	outputData := make([]port.ImageOutputData, len(images))
+	for i, image := range images {
+		outputData[i] = port.ImageOutputData{
+			ID:          image.UUID,
+			OnsenID:     image.OnsenID,
+			URL:         image.ImageURL,
+			Description: image.Description,
+			CreatedAt:   image.CreatedAt,
+		}
+	}
+	return outputData
 }
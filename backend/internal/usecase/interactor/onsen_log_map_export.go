@@ -0,0 +1,243 @@
+package interactor
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// 地図アプリ向けエクスポートで使用する定数
+const (
+	kmlNamespace = "http://www.opengis.net/kml/2.2"
+	gpxNamespace = "http://www.topografix.com/GPX/1/1"
+	mapExportApp = "Yuroku"
+)
+
+// geoJSONFeature はGeoJSONのFeatureです（位置情報がない場合geometryはnull）
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Geometry   *entity.GeoPoint       `json:"geometry"`
+	Properties geoJSONFeatureProperty `json:"properties"`
+}
+
+// geoJSONFeatureProperty はGeoJSONのFeatureに付与する温泉メモの属性です
+type geoJSONFeatureProperty struct {
+	Name          string               `json:"name"`
+	Location      string               `json:"location"`
+	SpringTypes   []entity.SpringType  `json:"spring_types"`
+	WaterAnalysis entity.WaterAnalysis `json:"water_analysis"`
+	Features      []entity.Feature     `json:"features"`
+	Rating        float64              `json:"rating"`
+	VisitDate     string               `json:"visit_date"`
+	Comment       string               `json:"comment"`
+}
+
+// kmlPlacemark はKMLの地点です
+type kmlPlacemark struct {
+	Name         string    `xml:"name"`
+	Description  string    `xml:"description"`
+	When         string    `xml:"TimeStamp>when"`
+	ExtendedData []kmlData `xml:"ExtendedData>Data"`
+	Coordinates  string    `xml:"Point>coordinates"`
+}
+
+// kmlData はKMLの拡張データです
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+// gpxWaypoint はGPXのウェイポイントです
+type gpxWaypoint struct {
+	Latitude    float64 `xml:"lat,attr"`
+	Longitude   float64 `xml:"lon,attr"`
+	Time        string  `xml:"time"`
+	Name        string  `xml:"name"`
+	Description string  `xml:"desc"`
+	Type        string  `xml:"type"`
+}
+
+// geoJSONOnsenLogExporter は温泉メモをGeoJSONのFeatureCollectionとして書き出すエクスポーターです
+// 位置情報のない温泉メモはgeometryをnullにして書き出します
+type geoJSONOnsenLogExporter struct {
+	w     io.Writer
+	count int
+}
+
+// Begin はFeatureCollectionの先頭を書き出します
+func (e *geoJSONOnsenLogExporter) Begin() error {
+	_, err := io.WriteString(e.w, "{\n  \"type\": \"FeatureCollection\",\n  \"features\": [")
+	return err
+}
+
+// Write は温泉メモをFeatureとして書き出します
+func (e *geoJSONOnsenLogExporter) Write(onsenLog *entity.OnsenLog) error {
+	feature := geoJSONFeature{
+		Type:     "Feature",
+		ID:       onsenLog.UUID,
+		Geometry: onsenLog.Coordinates,
+		Properties: geoJSONFeatureProperty{
+			Name:          onsenLog.Name,
+			Location:      onsenLog.Location,
+			SpringTypes:   onsenLog.SpringTypes,
+			WaterAnalysis: onsenLog.WaterAnalysis,
+			Features:      onsenLog.Features,
+			Rating:        onsenLog.Rating,
+			VisitDate:     onsenLog.VisitDate.Format("2006-01-02"),
+			Comment:       onsenLog.Comment,
+		},
+	}
+	data, err := json.MarshalIndent(feature, "    ", "  ")
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n    "
+	if e.count == 0 {
+		separator = "\n    "
+	}
+	e.count++
+
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+// End はFeatureCollectionの末尾を書き出します
+func (e *geoJSONOnsenLogExporter) End() error {
+	end := "\n  ]\n}"
+	if e.count == 0 {
+		end = "]\n}"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+// xmlOnsenLogExporter は温泉メモをXMLの要素として1件ずつ書き出すエクスポーターです
+// 地図アプリ向けの形式では位置情報のない温泉メモは含めません
+type xmlOnsenLogExporter struct {
+	w       io.Writer
+	encoder *xml.Encoder
+	root    []xml.StartElement
+	begin   func(encoder *xml.Encoder) error
+	element func(onsenLog *entity.OnsenLog) (interface{}, xml.StartElement)
+}
+
+// newKMLOnsenLogExporter はKMLの地点として書き出すエクスポーターを作成します
+func newKMLOnsenLogExporter(w io.Writer) *xmlOnsenLogExporter {
+	return &xmlOnsenLogExporter{
+		w: w,
+		root: []xml.StartElement{
+			{Name: xml.Name{Local: "kml"}, Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: kmlNamespace}}},
+			{Name: xml.Name{Local: "Document"}},
+		},
+		begin: func(encoder *xml.Encoder) error {
+			return encoder.EncodeElement(mapExportApp, xml.StartElement{Name: xml.Name{Local: "name"}})
+		},
+		element: func(onsenLog *entity.OnsenLog) (interface{}, xml.StartElement) {
+			placemark := kmlPlacemark{
+				Name:        onsenLog.Name,
+				Description: mapExportDescription(onsenLog),
+				When:        onsenLog.VisitDate.Format("2006-01-02"),
+				ExtendedData: []kmlData{
+					{Name: "spring_types", Value: entity.JoinSpringTypes(onsenLog.SpringTypes, ", ")},
+					{Name: "rating", Value: formatRating(onsenLog.Rating)},
+					{Name: "visit_date", Value: onsenLog.VisitDate.Format("2006-01-02")},
+					{Name: "comment", Value: onsenLog.Comment},
+				},
+				Coordinates: fmt.Sprintf("%f,%f", onsenLog.Coordinates.Longitude(), onsenLog.Coordinates.Latitude()),
+			}
+			return placemark, xml.StartElement{Name: xml.Name{Local: "Placemark"}}
+		},
+	}
+}
+
+// newGPXOnsenLogExporter はGPXのウェイポイントとして書き出すエクスポーターを作成します
+func newGPXOnsenLogExporter(w io.Writer) *xmlOnsenLogExporter {
+	return &xmlOnsenLogExporter{
+		w: w,
+		root: []xml.StartElement{
+			{Name: xml.Name{Local: "gpx"}, Attr: []xml.Attr{
+				{Name: xml.Name{Local: "xmlns"}, Value: gpxNamespace},
+				{Name: xml.Name{Local: "version"}, Value: "1.1"},
+				{Name: xml.Name{Local: "creator"}, Value: mapExportApp},
+			}},
+		},
+		element: func(onsenLog *entity.OnsenLog) (interface{}, xml.StartElement) {
+			waypoint := gpxWaypoint{
+				Latitude:    onsenLog.Coordinates.Latitude(),
+				Longitude:   onsenLog.Coordinates.Longitude(),
+				Time:        onsenLog.VisitDate.UTC().Format("2006-01-02T15:04:05Z"),
+				Name:        onsenLog.Name,
+				Description: mapExportDescription(onsenLog),
+				Type:        entity.JoinSpringTypes(onsenLog.SpringTypes, "・"),
+			}
+			return waypoint, xml.StartElement{Name: xml.Name{Local: "wpt"}}
+		},
+	}
+}
+
+// Begin はXML宣言とルート要素の開始タグを書き出します
+func (e *xmlOnsenLogExporter) Begin() error {
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return err
+	}
+
+	e.encoder = xml.NewEncoder(e.w)
+	e.encoder.Indent("", "  ")
+	for _, start := range e.root {
+		if err := e.encoder.EncodeToken(start); err != nil {
+			return err
+		}
+	}
+	if e.begin != nil {
+		return e.begin(e.encoder)
+	}
+	return e.encoder.Flush()
+}
+
+// Write は位置情報のある温泉メモを要素として書き出します
+func (e *xmlOnsenLogExporter) Write(onsenLog *entity.OnsenLog) error {
+	if onsenLog.Coordinates == nil {
+		return nil
+	}
+	element, start := e.element(onsenLog)
+	return e.encoder.EncodeElement(element, start)
+}
+
+// End はルート要素の終了タグを書き出します
+func (e *xmlOnsenLogExporter) End() error {
+	for i := len(e.root) - 1; i >= 0; i-- {
+		if err := e.encoder.EncodeToken(e.root[i].End()); err != nil {
+			return err
+		}
+	}
+	return e.encoder.Flush()
+}
+
+// mapExportDescription は地図アプリで表示する説明文を作成します
+func mapExportDescription(onsenLog *entity.OnsenLog) string {
+	lines := []string{
+		"泉質: " + entity.JoinSpringTypes(onsenLog.SpringTypes, "・"),
+		"評価: " + ratingStars(onsenLog.Rating),
+		"訪問日: " + onsenLog.VisitDate.Format("2006-01-02"),
+	}
+	if onsenLog.Comment != "" {
+		lines = append(lines, onsenLog.Comment)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ratingStars は総合評価を四捨五入して星の数で表します
+func ratingStars(rating float64) string {
+	stars := int(math.Round(rating))
+	return strings.Repeat("★", stars) + strings.Repeat("☆", 5-stars)
+}
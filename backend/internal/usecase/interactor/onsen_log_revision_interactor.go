@@ -0,0 +1,104 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// OnsenLogRevisionInteractor は温泉メモの変更履歴ユースケースのインタラクターです
+type OnsenLogRevisionInteractor struct {
+	revisionService *service.OnsenLogRevisionService
+	outputPort      port.OnsenLogRevisionOutputPort
+}
+
+// NewOnsenLogRevisionInteractor は新しい温泉メモの変更履歴インタラクターを作成します
+func NewOnsenLogRevisionInteractor(revisionService *service.OnsenLogRevisionService, outputPort port.OnsenLogRevisionOutputPort) *OnsenLogRevisionInteractor {
+	return &OnsenLogRevisionInteractor{
+		revisionService: revisionService,
+		outputPort:      outputPort,
+	}
+}
+
+// GetRevisions は温泉メモの版を新しい順に取得します
+func (i *OnsenLogRevisionInteractor) GetRevisions(ctx context.Context, onsenLogID, userID string) ([]port.OnsenLogRevisionOutputData, error) {
+	// ドメインサービスを呼び出し
+	revisions, err := i.revisionService.GetRevisions(ctx, onsenLogID, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 出力データを作成
+	outputData := make([]port.OnsenLogRevisionOutputData, len(revisions))
+	for i2, revision := range revisions {
+		outputData[i2] = toOnsenLogRevisionOutputData(revision)
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentRevisions(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// DiffRevisions は温泉メモの2つの版を項目ごとに比較します
+func (i *OnsenLogRevisionInteractor) DiffRevisions(ctx context.Context, input port.DiffOnsenLogRevisionsInput) (port.OnsenLogRevisionDiffOutputData, error) {
+	// ドメインサービスを呼び出し
+	from, to, changes, err := i.revisionService.DiffRevisions(ctx, input.OnsenLogID, input.UserID, input.From, input.To)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenLogRevisionDiffOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := port.OnsenLogRevisionDiffOutputData{
+		From:    toOnsenLogRevisionOutputData(from),
+		To:      toOnsenLogRevisionOutputData(to),
+		Changes: changes,
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentRevisionDiff(ctx, outputData); err != nil {
+		return port.OnsenLogRevisionDiffOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// RevertOnsenLog は温泉メモを以前の版の状態に戻します
+func (i *OnsenLogRevisionInteractor) RevertOnsenLog(ctx context.Context, input port.RevertOnsenLogInput) (port.OnsenLogOutputData, error) {
+	// ドメインサービスを呼び出し
+	onsenLog, err := i.revisionService.RevertOnsenLog(ctx, input.OnsenLogID, input.RevisionID, input.UserID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenLogOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toOnsenLogOutputData(onsenLog)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentRevertedOnsenLog(ctx, outputData); err != nil {
+		return port.OnsenLogOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// toOnsenLogRevisionOutputData は温泉メモの版エンティティを出力データに変換します
+func toOnsenLogRevisionOutputData(revision *entity.OnsenLogRevision) port.OnsenLogRevisionOutputData {
+	return port.OnsenLogRevisionOutputData{
+		ID:                revision.UUID,
+		Version:           revision.Version,
+		Action:            revision.Action,
+		ActorID:           revision.ActorID,
+		RevertedToVersion: revision.RevertedToVersion,
+		ChangedFields:     revision.ChangedFields,
+		Changes:           revision.Changes(),
+		CreatedAt:         revision.CreatedAt,
+	}
+}
@@ -0,0 +1,551 @@
+package interactor
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// XLSXエクスポートで使用する定数
+const (
+	xlsxMainNamespace         = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	xlsxRelationshipNamespace = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	xlsxPackageRelNamespace   = "http://schemas.openxmlformats.org/package/2006/relationships"
+	xlsxContentTypeNamespace  = "http://schemas.openxmlformats.org/package/2006/content-types"
+	xlsxDefaultColumnWidth    = 14
+)
+
+// xlsxStyle はstyles.xmlのセルの書式（cellXfs）のインデックスです
+type xlsxStyle int
+
+// セルの書式
+const (
+	xlsxStyleDefault xlsxStyle = iota
+	xlsxStyleHeader
+	xlsxStyleDate
+	xlsxStyleDateTime
+	xlsxStyleWrap
+)
+
+// xlsxExcelEpoch はExcelのシリアル値の起点です（1900年3月1日以降の日付で正しい値になります）
+var xlsxExcelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// xlsxCell はワークシートのセルです
+// isNumberがfalseで文字列が空のセルは出力しません
+type xlsxCell struct {
+	text     string
+	number   float64
+	isNumber bool
+	style    xlsxStyle
+}
+
+// xlsxSheet はワークシートの内容です
+type xlsxSheet struct {
+	name   string
+	widths []float64
+	rows   [][]xlsxCell
+}
+
+// xlsxLabels はXLSXのシート名と集計表の見出しです
+type xlsxLabels struct {
+	logsSheet         string
+	summarySheet      string
+	corner            string
+	total             string
+	noSpringType      string
+	unknownPrefecture string
+}
+
+// xlsxLabelsByLanguage はヘッダーの言語ごとのシート名と集計表の見出しです
+var xlsxLabelsByLanguage = map[entity.HeaderLanguage]xlsxLabels{
+	entity.HeaderLanguageJapanese: {
+		logsSheet:         "温泉メモ",
+		summarySheet:      "集計",
+		corner:            "泉質＼都道府県",
+		total:             "合計",
+		noSpringType:      "泉質未記録",
+		unknownPrefecture: entity.UnknownPrefecture,
+	},
+	entity.HeaderLanguageEnglish: {
+		logsSheet:         "Onsen Logs",
+		summarySheet:      "Summary",
+		corner:            "Spring Type / Prefecture",
+		total:             "Total",
+		noSpringType:      "Not recorded",
+		unknownPrefecture: "Unknown",
+	},
+}
+
+// xlsxOnsenLogExporter は温泉メモをExcelのブック（XLSX）として書き出すエクスポーターです
+// 1枚目のシートに温泉メモを日付・数値の型付きの列で、2枚目のシートに泉質と都道府県ごとの訪問回数の集計表を出力します
+// 温泉メモの行は1件ずつZIPに書き出し、集計表は件数だけを保持して最後に書き出します
+type xlsxOnsenLogExporter struct {
+	archive  *zip.Writer
+	sheet    io.Writer
+	columns  []entity.CSVColumn
+	language entity.HeaderLanguage
+	labels   xlsxLabels
+	summary  *xlsxSummary
+	rows     int
+}
+
+// newXLSXOnsenLogExporter は出力オプションの列とヘッダーの言語を使用するXLSXのエクスポーターを作成します
+func newXLSXOnsenLogExporter(w io.Writer, options port.CSVExportOptions) *xlsxOnsenLogExporter {
+	labels, ok := xlsxLabelsByLanguage[options.HeaderLanguage]
+	if !ok {
+		labels = xlsxLabelsByLanguage[entity.HeaderLanguageJapanese]
+	}
+	columns := options.Columns
+	if len(columns) == 0 {
+		columns = entity.DefaultCSVColumns()
+	}
+
+	return &xlsxOnsenLogExporter{
+		archive:  zip.NewWriter(w),
+		columns:  columns,
+		language: options.HeaderLanguage,
+		labels:   labels,
+		summary:  newXLSXSummary(labels),
+	}
+}
+
+// Begin は温泉メモのシートの先頭とヘッダーの行を書き出します
+func (e *xlsxOnsenLogExporter) Begin() error {
+	sheet, err := e.archive.Create(xlsxWorksheetPath(1))
+	if err != nil {
+		return err
+	}
+	e.sheet = sheet
+
+	// 列幅とヘッダー
+	widths := make([]float64, len(e.columns))
+	header := make([]xlsxCell, len(e.columns))
+	for i, column := range e.columns {
+		widths[i] = xlsxColumnWidth(column)
+		header[i] = xlsxCell{text: column.Label(e.language), style: xlsxStyleHeader}
+	}
+	if _, err := io.WriteString(e.sheet, xlsxWorksheetStart(widths)); err != nil {
+		return err
+	}
+	return e.writeRow(header)
+}
+
+// Write は温泉メモを1行書き出し、集計表に加えます
+func (e *xlsxOnsenLogExporter) Write(onsenLog *entity.OnsenLog) error {
+	row := make([]xlsxCell, len(e.columns))
+	for i, column := range e.columns {
+		row[i] = xlsxColumnCell(onsenLog, column)
+	}
+	e.summary.add(onsenLog)
+	return e.writeRow(row)
+}
+
+// End は温泉メモのシートを閉じ、集計表のシートとブックの定義を書き出します
+func (e *xlsxOnsenLogExporter) End() error {
+	if _, err := io.WriteString(e.sheet, xlsxWorksheetEnd); err != nil {
+		return err
+	}
+
+	// 集計表のシート
+	summary := e.summary.sheet()
+	sheet, err := e.archive.Create(xlsxWorksheetPath(2))
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(sheet, xlsxWorksheetStart(summary.widths)); err != nil {
+		return err
+	}
+	for i, row := range summary.rows {
+		if _, err := io.WriteString(sheet, xlsxRow(i+1, row)); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(sheet, xlsxWorksheetEnd); err != nil {
+		return err
+	}
+
+	// ブックの定義
+	sheetNames := []string{e.labels.logsSheet, summary.name}
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes(len(sheetNames))},
+		{"_rels/.rels", xml.Header + `<Relationships xmlns="` + xlsxPackageRelNamespace + `">` +
+			`<Relationship Id="rId1" Type="` + xlsxRelationshipNamespace + `/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xlsxWorkbook(sheetNames)},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels(len(sheetNames))},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for _, file := range files {
+		writer, err := e.archive.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(writer, file.content); err != nil {
+			return err
+		}
+	}
+
+	return e.archive.Close()
+}
+
+// writeRow は温泉メモのシートに1行書き出します
+func (e *xlsxOnsenLogExporter) writeRow(cells []xlsxCell) error {
+	e.rows++
+	_, err := io.WriteString(e.sheet, xlsxRow(e.rows, cells))
+	return err
+}
+
+// xlsxColumnCell は温泉メモの列の値を型付きのセルに変換します（未記録の項目は空のセル）
+func xlsxColumnCell(onsenLog *entity.OnsenLog, column entity.CSVColumn) xlsxCell {
+	switch column {
+	case entity.CSVColumnVisitDate:
+		return xlsxDateCell(onsenLog.VisitDate, xlsxStyleDate)
+	case entity.CSVColumnCreatedAt:
+		return xlsxDateCell(onsenLog.CreatedAt, xlsxStyleDateTime)
+	case entity.CSVColumnUpdatedAt:
+		return xlsxDateCell(onsenLog.UpdatedAt, xlsxStyleDateTime)
+	case entity.CSVColumnRating:
+		return xlsxRatingCell(onsenLog.Rating)
+	case entity.CSVColumnComment:
+		return xlsxCell{text: onsenLog.Comment, style: xlsxStyleWrap}
+	case entity.CSVColumnLatitude:
+		if onsenLog.Coordinates != nil {
+			return xlsxNumberCell(onsenLog.Coordinates.Latitude())
+		}
+		return xlsxCell{}
+	case entity.CSVColumnLongitude:
+		if onsenLog.Coordinates != nil {
+			return xlsxNumberCell(onsenLog.Coordinates.Longitude())
+		}
+		return xlsxCell{}
+	case entity.CSVColumnDurationMinutes:
+		if onsenLog.Visit.DurationMinutes > 0 {
+			return xlsxNumberCell(float64(onsenLog.Visit.DurationMinutes))
+		}
+		return xlsxCell{}
+	case entity.CSVColumnEntranceFee:
+		if onsenLog.Visit.EntranceFee != nil {
+			return xlsxNumberCell(*onsenLog.Visit.EntranceFee)
+		}
+		return xlsxCell{}
+	case entity.CSVColumnOtherCosts:
+		if len(onsenLog.Visit.OtherCosts) > 0 {
+			return xlsxNumberCell(onsenLog.Visit.OtherCostTotal())
+		}
+		return xlsxCell{}
+	case entity.CSVColumnTotalCost:
+		if onsenLog.Visit.HasCosts() {
+			return xlsxNumberCell(onsenLog.Visit.TotalCost)
+		}
+		return xlsxCell{}
+	}
+
+	// 評価項目の列
+	if criterion := entity.RatingCriterion(column); criterion.IsValid() {
+		return xlsxRatingCell(float64(onsenLog.RatingScores.Score(criterion)))
+	}
+
+	// その他の列は文字列
+	return xlsxCell{text: csvColumnValue(onsenLog, column, "2006-01-02")}
+}
+
+// xlsxColumnWidth は列の幅（文字数）を返します
+func xlsxColumnWidth(column entity.CSVColumn) float64 {
+	switch column {
+	case entity.CSVColumnComment:
+		return 50
+	case entity.CSVColumnID, entity.CSVColumnName, entity.CSVColumnLocation:
+		return 24
+	case entity.CSVColumnSpringTypes, entity.CSVColumnFeatures, entity.CSVColumnTags,
+		entity.CSVColumnCreatedAt, entity.CSVColumnUpdatedAt:
+		return 20
+	}
+	return xlsxDefaultColumnWidth
+}
+
+// xlsxSummary は泉質（行）と都道府県（列）ごとの訪問回数の集計表です
+// 複数の泉質を記録した温泉メモはそれぞれの泉質で数え、合計は各行・各列の和です
+type xlsxSummary struct {
+	labels          xlsxLabels
+	counts          map[string]map[string]int
+	prefectureCodes map[string]string
+}
+
+// newXLSXSummary は空の集計表を作成します
+func newXLSXSummary(labels xlsxLabels) *xlsxSummary {
+	return &xlsxSummary{
+		labels:          labels,
+		counts:          make(map[string]map[string]int),
+		prefectureCodes: make(map[string]string),
+	}
+}
+
+// add は温泉メモを集計表に加えます
+func (s *xlsxSummary) add(onsenLog *entity.OnsenLog) {
+	prefecture := onsenLog.Area.Prefecture
+	if prefecture == "" {
+		prefecture = s.labels.unknownPrefecture
+	}
+	s.prefectureCodes[prefecture] = onsenLog.Area.PrefectureCode
+
+	springTypes := make([]string, 0, len(onsenLog.SpringTypes))
+	for _, springType := range entity.NormalizeSpringTypes(onsenLog.SpringTypes) {
+		springTypes = append(springTypes, string(springType))
+	}
+	if len(springTypes) == 0 {
+		springTypes = append(springTypes, s.labels.noSpringType)
+	}
+
+	for _, springType := range springTypes {
+		if s.counts[springType] == nil {
+			s.counts[springType] = make(map[string]int)
+		}
+		s.counts[springType][prefecture]++
+	}
+}
+
+// sheet は集計表のシートを作成します
+func (s *xlsxSummary) sheet() xlsxSheet {
+	labels, counts, prefectureCodes := s.labels, s.counts, s.prefectureCodes
+
+	// 行は泉質の一覧の順（分類外の泉質は名前順、泉質未記録は最後）、列は都道府県コード順（不明は最後）
+	var springTypes []string
+	for _, springType := range entity.SpringTypes {
+		if counts[string(springType)] != nil {
+			springTypes = append(springTypes, string(springType))
+		}
+	}
+	var otherSpringTypes []string
+	for springType := range counts {
+		if !entity.SpringType(springType).IsValid() && springType != labels.noSpringType {
+			otherSpringTypes = append(otherSpringTypes, springType)
+		}
+	}
+	sort.Strings(otherSpringTypes)
+	springTypes = append(springTypes, otherSpringTypes...)
+	if counts[labels.noSpringType] != nil {
+		springTypes = append(springTypes, labels.noSpringType)
+	}
+
+	prefectures := make([]string, 0, len(prefectureCodes))
+	for prefecture := range prefectureCodes {
+		prefectures = append(prefectures, prefecture)
+	}
+	sort.Slice(prefectures, func(a, b int) bool {
+		codeA, codeB := prefectureCodes[prefectures[a]], prefectureCodes[prefectures[b]]
+		if (codeA == "") != (codeB == "") {
+			return codeB == ""
+		}
+		if codeA != codeB {
+			return codeA < codeB
+		}
+		return prefectures[a] < prefectures[b]
+	})
+
+	sheet := xlsxSheet{
+		name:   labels.summarySheet,
+		widths: make([]float64, len(prefectures)+2),
+	}
+	sheet.widths[0] = 24
+	for i := 1; i < len(sheet.widths); i++ {
+		sheet.widths[i] = 10
+	}
+
+	// ヘッダー
+	header := []xlsxCell{{text: labels.corner, style: xlsxStyleHeader}}
+	for _, prefecture := range prefectures {
+		header = append(header, xlsxCell{text: prefecture, style: xlsxStyleHeader})
+	}
+	header = append(header, xlsxCell{text: labels.total, style: xlsxStyleHeader})
+	sheet.rows = append(sheet.rows, header)
+
+	// 泉質ごとの行
+	columnTotals := make([]int, len(prefectures))
+	grandTotal := 0
+	for _, springType := range springTypes {
+		row := []xlsxCell{{text: springType, style: xlsxStyleHeader}}
+		rowTotal := 0
+		for i, prefecture := range prefectures {
+			count := counts[springType][prefecture]
+			row = append(row, xlsxNumberCell(float64(count)))
+			rowTotal += count
+			columnTotals[i] += count
+		}
+		row = append(row, xlsxNumberCell(float64(rowTotal)))
+		grandTotal += rowTotal
+		sheet.rows = append(sheet.rows, row)
+	}
+
+	// 合計の行
+	totalRow := []xlsxCell{{text: labels.total, style: xlsxStyleHeader}}
+	for _, count := range columnTotals {
+		totalRow = append(totalRow, xlsxNumberCell(float64(count)))
+	}
+	totalRow = append(totalRow, xlsxNumberCell(float64(grandTotal)))
+	sheet.rows = append(sheet.rows, totalRow)
+
+	return sheet
+}
+
+// xlsxNumberCell は数値のセルを作成します
+func xlsxNumberCell(number float64) xlsxCell {
+	return xlsxCell{number: number, isNumber: true}
+}
+
+// xlsxRatingCell は評価のセルを作成します（未評価の場合は空のセル）
+func xlsxRatingCell(rating float64) xlsxCell {
+	if rating == 0 {
+		return xlsxCell{}
+	}
+	return xlsxNumberCell(rating)
+}
+
+// xlsxDateCell は日時をExcelのシリアル値のセルにします（日時は記録されたままの時刻で表します）
+func xlsxDateCell(t time.Time, style xlsxStyle) xlsxCell {
+	if t.IsZero() {
+		return xlsxCell{}
+	}
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+	return xlsxCell{number: wall.Sub(xlsxExcelEpoch).Hours() / 24, isNumber: true, style: style}
+}
+
+// xlsxColumnName は0始まりの列番号をA・B・…・AAの列名に変換します
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// xlsxWorksheetPath はパッケージ内のワークシートのパスを返します（1始まり）
+func xlsxWorksheetPath(index int) string {
+	return "xl/worksheets/sheet" + strconv.Itoa(index) + ".xml"
+}
+
+// xlsxContentTypes はパッケージ内の各パーツの種類を定義します
+func xlsxContentTypes(sheetCount int) string {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<Types xmlns="` + xlsxContentTypeNamespace + `">`)
+	sb.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	sb.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	sb.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	sb.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheetCount; i++ {
+		sb.WriteString(`<Override PartName="/xl/worksheets/sheet` + strconv.Itoa(i) + `.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`)
+	}
+	sb.WriteString(`</Types>`)
+	return sb.String()
+}
+
+// xlsxWorkbook はシートの一覧を定義するブックを作成します
+func xlsxWorkbook(sheetNames []string) string {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<workbook xmlns="` + xlsxMainNamespace + `" xmlns:r="` + xlsxRelationshipNamespace + `"><sheets>`)
+	for i, name := range sheetNames {
+		id := strconv.Itoa(i + 1)
+		sb.WriteString(`<sheet name="` + xlsxEscape(name) + `" sheetId="` + id + `" r:id="rId` + id + `"/>`)
+	}
+	sb.WriteString(`</sheets></workbook>`)
+	return sb.String()
+}
+
+// xlsxWorkbookRels はブックからシートと書式への参照を定義します
+func xlsxWorkbookRels(sheetCount int) string {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<Relationships xmlns="` + xlsxPackageRelNamespace + `">`)
+	for i := 1; i <= sheetCount; i++ {
+		id := strconv.Itoa(i)
+		sb.WriteString(`<Relationship Id="rId` + id + `" Type="` + xlsxRelationshipNamespace + `/worksheet" Target="worksheets/sheet` + id + `.xml"/>`)
+	}
+	sb.WriteString(`<Relationship Id="rId` + strconv.Itoa(sheetCount+1) + `" Type="` + xlsxRelationshipNamespace + `/styles" Target="styles.xml"/>`)
+	sb.WriteString(`</Relationships>`)
+	return sb.String()
+}
+
+// xlsxStyles はセルの書式です（xlsxStyleの順に、標準・見出し・日付・日時・折り返し）
+var xlsxStyles = xml.Header +
+	`<styleSheet xmlns="` + xlsxMainNamespace + `">` +
+	`<numFmts count="2"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/><numFmt numFmtId="165" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="5">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0" applyAlignment="1"><alignment vertical="top" wrapText="1"/></xf>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+// xlsxWorksheetStart はワークシートの先頭から行データの開始までを作成します（見出しの行は固定表示にします）
+func xlsxWorksheetStart(widths []float64) string {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<worksheet xmlns="` + xlsxMainNamespace + `">`)
+	sb.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+
+	// 列幅
+	if len(widths) > 0 {
+		sb.WriteString(`<cols>`)
+		for i, width := range widths {
+			index := strconv.Itoa(i + 1)
+			sb.WriteString(`<col min="` + index + `" max="` + index + `" width="` + strconv.FormatFloat(width, 'f', -1, 64) + `" customWidth="1"/>`)
+		}
+		sb.WriteString(`</cols>`)
+	}
+
+	sb.WriteString(`<sheetData>`)
+	return sb.String()
+}
+
+// xlsxWorksheetEnd はワークシートの行データの終了からワークシートの末尾までです
+const xlsxWorksheetEnd = `</sheetData></worksheet>`
+
+// xlsxRow はワークシートの1行を作成します（行番号は1始まり）
+func xlsxRow(rowNumber int, cells []xlsxCell) string {
+	var sb strings.Builder
+	r := strconv.Itoa(rowNumber)
+	sb.WriteString(`<row r="` + r + `">`)
+	for c, cell := range cells {
+		if !cell.isNumber && cell.text == "" {
+			continue
+		}
+		sb.WriteString(`<c r="` + xlsxColumnName(c) + r + `"`)
+		if cell.style != xlsxStyleDefault {
+			sb.WriteString(` s="` + strconv.Itoa(int(cell.style)) + `"`)
+		}
+		if cell.isNumber {
+			sb.WriteString(`><v>` + strconv.FormatFloat(cell.number, 'f', -1, 64) + `</v></c>`)
+		} else {
+			sb.WriteString(` t="inlineStr"><is><t xml:space="preserve">` + xlsxEscape(cell.text) + `</t></is></c>`)
+		}
+	}
+	sb.WriteString(`</row>`)
+	return sb.String()
+}
+
+// xlsxEscape はXMLの特殊文字をエスケープします（XMLで使用できない文字は置換文字になります）
+// 改行はセル内の改行として残します
+func xlsxEscape(value string) string {
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(strings.ReplaceAll(value, "\r\n", "\n")))
+	return sb.String()
+}
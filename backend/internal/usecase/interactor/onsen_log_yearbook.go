@@ -0,0 +1,394 @@
+package interactor
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// 温泉アルバムのエクスポートで使用する定数
+const (
+	// yearbookMaxPhotos は訪問ごとのページに載せる写真の枚数の上限です
+	yearbookMaxPhotos = 4
+	// yearbookMaxPhotoBytes は温泉アルバムに載せる写真1枚のファイルサイズの上限です（超える写真は載せません）
+	yearbookMaxPhotoBytes = 10 << 20
+	// yearbookDateLayout は温泉アルバムに表示する日付のレイアウトです
+	yearbookDateLayout = "2006年1月2日"
+)
+
+// yearbookTemplateSource は温泉アルバムのHTMLのテンプレートの定義です
+//
+//go:embed templates/yearbook.html
+var yearbookTemplateSource string
+
+// yearbookTemplate は温泉アルバムのHTMLのテンプレートです
+// begin（表紙）・visit（訪問ごとのページ）・summary（年ごとのまとめのページ）・endの順に実行して書き出します
+var yearbookTemplate = template.Must(template.New("yearbook").Funcs(template.FuncMap{
+	"photoURI": yearbookPhotoURI,
+}).Parse(yearbookTemplateSource))
+
+// yearbookCover は温泉アルバムの表紙の内容です
+type yearbookCover struct {
+	Title       string
+	Period      string
+	VisitCount  int
+	GeneratedAt string
+}
+
+// yearbookVisit は温泉アルバムの訪問ごとのページの内容です
+type yearbookVisit struct {
+	Number      int
+	Name        string
+	Location    string
+	VisitDate   string
+	SpringTypes string
+	Stars       string
+	Rating      string
+	Comment     string
+	Photos      []yearbookPhoto
+}
+
+// yearbookPhoto は温泉アルバムに載せる写真です（ContentTypeはファイルの内容から判定した形式です）
+type yearbookPhoto struct {
+	Data        []byte
+	ContentType string
+	Description string
+}
+
+// yearbookSummary は温泉アルバムの年ごとのまとめのページの内容です
+// 棒グラフの割合（Percent）は項目の中で最も多い件数を100とした値です
+type yearbookSummary struct {
+	Title       string
+	Figures     []yearbookFigure
+	Months      []yearbookBar
+	SpringTypes []yearbookBar
+	Prefectures []yearbookBar
+	Highlights  []yearbookHighlight
+}
+
+// yearbookFigure はまとめのページに表示する集計値です
+type yearbookFigure struct {
+	Label string
+	Value string
+}
+
+// yearbookBar はまとめのページの棒グラフの1本です
+type yearbookBar struct {
+	Label   string
+	Count   int
+	Percent int
+}
+
+// yearbookHighlight はまとめのページに表示する評価の高い温泉メモです
+type yearbookHighlight struct {
+	Name      string
+	VisitDate string
+	Stars     string
+}
+
+// yearbookRenderer は温泉アルバムをページごとに書き出すレンダラーです
+// Begin・Visit（訪問の件数分）・Summary（年の数分）・Endの順に呼び出します
+type yearbookRenderer interface {
+	// Begin はファイルの先頭と表紙を書き出します
+	Begin(cover yearbookCover) error
+
+	// Visit は訪問ごとのページを書き出します
+	Visit(visit yearbookVisit) error
+
+	// Summary は年ごとのまとめのページを書き出します
+	Summary(summary yearbookSummary) error
+
+	// End はファイルの末尾を書き出します
+	End() error
+}
+
+// newYearbookRenderer はフォーマットに応じたレンダラーを作成します
+func newYearbookRenderer(w io.Writer, format string, title string) (yearbookRenderer, error) {
+	switch strings.ToLower(format) {
+	case "html":
+		return &htmlYearbookRenderer{w: w}, nil
+	case "pdf":
+		return newPDFYearbookRenderer(w, title), nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// ExportYearbook は温泉メモを訪問ごとのページと年ごとのまとめのページからなる温泉アルバムとしてwに書き出します
+// 訪問は古い順に並べ、写真は訪問ごとにストレージから読み取りながら書き出します
+func (i *OnsenLogInteractor) ExportYearbook(ctx context.Context, input port.ExportYearbookInput, w io.Writer) error {
+	// ドメインサービスを呼び出し
+	onsenLogs, err := i.onsenLogService.GetYearbookOnsenLogs(ctx, input.UserID, input.Year)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	// 温泉アルバムを書き出し
+	if err := i.renderYearbook(ctx, input, onsenLogs, w); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	// 出力ポートを呼び出し
+	return i.outputPort.PresentExportedData(ctx, input.Format, len(onsenLogs))
+}
+
+// renderYearbook は温泉メモを表紙・訪問ごとのページ・年ごとのまとめのページの順に書き出します
+func (i *OnsenLogInteractor) renderYearbook(ctx context.Context, input port.ExportYearbookInput, onsenLogs []*entity.OnsenLog, w io.Writer) error {
+	cover := newYearbookCover(input.Year, onsenLogs)
+	renderer, err := newYearbookRenderer(w, input.Format, cover.Title)
+	if err != nil {
+		return err
+	}
+
+	// 表紙
+	if err := renderer.Begin(cover); err != nil {
+		return err
+	}
+
+	// 訪問ごとのページ
+	for index, onsenLog := range onsenLogs {
+		photos, err := i.loadYearbookPhotos(ctx, onsenLog)
+		if err != nil {
+			return err
+		}
+		if err := renderer.Visit(newYearbookVisit(index+1, onsenLog, photos)); err != nil {
+			return err
+		}
+	}
+
+	// 年ごとのまとめのページ
+	for _, stats := range entity.NewYearbookStats(onsenLogs) {
+		if err := renderer.Summary(newYearbookSummary(stats)); err != nil {
+			return err
+		}
+	}
+
+	return renderer.End()
+}
+
+// loadYearbookPhotos は温泉メモの写真を上限の枚数までストレージから読み取ります
+// 読み取れない写真、サイズが上限を超える写真、画像として判定できない写真は載せずにログに記録します
+func (i *OnsenLogInteractor) loadYearbookPhotos(ctx context.Context, onsenLog *entity.OnsenLog) ([]yearbookPhoto, error) {
+	images, err := i.onsenImageService.GetImagesByOnsenLog(ctx, onsenLog)
+	if err != nil {
+		return nil, err
+	}
+
+	var photos []yearbookPhoto
+	for _, image := range images {
+		if len(photos) == yearbookMaxPhotos {
+			break
+		}
+
+		data, err := i.readYearbookPhoto(ctx, image)
+		if err != nil {
+			log.Printf("Failed to read yearbook photo %s: %v", image.UUID, err)
+			continue
+		}
+		contentType := detectYearbookPhotoType(data)
+		if contentType == "" {
+			log.Printf("Skipped yearbook photo %s: unsupported image format", image.UUID)
+			continue
+		}
+
+		photos = append(photos, yearbookPhoto{
+			Data:        data,
+			ContentType: contentType,
+			Description: image.Description,
+		})
+	}
+	return photos, nil
+}
+
+// readYearbookPhoto はストレージから写真のファイルを読み取ります（サイズが上限を超える場合はエラーを返します）
+func (i *OnsenLogInteractor) readYearbookPhoto(ctx context.Context, image *entity.OnsenImage) ([]byte, error) {
+	reader, err := i.onsenImageService.OpenImageFile(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, yearbookMaxPhotoBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > yearbookMaxPhotoBytes {
+		return nil, fmt.Errorf("photo exceeds %d bytes", yearbookMaxPhotoBytes)
+	}
+	return data, nil
+}
+
+// detectYearbookPhotoType はファイルの先頭のバイト列から画像の形式を判定します（判定できない場合は空文字）
+func detectYearbookPhotoType(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("\xff\xd8\xff")):
+		return "image/jpeg"
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png"
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return "image/gif"
+	case len(data) >= 12 && bytes.HasPrefix(data, []byte("RIFF")) && string(data[8:12]) == "WEBP":
+		return "image/webp"
+	}
+	return ""
+}
+
+// newYearbookCover は温泉アルバムの表紙の内容を作成します（温泉メモは訪問日の古い順です）
+func newYearbookCover(year int, onsenLogs []*entity.OnsenLog) yearbookCover {
+	title := "温泉アルバム"
+	if year != 0 {
+		title = strconv.Itoa(year) + "年の温泉アルバム"
+	}
+	return yearbookCover{
+		Title:       title,
+		Period:      yearbookPeriod(onsenLogs[0].VisitDate, onsenLogs[len(onsenLogs)-1].VisitDate),
+		VisitCount:  len(onsenLogs),
+		GeneratedAt: time.Now().Format(yearbookDateLayout),
+	}
+}
+
+// newYearbookVisit は温泉メモから訪問ごとのページの内容を作成します
+func newYearbookVisit(number int, onsenLog *entity.OnsenLog, photos []yearbookPhoto) yearbookVisit {
+	location := onsenLog.Location
+	if location == "" {
+		location = onsenLog.Area.Prefecture + onsenLog.Area.Municipality
+	}
+
+	springTypes := make([]string, len(onsenLog.SpringTypes))
+	for index, springType := range onsenLog.SpringTypes {
+		springTypes[index] = string(springType)
+	}
+
+	visit := yearbookVisit{
+		Number:      number,
+		Name:        onsenLog.Name,
+		Location:    location,
+		VisitDate:   onsenLog.VisitDate.Format(yearbookDateLayout),
+		SpringTypes: strings.Join(springTypes, "・"),
+		Comment:     strings.TrimSpace(onsenLog.Comment),
+		Photos:      photos,
+	}
+	if onsenLog.Rating > 0 {
+		visit.Stars = ratingStars(onsenLog.Rating)
+		visit.Rating = formatRating(onsenLog.Rating)
+	}
+	return visit
+}
+
+// newYearbookSummary は年ごとの集計からまとめのページの内容を作成します
+func newYearbookSummary(stats entity.YearbookYearStats) yearbookSummary {
+	summary := yearbookSummary{
+		Title: strconv.Itoa(stats.Year) + "年のまとめ",
+		Figures: []yearbookFigure{
+			{Label: "訪問回数", Value: strconv.Itoa(stats.VisitCount) + "回"},
+			{Label: "訪れた温泉", Value: strconv.Itoa(stats.OnsenCount) + "か所"},
+			{Label: "訪れた都道府県", Value: strconv.Itoa(stats.PrefectureCount) + "都道府県"},
+			{Label: "期間", Value: yearbookPeriod(stats.FirstVisit, stats.LastVisit)},
+		},
+	}
+	if stats.AverageRating > 0 {
+		summary.Figures = append(summary.Figures, yearbookFigure{
+			Label: "平均評価",
+			Value: ratingStars(stats.AverageRating) + " " + formatRating(stats.AverageRating),
+		})
+	}
+	for _, spending := range stats.Spending {
+		summary.Figures = append(summary.Figures, yearbookFigure{
+			Label: "費用（" + spending.Currency + "）",
+			Value: formatAmount(spending.Total),
+		})
+	}
+
+	// 月ごとの訪問回数
+	months := make([]entity.StatsCount, len(stats.MonthlyCounts))
+	for index, count := range stats.MonthlyCounts {
+		months[index] = entity.StatsCount{Key: strconv.Itoa(index+1) + "月", Count: count}
+	}
+	summary.Months = newYearbookBars(months)
+
+	// 泉質と都道府県のランキング
+	summary.SpringTypes = newYearbookBars(stats.BySpringType)
+	summary.Prefectures = newYearbookBars(stats.ByPrefecture)
+
+	// 評価の高い温泉
+	for _, highlight := range stats.Highlights {
+		summary.Highlights = append(summary.Highlights, yearbookHighlight{
+			Name:      highlight.Name,
+			VisitDate: highlight.VisitDate.Format(yearbookDateLayout),
+			Stars:     ratingStars(highlight.Rating),
+		})
+	}
+
+	return summary
+}
+
+// newYearbookBars は集計キーごとの件数を棒グラフに変換します
+func newYearbookBars(counts []entity.StatsCount) []yearbookBar {
+	maxCount := 0
+	for _, count := range counts {
+		if count.Count > maxCount {
+			maxCount = count.Count
+		}
+	}
+
+	bars := make([]yearbookBar, len(counts))
+	for index, count := range counts {
+		bars[index] = yearbookBar{Label: count.Key, Count: count.Count}
+		if maxCount > 0 {
+			bars[index].Percent = count.Count * 100 / maxCount
+		}
+	}
+	return bars
+}
+
+// yearbookPeriod は最初と最後の訪問日から期間の表示を作成します（同じ日の場合は1日のみ）
+func yearbookPeriod(first, last time.Time) string {
+	if first.Format("2006-01-02") == last.Format("2006-01-02") {
+		return first.Format(yearbookDateLayout)
+	}
+	return first.Format(yearbookDateLayout) + "〜" + last.Format(yearbookDateLayout)
+}
+
+// htmlYearbookRenderer は温泉アルバムを印刷用のHTMLとして書き出すレンダラーです
+// 写真はdata URIとして埋め込み、1つのファイルで閲覧・印刷できるようにします
+type htmlYearbookRenderer struct {
+	w io.Writer
+}
+
+// Begin はHTMLの先頭と表紙を書き出します
+func (r *htmlYearbookRenderer) Begin(cover yearbookCover) error {
+	return yearbookTemplate.ExecuteTemplate(r.w, "begin", cover)
+}
+
+// Visit は訪問ごとのページを書き出します
+func (r *htmlYearbookRenderer) Visit(visit yearbookVisit) error {
+	return yearbookTemplate.ExecuteTemplate(r.w, "visit", visit)
+}
+
+// Summary は年ごとのまとめのページを書き出します
+func (r *htmlYearbookRenderer) Summary(summary yearbookSummary) error {
+	return yearbookTemplate.ExecuteTemplate(r.w, "summary", summary)
+}
+
+// End はHTMLの末尾を書き出します
+func (r *htmlYearbookRenderer) End() error {
+	return yearbookTemplate.ExecuteTemplate(r.w, "end", nil)
+}
+
+// yearbookPhotoURI は写真をimg要素のsrcに指定できるdata URIに変換します
+func yearbookPhotoURI(photo yearbookPhoto) template.URL {
+	return template.URL("data:" + photo.ContentType + ";base64," + base64.StdEncoding.EncodeToString(photo.Data))
+}
@@ -0,0 +1,649 @@
+package interactor
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"log"
+	"math"
+	"strings"
+	"time"
+)
+
+// PDFの温泉アルバムで使用する定数（座標と長さの単位はポイント）
+const (
+	pdfPageWidth       = 595.28
+	pdfPageHeight      = 841.89
+	pdfMargin          = 48.0
+	pdfContentWidth    = pdfPageWidth - pdfMargin*2
+	pdfPhotoAreaHeight = 340.0
+	pdfPhotoGap        = 8.0
+	// pdfFontName はページの文字に使用するPDFビューアー内蔵の日本語フォントです（フォントは埋め込みません）
+	pdfFontName = "HeiseiKakuGo-W5"
+	// pdfFontEncoding はUCS-2の文字コードをCIDに変換するCMapです（ASCIIは半角の字形を使用します）
+	pdfFontEncoding = "UniJIS-UCS2-HW-H"
+	// pdfMaxImageSide は再圧縮する写真の長辺の上限（ピクセル）です（超える写真は縮小します）
+	pdfMaxImageSide = 1600
+)
+
+// PDFの固定のオブジェクト番号（ページや写真のオブジェクトは以降の番号を順に使用します）
+const (
+	pdfCatalogObject = iota + 1
+	pdfPagesObject
+	pdfFontObject
+	pdfCIDFontObject
+	pdfFontDescriptorObject
+	pdfFirstFreeObject
+)
+
+// PDFのページで使用する色（RGB）
+var (
+	pdfColorText   = [3]float64{0.2, 0.2, 0.2}
+	pdfColorMuted  = [3]float64{0.53, 0.53, 0.53}
+	pdfColorAccent = [3]float64{0.75, 0.35, 0.24}
+	pdfColorStar   = [3]float64{0.88, 0.63, 0.13}
+	pdfColorPaper  = [3]float64{0.98, 0.97, 0.95}
+)
+
+// pdfYearbookRenderer は温泉アルバムをA4のPDFとして書き出すレンダラーです
+// ページは書き出した順にオブジェクトとして出力し、ページツリーと相互参照表は最後に書き出します
+// 文字はビューアー内蔵の日本語フォントで表示し、写真はJPEGをそのまま、それ以外の形式は再圧縮して埋め込みます
+type pdfYearbookRenderer struct {
+	w       *pdfOutput
+	title   string
+	offsets []int64
+	pages   []int
+}
+
+// newPDFYearbookRenderer は新しいPDFの温泉アルバムのレンダラーを作成します
+func newPDFYearbookRenderer(w io.Writer, title string) *pdfYearbookRenderer {
+	return &pdfYearbookRenderer{
+		w:       &pdfOutput{w: w},
+		title:   title,
+		offsets: make([]int64, pdfFirstFreeObject),
+	}
+}
+
+// pdfOutput は書き出したバイト数（オブジェクトの位置）を数えるライターです
+type pdfOutput struct {
+	w      io.Writer
+	offset int64
+}
+
+// Write はデータを書き出し、書き出したバイト数を加算します
+func (o *pdfOutput) Write(p []byte) (int, error) {
+	n, err := o.w.Write(p)
+	o.offset += int64(n)
+	return n, err
+}
+
+// Begin はPDFのヘッダーとフォントを書き出し、表紙のページを書き出します
+func (r *pdfYearbookRenderer) Begin(cover yearbookCover) error {
+	if _, err := io.WriteString(r.w, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n"); err != nil {
+		return err
+	}
+
+	// フォント
+	if err := r.writeObject(pdfFontObject, fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s-%s /Encoding /%s /DescendantFonts [%d 0 R] >>",
+		pdfFontName, pdfFontEncoding, pdfFontEncoding, pdfCIDFontObject)); err != nil {
+		return err
+	}
+	if err := r.writeObject(pdfCIDFontObject, fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Japan1) /Supplement 2 >> /FontDescriptor %d 0 R /DW 1000 /W [231 325 500 327 389 500] >>",
+		pdfFontName, pdfFontDescriptorObject)); err != nil {
+		return err
+	}
+	if err := r.writeObject(pdfFontDescriptorObject, fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [-92 -250 1010 922] /ItalicAngle 0 /Ascent 752 /Descent -221 /CapHeight 737 /StemV 114 >>",
+		pdfFontName)); err != nil {
+		return err
+	}
+
+	// 表紙
+	page := &pdfPage{}
+	page.textCenter(300, 28, pdfColorText, cover.Title)
+	page.fillRect(pdfPageWidth/2-60, 350, 120, 2, pdfColorAccent)
+	page.textCenter(378, 13, pdfColorText, cover.Period)
+	page.textCenter(402, 13, pdfColorText, fmt.Sprintf("%d回の温泉めぐり", cover.VisitCount))
+	page.textCenter(470, 9, pdfColorMuted, cover.GeneratedAt+" 作成")
+	return r.writePage(page)
+}
+
+// Visit は写真・温泉名・泉質・評価・感想を載せた訪問ごとのページを書き出します
+// 埋め込めない形式の写真は載せずにログに記録し、ページに収まらない感想は末尾を省略します
+func (r *pdfYearbookRenderer) Visit(visit yearbookVisit) error {
+	page := &pdfPage{}
+
+	// 訪問日と番号
+	y := pdfMargin
+	page.text(pdfMargin, y, 10, pdfColorMuted, visit.VisitDate)
+	number := fmt.Sprintf("No.%d", visit.Number)
+	page.text(pdfPageWidth-pdfMargin-pdfTextWidth(number, 10), y, 10, pdfColorMuted, number)
+	y += 16
+	page.fillRect(pdfMargin, y, pdfContentWidth, 1.5, pdfColorAccent)
+	y += 12
+
+	// 温泉名と所在地
+	for _, line := range pdfWrapLines(visit.Name, 20, pdfContentWidth, 2) {
+		page.text(pdfMargin, y, 20, pdfColorText, line)
+		y += 26
+	}
+	if visit.Location != "" {
+		page.text(pdfMargin, y, 11, pdfColorMuted, pdfTruncate(visit.Location, 11, pdfContentWidth))
+		y += 18
+	}
+
+	// 写真
+	var images []*pdfImage
+	for _, photo := range visit.Photos {
+		img, err := newPDFImage(photo)
+		if err != nil {
+			log.Printf("Skipped yearbook photo in PDF: %v", err)
+			continue
+		}
+		images = append(images, img)
+	}
+	if len(images) > 0 {
+		y += 6
+		if err := r.drawPhotos(page, images, y); err != nil {
+			return err
+		}
+		y += pdfPhotoAreaHeight + 14
+	}
+
+	// 泉質と評価
+	if visit.SpringTypes != "" {
+		lines := pdfWrapLines(visit.SpringTypes, 11, pdfContentWidth-50, 2)
+		page.text(pdfMargin, y, 11, pdfColorMuted, "泉質")
+		for _, line := range lines {
+			page.text(pdfMargin+50, y, 11, pdfColorText, line)
+			y += 17
+		}
+	}
+	if visit.Stars != "" {
+		page.text(pdfMargin, y, 11, pdfColorMuted, "評価")
+		page.text(pdfMargin+50, y, 11, pdfColorStar, visit.Stars)
+		page.text(pdfMargin+50+pdfTextWidth(visit.Stars, 11)+6, y, 11, pdfColorText, visit.Rating)
+		y += 17
+	}
+
+	// 感想（ページの残りに収まる行数まで）
+	if visit.Comment != "" {
+		y += 8
+		const lineHeight = 17.0
+		maxLines := int((pdfPageHeight - pdfMargin - y - 16) / lineHeight)
+		if maxLines > 0 {
+			lines := pdfWrapLines(visit.Comment, 11, pdfContentWidth-24, maxLines)
+			page.fillRect(pdfMargin, y, pdfContentWidth, float64(len(lines))*lineHeight+16, pdfColorPaper)
+			page.fillRect(pdfMargin, y, 3, float64(len(lines))*lineHeight+16, pdfColorAccent)
+			y += 8
+			for _, line := range lines {
+				page.text(pdfMargin+14, y, 11, pdfColorText, line)
+				y += lineHeight
+			}
+		}
+	}
+
+	return r.writePage(page)
+}
+
+// drawPhotos は写真を書き出し、ページの写真の領域に並べます（1枚は全幅、2枚以上は2列）
+// 写真は縦横比を保ったまま枠の中央に配置します
+func (r *pdfYearbookRenderer) drawPhotos(page *pdfPage, images []*pdfImage, top float64) error {
+	columns, rows := 1, 1
+	if len(images) > 1 {
+		columns = 2
+	}
+	if len(images) > 2 {
+		rows = 2
+	}
+	cellWidth := (pdfContentWidth - pdfPhotoGap*float64(columns-1)) / float64(columns)
+	cellHeight := (pdfPhotoAreaHeight - pdfPhotoGap*float64(rows-1)) / float64(rows)
+
+	for index, img := range images {
+		id, err := r.writeImage(img)
+		if err != nil {
+			return err
+		}
+
+		scale := math.Min(cellWidth/float64(img.width), cellHeight/float64(img.height))
+		width, height := float64(img.width)*scale, float64(img.height)*scale
+		x := pdfMargin + float64(index%columns)*(cellWidth+pdfPhotoGap) + (cellWidth-width)/2
+		y := top + float64(index/columns)*(cellHeight+pdfPhotoGap) + (cellHeight-height)/2
+		page.image(id, x, y, width, height)
+	}
+	return nil
+}
+
+// Summary は集計値と月ごと・泉質・都道府県の棒グラフ、評価の高い温泉を載せた年ごとのまとめのページを書き出します
+func (r *pdfYearbookRenderer) Summary(summary yearbookSummary) error {
+	page := &pdfPage{}
+
+	// 見出し
+	y := pdfMargin
+	page.text(pdfMargin, y, 18, pdfColorText, summary.Title)
+	y += 26
+	page.fillRect(pdfMargin, y, pdfContentWidth, 1.5, pdfColorAccent)
+	y += 14
+
+	// 集計値（3列）
+	const figureGap, figureHeight = 8.0, 42.0
+	figureWidth := (pdfContentWidth - figureGap*2) / 3
+	for index, figure := range summary.Figures {
+		x := pdfMargin + float64(index%3)*(figureWidth+figureGap)
+		top := y + float64(index/3)*(figureHeight+figureGap)
+		page.fillRect(x, top, figureWidth, figureHeight, pdfColorPaper)
+		page.text(x+8, top+7, 9, pdfColorMuted, figure.Label)
+		page.text(x+8, top+21, 12, pdfColorText, pdfTruncate(figure.Value, 12, figureWidth-16))
+	}
+	y += float64((len(summary.Figures)+2)/3)*(figureHeight+figureGap) + 8
+
+	// 月ごとの訪問回数
+	page.text(pdfMargin, y, 12, pdfColorText, "月ごとの訪問回数")
+	y += 24
+	const chartHeight, barGap = 80.0, 6.0
+	barWidth := (pdfContentWidth - barGap*11) / 12
+	for index, bar := range summary.Months {
+		x := pdfMargin + float64(index)*(barWidth+barGap)
+		height := chartHeight * float64(bar.Percent) / 100
+		count := fmt.Sprintf("%d", bar.Count)
+		page.text(x+(barWidth-pdfTextWidth(count, 8))/2, y+chartHeight-height-11, 8, pdfColorMuted, count)
+		if height > 0 {
+			page.fillRect(x, y+chartHeight-height, barWidth, height, pdfColorAccent)
+		}
+		page.text(x+(barWidth-pdfTextWidth(bar.Label, 8))/2, y+chartHeight+4, 8, pdfColorText, bar.Label)
+	}
+	y += chartHeight + 26
+
+	// 泉質と都道府県のランキング
+	y = drawPDFRanking(page, y, "よく入った泉質", summary.SpringTypes)
+	y = drawPDFRanking(page, y, "よく訪れた都道府県", summary.Prefectures)
+
+	// 評価の高い温泉
+	if len(summary.Highlights) > 0 && y+24 < pdfPageHeight-pdfMargin {
+		page.text(pdfMargin, y, 12, pdfColorText, "評価の高かった温泉")
+		y += 22
+		for index, highlight := range summary.Highlights {
+			if y+14 > pdfPageHeight-pdfMargin {
+				break
+			}
+			starsX := pdfPageWidth - pdfMargin - pdfTextWidth(highlight.Stars, 10)
+			line := fmt.Sprintf("%d. %s（%s）", index+1, highlight.Name, highlight.VisitDate)
+			page.text(pdfMargin, y, 10, pdfColorText, pdfTruncate(line, 10, starsX-pdfMargin-8))
+			page.text(starsX, y, 10, pdfColorStar, highlight.Stars)
+			y += 18
+		}
+	}
+
+	return r.writePage(page)
+}
+
+// drawPDFRanking はランキングの見出しと横棒グラフを描画し、次に描画する位置を返します（項目がない場合は何も描画しません）
+func drawPDFRanking(page *pdfPage, y float64, title string, bars []yearbookBar) float64 {
+	if len(bars) == 0 || y+24 > pdfPageHeight-pdfMargin {
+		return y
+	}
+
+	page.text(pdfMargin, y, 12, pdfColorText, title)
+	y += 22
+	const labelWidth, countWidth = 130.0, 40.0
+	barWidth := pdfContentWidth - labelWidth - countWidth
+	for _, bar := range bars {
+		if y+14 > pdfPageHeight-pdfMargin {
+			break
+		}
+		page.text(pdfMargin, y, 10, pdfColorText, pdfTruncate(bar.Label, 10, labelWidth-8))
+		if width := barWidth * float64(bar.Percent) / 100; width > 0 {
+			page.fillRect(pdfMargin+labelWidth, y+1, width, 9, pdfColorStar)
+		}
+		count := fmt.Sprintf("%d回", bar.Count)
+		page.text(pdfPageWidth-pdfMargin-pdfTextWidth(count, 10), y, 10, pdfColorText, count)
+		y += 18
+	}
+	return y + 8
+}
+
+// End はページツリー・カタログ・文書情報と相互参照表を書き出します
+func (r *pdfYearbookRenderer) End() error {
+	// ページツリー
+	kids := make([]string, len(r.pages))
+	for index, id := range r.pages {
+		kids[index] = fmt.Sprintf("%d 0 R", id)
+	}
+	if err := r.writeObject(pdfPagesObject, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(r.pages))); err != nil {
+		return err
+	}
+
+	// カタログと文書情報
+	if err := r.writeObject(pdfCatalogObject, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pdfPagesObject)); err != nil {
+		return err
+	}
+	info := r.newObject()
+	if err := r.writeObject(info, fmt.Sprintf("<< /Title <FEFF%s> /Producer (%s) /CreationDate (D:%s) >>",
+		pdfUTF16Hex(r.title), mapExportApp, time.Now().UTC().Format("20060102150405Z"))); err != nil {
+		return err
+	}
+
+	// 相互参照表とトレーラー
+	xref := r.w.offset
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "xref\n0 %d\n0000000000 65535 f \n", len(r.offsets))
+	for _, offset := range r.offsets[1:] {
+		fmt.Fprintf(&sb, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&sb, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(r.offsets), pdfCatalogObject, info, xref)
+	_, err := io.WriteString(r.w, sb.String())
+	return err
+}
+
+// newObject は新しいオブジェクト番号を割り当てます
+func (r *pdfYearbookRenderer) newObject() int {
+	r.offsets = append(r.offsets, 0)
+	return len(r.offsets) - 1
+}
+
+// writeObject はオブジェクトを書き出し、位置を記録します
+func (r *pdfYearbookRenderer) writeObject(id int, body string) error {
+	r.offsets[id] = r.w.offset
+	_, err := fmt.Fprintf(r.w, "%d 0 obj\n%s\nendobj\n", id, body)
+	return err
+}
+
+// writeStream はストリームのオブジェクトを書き出し、位置を記録します（dictにはLength以外の項目を指定します）
+func (r *pdfYearbookRenderer) writeStream(id int, dict string, data []byte) error {
+	r.offsets[id] = r.w.offset
+	if _, err := fmt.Fprintf(r.w, "%d 0 obj\n<< %s /Length %d >>\nstream\n", id, dict, len(data)); err != nil {
+		return err
+	}
+	if _, err := r.w.Write(data); err != nil {
+		return err
+	}
+	_, err := io.WriteString(r.w, "\nendstream\nendobj\n")
+	return err
+}
+
+// writeImage は写真を画像のオブジェクトとして書き出し、オブジェクト番号を返します
+func (r *pdfYearbookRenderer) writeImage(img *pdfImage) (int, error) {
+	id := r.newObject()
+	dict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /%s /BitsPerComponent 8 /Filter /%s",
+		img.width, img.height, img.colorSpace, img.filter)
+	return id, r.writeStream(id, dict, img.data)
+}
+
+// writePage はページの内容をFlate圧縮して書き出し、ページのオブジェクトを書き出します
+func (r *pdfYearbookRenderer) writePage(page *pdfPage) error {
+	content, err := pdfDeflate(page.content.Bytes())
+	if err != nil {
+		return err
+	}
+	contentID := r.newObject()
+	if err := r.writeStream(contentID, "/Filter /FlateDecode", content); err != nil {
+		return err
+	}
+
+	var xObjects strings.Builder
+	for _, id := range page.images {
+		fmt.Fprintf(&xObjects, " /Im%d %d 0 R", id, id)
+	}
+	pageID := r.newObject()
+	r.pages = append(r.pages, pageID)
+	return r.writeObject(pageID, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 %d 0 R >> /XObject <<%s >> >> /Contents %d 0 R >>",
+		pdfPagesObject, pdfPageWidth, pdfPageHeight, pdfFontObject, xObjects.String(), contentID))
+}
+
+// pdfPage はPDFの1ページ分の描画内容です
+// 座標はページの左上を原点とし、下に向かって増える値で指定します（PDFの座標には書き出し時に変換します）
+type pdfPage struct {
+	content bytes.Buffer
+	images  []int
+}
+
+// text は文字の上端をyにして1行の文字列を描画します
+func (p *pdfPage) text(x, y, size float64, rgb [3]float64, s string) {
+	if s == "" {
+		return
+	}
+	baseline := pdfPageHeight - y - size*0.88
+	fmt.Fprintf(&p.content, "%.3f %.3f %.3f rg BT /F1 %.2f Tf %.2f %.2f Td <%s> Tj ET\n",
+		rgb[0], rgb[1], rgb[2], size, x, baseline, pdfUCS2Hex(s))
+}
+
+// textCenter はページの中央に揃えて1行の文字列を描画します
+func (p *pdfPage) textCenter(y, size float64, rgb [3]float64, s string) {
+	s = pdfTruncate(s, size, pdfContentWidth)
+	p.text((pdfPageWidth-pdfTextWidth(s, size))/2, y, size, rgb, s)
+}
+
+// fillRect は塗りつぶした長方形を描画します
+func (p *pdfPage) fillRect(x, y, width, height float64, rgb [3]float64) {
+	fmt.Fprintf(&p.content, "%.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f\n",
+		rgb[0], rgb[1], rgb[2], x, pdfPageHeight-y-height, width, height)
+}
+
+// image は書き出し済みの画像のオブジェクトを長方形に合わせて描画します
+func (p *pdfPage) image(id int, x, y, width, height float64) {
+	p.images = append(p.images, id)
+	fmt.Fprintf(&p.content, "q %.2f 0 0 %.2f %.2f %.2f cm /Im%d Do Q\n", width, height, x, pdfPageHeight-y-height, id)
+}
+
+// pdfImage はPDFに埋め込む画像のデータです
+type pdfImage struct {
+	width      int
+	height     int
+	colorSpace string
+	filter     string
+	data       []byte
+}
+
+// newPDFImage は写真をPDFに埋め込める画像のデータに変換します
+// RGBとグレースケールのJPEGはそのまま埋め込み、それ以外（CMYKのJPEG・PNG・GIF）はRGBに変換してFlate圧縮します
+func newPDFImage(photo yearbookPhoto) (*pdfImage, error) {
+	if photo.ContentType == "image/jpeg" {
+		config, err := jpeg.DecodeConfig(bytes.NewReader(photo.Data))
+		if err != nil {
+			return nil, err
+		}
+		switch config.ColorModel {
+		case color.YCbCrModel:
+			return &pdfImage{width: config.Width, height: config.Height, colorSpace: "DeviceRGB", filter: "DCTDecode", data: photo.Data}, nil
+		case color.GrayModel:
+			return &pdfImage{width: config.Width, height: config.Height, colorSpace: "DeviceGray", filter: "DCTDecode", data: photo.Data}, nil
+		}
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(photo.Data))
+	if err != nil {
+		return nil, err
+	}
+	return newPDFImageFromDecoded(decoded)
+}
+
+// newPDFImageFromDecoded はデコードした画像をRGBに変換してFlate圧縮します
+// 長辺が上限を超える画像は縮小し、透明な部分は白で塗りつぶします
+func newPDFImageFromDecoded(img image.Image) (*pdfImage, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, errors.New("empty image")
+	}
+	scale := 1.0
+	if longest := math.Max(float64(width), float64(height)); longest > pdfMaxImageSide {
+		scale = pdfMaxImageSide / longest
+	}
+	outWidth := int(math.Max(1, math.Round(float64(width)*scale)))
+	outHeight := int(math.Max(1, math.Round(float64(height)*scale)))
+
+	pixels := make([]byte, 0, outWidth*outHeight*3)
+	for y := 0; y < outHeight; y++ {
+		sourceY := bounds.Min.Y + int(float64(y)/scale)
+		for x := 0; x < outWidth; x++ {
+			sourceX := bounds.Min.X + int(float64(x)/scale)
+			c := color.NRGBAModel.Convert(img.At(sourceX, sourceY)).(color.NRGBA)
+			alpha := uint32(c.A)
+			pixels = append(pixels,
+				byte((uint32(c.R)*alpha+255*(255-alpha))/255),
+				byte((uint32(c.G)*alpha+255*(255-alpha))/255),
+				byte((uint32(c.B)*alpha+255*(255-alpha))/255),
+			)
+		}
+	}
+
+	data, err := pdfDeflate(pixels)
+	if err != nil {
+		return nil, err
+	}
+	return &pdfImage{width: outWidth, height: outHeight, colorSpace: "DeviceRGB", filter: "FlateDecode", data: data}, nil
+}
+
+// pdfDeflate はデータをFlate（zlib）で圧縮します
+func pdfDeflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := zlib.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// pdfRune はPDFに描画する文字を返します
+// タブは空白に置き換え、UCS-2で表せない文字（絵文字など）は「?」に置き換え、その他の制御文字は描画しません（falseを返します）
+func pdfRune(r rune) (rune, bool) {
+	switch {
+	case r == '\t':
+		return ' ', true
+	case r < 0x20 || r == 0x7f:
+		return 0, false
+	case r > 0xffff || (r >= 0xd800 && r <= 0xdfff):
+		return '?', true
+	}
+	return r, true
+}
+
+// pdfRuneWidth は文字の幅を文字サイズに対する比率で返します（ASCIIと半角カナは半角、それ以外は全角）
+// フォントの幅の指定（W）と同じ値を使用します
+func pdfRuneWidth(r rune) float64 {
+	if r < 0x80 || (r >= 0xff61 && r <= 0xff9f) {
+		return 0.5
+	}
+	return 1
+}
+
+// pdfTextWidth は文字列を描画したときの幅を返します
+func pdfTextWidth(s string, size float64) float64 {
+	width := 0.0
+	for _, r := range s {
+		if r, ok := pdfRune(r); ok {
+			width += pdfRuneWidth(r)
+		}
+	}
+	return width * size
+}
+
+// pdfUCS2Hex は文字列をUCS-2（ビッグエンディアン）の16進数の文字列に変換します
+func pdfUCS2Hex(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r, ok := pdfRune(r); ok {
+			fmt.Fprintf(&sb, "%04X", r)
+		}
+	}
+	return sb.String()
+}
+
+// pdfUTF16Hex は文字列をUTF-16（ビッグエンディアン）の16進数の文字列に変換します（文書情報で使用します）
+func pdfUTF16Hex(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r > 0xffff {
+			r -= 0x10000
+			fmt.Fprintf(&sb, "%04X%04X", 0xd800+(r>>10), 0xdc00+(r&0x3ff))
+			continue
+		}
+		fmt.Fprintf(&sb, "%04X", r)
+	}
+	return sb.String()
+}
+
+// pdfWrapLines は文字列を幅に収まる行に折り返します
+// 改行はそのまま行の区切りとし、英数字の単語は可能な限り空白の位置で折り返します
+// 行数がmaxLinesを超える場合は最後の行の末尾を「…」にして省略します
+func pdfWrapLines(s string, size, width float64, maxLines int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		line := []rune{}
+		lineWidth := 0.0
+		for _, r := range paragraph {
+			r, ok := pdfRune(r)
+			if !ok {
+				continue
+			}
+			runeWidth := pdfRuneWidth(r) * size
+			if lineWidth+runeWidth > width && len(line) > 0 {
+				// 英数字の単語の途中であれば直前の空白で折り返す
+				next := []rune{}
+				if r != ' ' && r < 0x80 {
+					if index := lastRuneIndex(line, ' '); index > 0 && isASCIIWord(line[index+1:]) {
+						next = append(next, line[index+1:]...)
+						line = line[:index]
+					}
+				}
+				lines = append(lines, string(line))
+				line, lineWidth = next, pdfTextWidth(string(next), size)
+				if r == ' ' && len(line) == 0 {
+					continue
+				}
+			}
+			line = append(line, r)
+			lineWidth += runeWidth
+		}
+		lines = append(lines, string(line))
+	}
+
+	// 行数の上限を超える場合は省略する
+	if maxLines > 0 && len(lines) > maxLines {
+		lines = lines[:maxLines]
+		lines[maxLines-1] = pdfTruncate(lines[maxLines-1]+"…", size, width)
+	}
+	return lines
+}
+
+// pdfTruncate は幅に収まらない文字列の末尾を「…」にして省略します
+func pdfTruncate(s string, size, width float64) string {
+	if pdfTextWidth(s, size) <= width {
+		return s
+	}
+	runes := []rune(s)
+	ellipsis := pdfRuneWidth('…') * size
+	for len(runes) > 0 && pdfTextWidth(string(runes), size)+ellipsis > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
+
+// lastRuneIndex はルーンのスライスで最後に現れる文字の位置を返します（ない場合は-1）
+func lastRuneIndex(runes []rune, target rune) int {
+	for index := len(runes) - 1; index >= 0; index-- {
+		if runes[index] == target {
+			return index
+		}
+	}
+	return -1
+}
+
+// isASCIIWord はルーンが空白以外のASCII文字のみかどうかを返します
+func isASCIIWord(runes []rune) bool {
+	for _, r := range runes {
+		if r >= 0x80 || r == ' ' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// PrefectureInteractor は都道府県ユースケースのインタラクターです
+type PrefectureInteractor struct {
+	prefectureService *service.PrefectureService
+	outputPort        port.PrefectureOutputPort
+}
+
+// NewPrefectureInteractor は新しい都道府県インタラクターを作成します
+func NewPrefectureInteractor(
+	prefectureService *service.PrefectureService,
+	outputPort port.PrefectureOutputPort,
+) *PrefectureInteractor {
+	return &PrefectureInteractor{
+		prefectureService: prefectureService,
+		outputPort:        outputPort,
+	}
+}
+
+// GetPrefectures は47都道府県を取得します
+func (i *PrefectureInteractor) GetPrefectures(ctx context.Context) ([]port.PrefectureOutputData, error) {
+	// ドメインサービスを呼び出し
+	prefectures := i.prefectureService.GetPrefectures()
+
+	// 出力データを作成
+	outputData := make([]port.PrefectureOutputData, len(prefectures))
+	for i, prefecture := range prefectures {
+		outputData[i] = toPrefectureOutputData(prefecture)
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentPrefectures(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// GetCompletionMap はユーザーの47都道府県の制覇状況を取得します
+func (i *PrefectureInteractor) GetCompletionMap(ctx context.Context, userID string) (port.PrefectureCompletionOutputData, error) {
+	// ドメインサービスを呼び出し
+	completionMap, err := i.prefectureService.GetCompletionMap(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.PrefectureCompletionOutputData{}, err
+	}
+
+	// 出力データを作成
+	statuses := make([]port.PrefectureStatusOutputData, len(completionMap.Prefectures))
+	for i, completion := range completionMap.Prefectures {
+		statuses[i] = port.PrefectureStatusOutputData{
+			PrefectureOutputData: toPrefectureOutputData(completion.Prefecture),
+			Visited:              completion.Visited,
+			VisitCount:           completion.VisitCount,
+			FirstVisit:           completion.FirstVisit,
+			LastVisit:            completion.LastVisit,
+		}
+	}
+
+	outputData := port.PrefectureCompletionOutputData{
+		Prefectures:     statuses,
+		TotalCount:      entity.PrefectureCount,
+		VisitedCount:    completionMap.VisitedCount,
+		UnvisitedCount:  entity.PrefectureCount - completionMap.VisitedCount,
+		CompletionRate:  completionMap.CompletionRate(),
+		UnresolvedCount: completionMap.UnresolvedCount,
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentCompletionMap(ctx, outputData); err != nil {
+		return port.PrefectureCompletionOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// toPrefectureOutputData は都道府県を出力データに変換します
+func toPrefectureOutputData(prefecture entity.Prefecture) port.PrefectureOutputData {
+	return port.PrefectureOutputData{
+		Code:   prefecture.Code,
+		Name:   prefecture.Name,
+		Kana:   prefecture.Kana,
+		Romaji: prefecture.Romaji,
+		Region: prefecture.Region,
+	}
+}
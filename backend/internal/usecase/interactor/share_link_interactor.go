@@ -0,0 +1,148 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// ShareLinkInteractor は共有リンクユースケースのインタラクターです
+type ShareLinkInteractor struct {
+	shareLinkService *service.ShareLinkService
+	outputPort       port.ShareLinkOutputPort
+}
+
+// NewShareLinkInteractor は新しい共有リンクインタラクターを作成します
+func NewShareLinkInteractor(shareLinkService *service.ShareLinkService, outputPort port.ShareLinkOutputPort) *ShareLinkInteractor {
+	return &ShareLinkInteractor{
+		shareLinkService: shareLinkService,
+		outputPort:       outputPort,
+	}
+}
+
+// CreateShareLink は温泉メモの共有リンクを作成します
+func (i *ShareLinkInteractor) CreateShareLink(ctx context.Context, input port.CreateShareLinkInput) (port.ShareLinkOutputData, error) {
+	// ドメインサービスを呼び出し
+	shareLink, err := i.shareLinkService.CreateShareLink(ctx, input.OnsenLogID, input.UserID, input.ExpiresIn)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ShareLinkOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toShareLinkOutputData(shareLink)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentShareLink(ctx, outputData); err != nil {
+		return port.ShareLinkOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// GetShareLinks はユーザーの有効な共有リンクを作成日時の新しい順に取得します
+func (i *ShareLinkInteractor) GetShareLinks(ctx context.Context, userID string) ([]port.ShareLinkOutputData, error) {
+	// ドメインサービスを呼び出し
+	shareLinks, err := i.shareLinkService.GetActiveShareLinks(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 出力データを作成
+	outputData := make([]port.ShareLinkOutputData, len(shareLinks))
+	for i, shareLink := range shareLinks {
+		outputData[i] = toShareLinkOutputData(shareLink)
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentShareLinks(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// RevokeShareLink は共有リンクを取り消します
+func (i *ShareLinkInteractor) RevokeShareLink(ctx context.Context, id, userID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.shareLinkService.RevokeShareLink(ctx, id, userID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetPublicOnsenLog は共有リンクで公開されている温泉メモを画像付きで取得します
+func (i *ShareLinkInteractor) GetPublicOnsenLog(ctx context.Context, input port.GetPublicOnsenLogInput) (port.PublicOnsenLogOutputData, error) {
+	// ドメインサービスを呼び出し
+	shareLink, onsenLog, images, err := i.shareLinkService.GetSharedOnsenLog(ctx, input.Token)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.PublicOnsenLogOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toPublicOnsenLogOutputData(onsenLog, images)
+	outputData.ExpiresAt = shareLink.ExpiresAt
+	outputData.IsOwner = input.ViewerID != "" && input.ViewerID == shareLink.UserID
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentPublicOnsenLog(ctx, outputData); err != nil {
+		return port.PublicOnsenLogOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// toShareLinkOutputData は共有リンクエンティティを出力データに変換します
+func toShareLinkOutputData(shareLink *entity.ShareLink) port.ShareLinkOutputData {
+	return port.ShareLinkOutputData{
+		ID:         shareLink.UUID,
+		UserID:     shareLink.UserID,
+		OnsenLogID: shareLink.OnsenLogID,
+		Token:      shareLink.Token,
+		ExpiresAt:  shareLink.ExpiresAt,
+		CreatedAt:  shareLink.CreatedAt,
+	}
+}
+
+// toPublicOnsenLogOutputData は温泉メモエンティティと画像を公開用の出力データに変換します
+func toPublicOnsenLogOutputData(onsenLog *entity.OnsenLog, images []*entity.OnsenImage) port.PublicOnsenLogOutputData {
+	outputData := port.PublicOnsenLogOutputData{
+		Name:             onsenLog.Name,
+		Location:         onsenLog.Location,
+		Area:             onsenLog.Area,
+		SpringTypes:      onsenLog.SpringTypes,
+		WaterAnalysis:    onsenLog.WaterAnalysis,
+		LiquidClass:      onsenLog.WaterAnalysis.LiquidClass(),
+		TemperatureClass: onsenLog.WaterAnalysis.TemperatureClass(),
+		Features:         onsenLog.Features,
+		Tags:             onsenLog.Tags,
+		VisitDate:        onsenLog.VisitDate,
+		TimeOfDay:        onsenLog.Visit.TimeOfDay(),
+		Rating:           onsenLog.Rating,
+		RatingScores:     onsenLog.RatingScores,
+		Comment:          onsenLog.Comment,
+		Images:           make([]port.PublicImageOutputData, len(images)),
+	}
+
+	if onsenLog.Coordinates != nil {
+		latitude := onsenLog.Coordinates.Latitude()
+		longitude := onsenLog.Coordinates.Longitude()
+		outputData.Latitude = &latitude
+		outputData.Longitude = &longitude
+	}
+
+	for i, image := range images {
+		outputData.Images[i] = port.PublicImageOutputData{
+			URL:         image.ImageURL,
+			Description: image.Description,
+		}
+	}
+
+	return outputData
+}
@@ -0,0 +1,117 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// TagInteractor はタグ管理ユースケースのインタラクターです
+type TagInteractor struct {
+	tagService *service.TagService
+	outputPort port.TagOutputPort
+}
+
+// NewTagInteractor は新しいタグインタラクターを作成します
+func NewTagInteractor(
+	tagService *service.TagService,
+	outputPort port.TagOutputPort,
+) *TagInteractor {
+	return &TagInteractor{
+		tagService: tagService,
+		outputPort: outputPort,
+	}
+}
+
+// GetTags はユーザーが使用しているタグを使用回数付きで取得します
+func (i *TagInteractor) GetTags(ctx context.Context, userID string) ([]port.TagOutputData, error) {
+	// ドメインサービスを呼び出し
+	tags, err := i.tagService.GetTags(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 出力データを作成
+	outputData := make([]port.TagOutputData, len(tags))
+	for i, tag := range tags {
+		outputData[i] = port.TagOutputData{
+			Name:       tag.Name,
+			Count:      tag.Count,
+			LastUsedAt: tag.LastUsedAt,
+		}
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentTags(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// RenameTag はすべての温泉メモでタグの名前を変更します
+func (i *TagInteractor) RenameTag(ctx context.Context, input port.RenameTagInput) (port.TagUpdateOutputData, error) {
+	// 入力値のバリデーション
+	if input.NewName == "" {
+		err := errors.New("変更後のタグ名は必須です")
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TagUpdateOutputData{}, err
+	}
+
+	// ドメインサービスを呼び出し
+	updatedCount, err := i.tagService.RenameTag(ctx, input.UserID, input.Name, input.NewName)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TagUpdateOutputData{}, err
+	}
+
+	return i.presentTagUpdate(ctx, input.NewName, updatedCount)
+}
+
+// MergeTags は統合元のタグを統合先のタグに統合します
+func (i *TagInteractor) MergeTags(ctx context.Context, input port.MergeTagsInput) (port.TagUpdateOutputData, error) {
+	// 入力値のバリデーション
+	if input.Source == "" || input.Target == "" {
+		err := errors.New("統合元と統合先のタグは必須です")
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TagUpdateOutputData{}, err
+	}
+
+	// ドメインサービスを呼び出し
+	updatedCount, err := i.tagService.MergeTags(ctx, input.UserID, input.Source, input.Target)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TagUpdateOutputData{}, err
+	}
+
+	return i.presentTagUpdate(ctx, input.Target, updatedCount)
+}
+
+// DeleteTag はすべての温泉メモからタグを削除します
+func (i *TagInteractor) DeleteTag(ctx context.Context, userID, name string) (port.TagUpdateOutputData, error) {
+	// ドメインサービスを呼び出し
+	updatedCount, err := i.tagService.DeleteTag(ctx, userID, name)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TagUpdateOutputData{}, err
+	}
+
+	return i.presentTagUpdate(ctx, "", updatedCount)
+}
+
+// presentTagUpdate はタグの更新結果を出力データにして出力ポートを呼び出します
+func (i *TagInteractor) presentTagUpdate(ctx context.Context, tag string, updatedCount int) (port.TagUpdateOutputData, error) {
+	outputData := port.TagUpdateOutputData{
+		Tag:          tag,
+		UpdatedCount: updatedCount,
+	}
+
+	if err := i.outputPort.PresentTagUpdate(ctx, outputData); err != nil {
+		return port.TagUpdateOutputData{}, err
+	}
+
+	return outputData, nil
+}
@@ -0,0 +1,143 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// TrashInteractor はゴミ箱ユースケースのインタラクターです
+type TrashInteractor struct {
+	trashService *service.TrashService
+	outputPort   port.TrashOutputPort
+}
+
+// NewTrashInteractor は新しいゴミ箱インタラクターを作成します
+func NewTrashInteractor(trashService *service.TrashService, outputPort port.TrashOutputPort) *TrashInteractor {
+	return &TrashInteractor{
+		trashService: trashService,
+		outputPort:   outputPort,
+	}
+}
+
+// GetTrash はゴミ箱の温泉メモと画像を削除日時の新しい順に取得します
+func (i *TrashInteractor) GetTrash(ctx context.Context, userID string) (port.TrashOutputData, error) {
+	// ドメインサービスを呼び出し
+	onsenLogs, images, err := i.trashService.GetTrash(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TrashOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := port.TrashOutputData{
+		OnsenLogs:   make([]port.TrashItemOutputData, len(onsenLogs)),
+		OnsenImages: make([]port.TrashImageItemOutputData, len(images)),
+	}
+	for i2, onsenLog := range onsenLogs {
+		outputData.OnsenLogs[i2] = toTrashItemOutputData(onsenLog, i.trashService.Retention())
+	}
+	for i2, image := range images {
+		outputData.OnsenImages[i2] = toTrashImageItemOutputData(image, i.trashService.Retention())
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentTrash(ctx, outputData); err != nil {
+		return port.TrashOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// RestoreOnsenLog はゴミ箱の温泉メモを画像とともに復元します
+func (i *TrashInteractor) RestoreOnsenLog(ctx context.Context, id, userID string) (port.OnsenLogOutputData, error) {
+	// ドメインサービスを呼び出し
+	onsenLog, err := i.trashService.RestoreOnsenLog(ctx, id, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.OnsenLogOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toOnsenLogOutputData(onsenLog)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentRestoredOnsenLog(ctx, outputData); err != nil {
+		return port.OnsenLogOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// PurgeOnsenLog はゴミ箱の温泉メモを完全に削除します
+func (i *TrashInteractor) PurgeOnsenLog(ctx context.Context, id, userID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.trashService.PurgeOnsenLog(ctx, id, userID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// RestoreImage はゴミ箱の温泉画像を復元します
+func (i *TrashInteractor) RestoreImage(ctx context.Context, id, userID string) (port.ImageOutputData, error) {
+	// ドメインサービスを呼び出し
+	image, err := i.trashService.RestoreImage(ctx, id, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ImageOutputData{}, err
+	}
+
+	return toImageOutputData([]*entity.OnsenImage{image})[0], nil
+}
+
+// PurgeImage はゴミ箱の温泉画像を完全に削除します
+func (i *TrashInteractor) PurgeImage(ctx context.Context, id, userID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.trashService.PurgeImage(ctx, id, userID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// PurgeExpiredTrash は保持期間を過ぎたゴミ箱の温泉メモと画像を完全に削除し、削除した件数を返します
+func (i *TrashInteractor) PurgeExpiredTrash(ctx context.Context) (int, error) {
+	return i.trashService.PurgeExpired(ctx, time.Now())
+}
+
+// toTrashItemOutputData はゴミ箱の温泉メモエンティティを出力データに変換します
+func toTrashItemOutputData(onsenLog *entity.OnsenLog, retention time.Duration) port.TrashItemOutputData {
+	outputData := port.TrashItemOutputData{
+		ID:        onsenLog.UUID,
+		Name:      onsenLog.Name,
+		Location:  onsenLog.Location,
+		VisitDate: onsenLog.VisitDate,
+		Rating:    onsenLog.Rating,
+	}
+	if onsenLog.DeletedAt != nil {
+		outputData.DeletedAt = *onsenLog.DeletedAt
+		outputData.PurgeAt = *entity.TrashPurgeAt(onsenLog, retention)
+	}
+	return outputData
+}
+
+// toTrashImageItemOutputData はゴミ箱の温泉画像エンティティを出力データに変換します
+func toTrashImageItemOutputData(image *entity.OnsenImage, retention time.Duration) port.TrashImageItemOutputData {
+	outputData := port.TrashImageItemOutputData{
+		ID:          image.UUID,
+		OnsenID:     image.OnsenID,
+		URL:         image.ImageURL,
+		Description: image.Description,
+	}
+	if image.DeletedAt != nil {
+		outputData.DeletedAt = *image.DeletedAt
+		outputData.PurgeAt = *entity.TrashImagePurgeAt(image, retention)
+	}
+	return outputData
+}
@@ -0,0 +1,200 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// TripInteractor は旅行ユースケースのインタラクターです
+// 旅行のエクスポートは温泉メモユースケースのエクスポート処理を利用します
+type TripInteractor struct {
+	tripService     *service.TripService
+	onsenLogUseCase port.OnsenLogInputPort
+	outputPort      port.TripOutputPort
+}
+
+// NewTripInteractor は新しい旅行インタラクターを作成します
+func NewTripInteractor(
+	tripService *service.TripService,
+	onsenLogUseCase port.OnsenLogInputPort,
+	outputPort port.TripOutputPort,
+) *TripInteractor {
+	return &TripInteractor{
+		tripService:     tripService,
+		onsenLogUseCase: onsenLogUseCase,
+		outputPort:      outputPort,
+	}
+}
+
+// CreateTrip は新しい旅行を作成します
+func (i *TripInteractor) CreateTrip(ctx context.Context, input port.CreateTripInput) (port.TripOutputData, error) {
+	// 入力値のバリデーション
+	if input.Title == "" {
+		err := errors.New("旅行のタイトルは必須です")
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TripOutputData{}, err
+	}
+
+	// ドメインサービスを呼び出し
+	trip, err := i.tripService.CreateTrip(ctx, input.UserID, entity.TripParams{
+		Title:       input.Title,
+		StartDate:   input.StartDate,
+		EndDate:     input.EndDate,
+		Notes:       input.Notes,
+		OnsenLogIDs: input.OnsenLogIDs,
+	})
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TripOutputData{}, err
+	}
+
+	return i.presentTripDetail(ctx, trip)
+}
+
+// GetTrip は旅行を温泉メモと統計付きで取得します
+func (i *TripInteractor) GetTrip(ctx context.Context, id, userID string) (port.TripOutputData, error) {
+	// ドメインサービスを呼び出し
+	trip, err := i.tripService.GetTrip(ctx, id, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TripOutputData{}, err
+	}
+
+	return i.presentTripDetail(ctx, trip)
+}
+
+// GetTrips はユーザーの旅行を開始日の新しい順に取得します
+func (i *TripInteractor) GetTrips(ctx context.Context, userID string) ([]port.TripOutputData, error) {
+	// ドメインサービスを呼び出し
+	trips, err := i.tripService.GetTripsByUserID(ctx, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 出力データを作成
+	outputData := make([]port.TripOutputData, len(trips))
+	for i, trip := range trips {
+		outputData[i] = toTripOutputData(trip)
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentTrips(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// UpdateTrip は旅行を更新します
+func (i *TripInteractor) UpdateTrip(ctx context.Context, input port.UpdateTripInput) (port.TripOutputData, error) {
+	// 入力値のバリデーション
+	if input.Title == "" {
+		err := errors.New("旅行のタイトルは必須です")
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TripOutputData{}, err
+	}
+
+	// ドメインサービスを呼び出し
+	trip, err := i.tripService.UpdateTrip(ctx, input.ID, input.UserID, entity.TripParams{
+		Title:       input.Title,
+		StartDate:   input.StartDate,
+		EndDate:     input.EndDate,
+		Notes:       input.Notes,
+		OnsenLogIDs: input.OnsenLogIDs,
+	})
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TripOutputData{}, err
+	}
+
+	return i.presentTripDetail(ctx, trip)
+}
+
+// DeleteTrip は旅行を削除します
+func (i *TripInteractor) DeleteTrip(ctx context.Context, id, userID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.tripService.DeleteTrip(ctx, id, userID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// ExportTrip は旅行に含まれる温泉メモを旅程の順にwに書き出します
+func (i *TripInteractor) ExportTrip(ctx context.Context, input port.ExportTripInput, w io.Writer) error {
+	// ドメインサービスを呼び出し
+	trip, err := i.tripService.GetTrip(ctx, input.ID, input.UserID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	// 温泉メモのない旅行はすべての温泉メモのエクスポートと区別できないためエラーにする
+	if len(trip.OnsenLogIDs) == 0 {
+		err := errors.New("旅行に温泉メモが含まれていません")
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	// 温泉メモのエクスポート処理を呼び出し
+	return i.onsenLogUseCase.ExportOnsenLogs(ctx, port.ExportOnsenLogsInput{
+		UserID:      input.UserID,
+		Format:      input.Format,
+		OnsenLogIDs: trip.OnsenLogIDs,
+		CSV:         input.CSV,
+		Progress:    input.Progress,
+	}, w)
+}
+
+// presentTripDetail は旅行に含まれる温泉メモと統計を取得して出力します
+func (i *TripInteractor) presentTripDetail(ctx context.Context, trip *entity.Trip) (port.TripOutputData, error) {
+	// 旅程の順に温泉メモを取得
+	onsenLogs, err := i.tripService.GetTripOnsenLogs(ctx, trip)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.TripOutputData{}, err
+	}
+
+	// 出力データを作成（削除された温泉メモのIDは除く）
+	outputData := toTripOutputData(trip)
+	outputData.OnsenLogs = toOnsenLogsOutputData(onsenLogs)
+	outputData.OnsenLogIDs = make([]string, len(onsenLogs))
+	for i, onsenLog := range onsenLogs {
+		outputData.OnsenLogIDs[i] = onsenLog.UUID
+	}
+	stats := entity.NewTripStats(trip, onsenLogs)
+	outputData.Stats = &stats
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentTrip(ctx, outputData); err != nil {
+		return port.TripOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// toTripOutputData は旅行エンティティを出力データに変換します
+func toTripOutputData(trip *entity.Trip) port.TripOutputData {
+	onsenLogIDs := trip.OnsenLogIDs
+	if onsenLogIDs == nil {
+		onsenLogIDs = []string{}
+	}
+	return port.TripOutputData{
+		ID:          trip.UUID,
+		UserID:      trip.UserID,
+		Title:       trip.Title,
+		StartDate:   trip.StartDate,
+		EndDate:     trip.EndDate,
+		Notes:       trip.Notes,
+		OnsenLogIDs: onsenLogIDs,
+		CreatedAt:   trip.CreatedAt,
+		UpdatedAt:   trip.UpdatedAt,
+	}
+}
@@ -0,0 +1,221 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+	"github.com/yourusername/yuroku/internal/domain/repository"
+	"github.com/yourusername/yuroku/internal/domain/service"
+	"github.com/yourusername/yuroku/internal/usecase/port"
+)
+
+// WishlistInteractor は行きたい温泉ユースケースのインタラクターです
+// 温泉メモへの変換は温泉メモユースケースの作成処理を利用します
+type WishlistInteractor struct {
+	wishlistService *service.WishlistService
+	onsenLogUseCase port.OnsenLogInputPort
+	outputPort      port.WishlistOutputPort
+}
+
+// NewWishlistInteractor は新しい行きたい温泉インタラクターを作成します
+func NewWishlistInteractor(
+	wishlistService *service.WishlistService,
+	onsenLogUseCase port.OnsenLogInputPort,
+	outputPort port.WishlistOutputPort,
+) *WishlistInteractor {
+	return &WishlistInteractor{
+		wishlistService: wishlistService,
+		onsenLogUseCase: onsenLogUseCase,
+		outputPort:      outputPort,
+	}
+}
+
+// CreateWishlistItem は新しい行きたい温泉を作成します
+func (i *WishlistInteractor) CreateWishlistItem(ctx context.Context, input port.CreateWishlistItemInput) (port.WishlistItemOutputData, error) {
+	// 入力値のバリデーション
+	if input.Name == "" {
+		err := errors.New("温泉名は必須です")
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.WishlistItemOutputData{}, err
+	}
+
+	// ドメインサービスを呼び出し
+	item, err := i.wishlistService.CreateWishlistItem(ctx, input.UserID, entity.WishlistItemParams{
+		Name:         input.Name,
+		Location:     input.Location,
+		Notes:        input.Notes,
+		Priority:     input.Priority,
+		TargetSeason: input.TargetSeason,
+	})
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.WishlistItemOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toWishlistItemOutputData(item)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentWishlistItem(ctx, outputData); err != nil {
+		return port.WishlistItemOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// GetWishlistItem は行きたい温泉を取得します
+func (i *WishlistInteractor) GetWishlistItem(ctx context.Context, id, userID string) (port.WishlistItemOutputData, error) {
+	// ドメインサービスを呼び出し
+	item, err := i.wishlistService.GetWishlistItem(ctx, id, userID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.WishlistItemOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toWishlistItemOutputData(item)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentWishlistItem(ctx, outputData); err != nil {
+		return port.WishlistItemOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// GetWishlist はユーザーの行きたい温泉を優先度の高い順に取得します
+func (i *WishlistInteractor) GetWishlist(ctx context.Context, input port.GetWishlistInput) ([]port.WishlistItemOutputData, error) {
+	// ドメインサービスを呼び出し
+	items, err := i.wishlistService.GetWishlist(ctx, input.UserID, repository.WishlistFilter{
+		Status:       input.Status,
+		TargetSeason: input.TargetSeason,
+	})
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return nil, err
+	}
+
+	// 出力データを作成
+	outputData := make([]port.WishlistItemOutputData, len(items))
+	for i, item := range items {
+		outputData[i] = toWishlistItemOutputData(item)
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentWishlist(ctx, outputData); err != nil {
+		return nil, err
+	}
+
+	return outputData, nil
+}
+
+// UpdateWishlistItem は行きたい温泉を更新します
+func (i *WishlistInteractor) UpdateWishlistItem(ctx context.Context, input port.UpdateWishlistItemInput) (port.WishlistItemOutputData, error) {
+	// 入力値のバリデーション
+	if input.Name == "" {
+		err := errors.New("温泉名は必須です")
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.WishlistItemOutputData{}, err
+	}
+
+	// ドメインサービスを呼び出し
+	item, err := i.wishlistService.UpdateWishlistItem(ctx, input.ID, input.UserID, entity.WishlistItemParams{
+		Name:         input.Name,
+		Location:     input.Location,
+		Notes:        input.Notes,
+		Priority:     input.Priority,
+		TargetSeason: input.TargetSeason,
+	})
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.WishlistItemOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := toWishlistItemOutputData(item)
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentWishlistItem(ctx, outputData); err != nil {
+		return port.WishlistItemOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// DeleteWishlistItem は行きたい温泉を削除します
+func (i *WishlistInteractor) DeleteWishlistItem(ctx context.Context, id, userID string) error {
+	// ドメインサービスを呼び出し
+	if err := i.wishlistService.DeleteWishlistItem(ctx, id, userID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// ConvertToOnsenLog は訪問した行きたい温泉を温泉メモに変換します
+// 温泉名と所在地を行きたい温泉の値で補って温泉メモを作成し、行きたい温泉を訪問済みにします
+func (i *WishlistInteractor) ConvertToOnsenLog(ctx context.Context, input port.ConvertWishlistItemInput) (port.ConvertWishlistItemOutputData, error) {
+	// 変換する行きたい温泉を取得
+	item, err := i.wishlistService.PrepareConversion(ctx, input.ID, input.UserID)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ConvertWishlistItemOutputData{}, err
+	}
+
+	// 温泉メモの入力データを行きたい温泉の値で補う
+	createInput := input.OnsenLog
+	createInput.UserID = input.UserID
+	createInput.WishlistItemID = item.UUID
+	if createInput.Name == "" {
+		createInput.Name = item.Name
+	}
+	if createInput.Location == "" {
+		createInput.Location = item.Location
+	}
+
+	// 温泉メモを作成
+	onsenLog, err := i.onsenLogUseCase.CreateOnsenLog(ctx, createInput)
+	if err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ConvertWishlistItemOutputData{}, err
+	}
+
+	// 行きたい温泉を訪問済みにする
+	if err := i.wishlistService.MarkVisited(ctx, item, onsenLog.ID); err != nil {
+		_ = i.outputPort.PresentError(ctx, err)
+		return port.ConvertWishlistItemOutputData{}, err
+	}
+
+	// 出力データを作成
+	outputData := port.ConvertWishlistItemOutputData{
+		WishlistItem: toWishlistItemOutputData(item),
+		OnsenLog:     onsenLog,
+	}
+
+	// 出力ポートを呼び出し
+	if err := i.outputPort.PresentConversion(ctx, outputData); err != nil {
+		return port.ConvertWishlistItemOutputData{}, err
+	}
+
+	return outputData, nil
+}
+
+// toWishlistItemOutputData は行きたい温泉エンティティを出力データに変換します
+func toWishlistItemOutputData(item *entity.WishlistItem) port.WishlistItemOutputData {
+	return port.WishlistItemOutputData{
+		ID:           item.UUID,
+		UserID:       item.UserID,
+		Name:         item.Name,
+		Location:     item.Location,
+		Notes:        item.Notes,
+		Priority:     item.Priority,
+		TargetSeason: item.TargetSeason,
+		Visited:      item.IsVisited(),
+		OnsenLogID:   item.OnsenLogID,
+		VisitedAt:    item.VisitedAt,
+		CreatedAt:    item.CreatedAt,
+		UpdatedAt:    item.UpdatedAt,
+	}
+}
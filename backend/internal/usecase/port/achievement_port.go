@@ -0,0 +1,42 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// AchievementInputPort は実績ユースケースの入力ポートです
+type AchievementInputPort interface {
+	// GetAchievements はユーザーの獲得済みの実績と達成途中の実績を取得します
+	GetAchievements(ctx context.Context, userID string) (AchievementsOutputData, error)
+}
+
+// AchievementOutputPort は実績ユースケースの出力ポートです
+type AchievementOutputPort interface {
+	// PresentAchievements は実績の達成状況を表示します
+	PresentAchievements(ctx context.Context, data AchievementsOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// AchievementOutputData は実績の達成状況の出力データです
+// Progressは達成率（%）で、UnlockedAtは獲得済みの場合のみ設定されます
+type AchievementOutputData struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Current     int        `json:"current"`
+	Target      int        `json:"target"`
+	Progress    int        `json:"progress"`
+	Unlocked    bool       `json:"unlocked"`
+	UnlockedAt  *time.Time `json:"unlocked_at"`
+}
+
+// AchievementsOutputData は獲得済みの実績と達成途中の実績の出力データです
+type AchievementsOutputData struct {
+	Earned      []AchievementOutputData `json:"earned"`
+	InProgress  []AchievementOutputData `json:"in_progress"`
+	EarnedCount int                     `json:"earned_count"`
+	TotalCount  int                     `json:"total_count"`
+}
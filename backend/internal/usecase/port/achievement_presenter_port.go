@@ -0,0 +1,10 @@
+package port
+
+// AchievementPresenterPort は実績関連のレスポンスを整形するためのインターフェースです
+type AchievementPresenterPort interface {
+	// PresentAchievements は実績の達成状況のレスポンスを整形します
+	PresentAchievements(data AchievementsOutputData) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
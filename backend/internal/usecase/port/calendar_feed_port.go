@@ -0,0 +1,41 @@
+package port
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// CalendarFeedInputPort はカレンダーフィードユースケースの入力ポートです
+type CalendarFeedInputPort interface {
+	// IssueCalendarFeed はカレンダーフィードを発行します（発行済みの場合はトークンを再発行します）
+	IssueCalendarFeed(ctx context.Context, userID string) (CalendarFeedOutputData, error)
+
+	// GetCalendarFeed はカレンダーフィードを取得します
+	GetCalendarFeed(ctx context.Context, userID string) (CalendarFeedOutputData, error)
+
+	// DeleteCalendarFeed はカレンダーフィードを削除します
+	DeleteCalendarFeed(ctx context.Context, userID string) error
+
+	// WriteCalendarFeed はトークンに対応するユーザーの温泉メモと今後の旅行をiCalendar形式でwに書き出します
+	WriteCalendarFeed(ctx context.Context, token string, w io.Writer) error
+}
+
+// CalendarFeedOutputPort はカレンダーフィードユースケースの出力ポートです
+type CalendarFeedOutputPort interface {
+	// PresentCalendarFeed はカレンダーフィードを表示します
+	PresentCalendarFeed(ctx context.Context, data CalendarFeedOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// CalendarFeedOutputData はカレンダーフィードの出力データです
+// FeedURLはカレンダーアプリで購読するフィードの絶対URLです
+type CalendarFeedOutputData struct {
+	ID        string    `json:"id"`
+	Token     string    `json:"token"`
+	FeedURL   string    `json:"feed_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
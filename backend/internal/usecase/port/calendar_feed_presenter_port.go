@@ -0,0 +1,10 @@
+package port
+
+// CalendarFeedPresenterPort はカレンダーフィード関連のレスポンスを整形するためのインターフェースです
+type CalendarFeedPresenterPort interface {
+	// PresentCalendarFeed はカレンダーフィードのレスポンスを整形します
+	PresentCalendarFeed(data CalendarFeedOutputData) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
@@ -0,0 +1,87 @@
+package port
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ExportJobInputPort はエクスポートジョブユースケースの入力ポートです
+type ExportJobInputPort interface {
+	// CreateExportJob はエクスポートジョブを作成し、実行待ちとして登録します
+	CreateExportJob(ctx context.Context, input CreateExportJobInput) (ExportJobOutputData, error)
+
+	// GetExportJobs はユーザーのエクスポートジョブを取得します
+	GetExportJobs(ctx context.Context, userID string) ([]ExportJobOutputData, error)
+
+	// GetExportJob はエクスポートジョブの状態と進捗を取得します
+	GetExportJob(ctx context.Context, id, userID string) (ExportJobOutputData, error)
+
+	// OpenExportFile はダウンロードトークンを検証し、エクスポートしたファイルを開きます
+	// 返したファイルは呼び出し元で閉じる必要があります
+	OpenExportFile(ctx context.Context, id, token string) (ExportFileOutputData, io.ReadCloser, error)
+
+	// RunPendingExportJobs は実行待ちのエクスポートジョブがなくなるまで順に実行し、実行した件数を返します
+	RunPendingExportJobs(ctx context.Context) (int, error)
+
+	// RequeueInterruptedExportJobs は実行中のまま中断されたエクスポートジョブを実行待ちに戻し、戻した件数を返します
+	// 一定時間更新されていないジョブのみを戻すため、複数のサーバーで実行しても他のサーバーで実行中のジョブは戻しません
+	RequeueInterruptedExportJobs(ctx context.Context) (int, error)
+
+	// PurgeExpiredExports はダウンロードの有効期限が切れたエクスポートファイルを削除し、削除した件数を返します
+	PurgeExpiredExports(ctx context.Context) (int, error)
+}
+
+// ExportJobOutputPort はエクスポートジョブユースケースの出力ポートです
+type ExportJobOutputPort interface {
+	// PresentExportJob はエクスポートジョブを表示します
+	PresentExportJob(ctx context.Context, data ExportJobOutputData) error
+
+	// PresentExportJobs はエクスポートジョブのリストを表示します
+	PresentExportJobs(ctx context.Context, data []ExportJobOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// CreateExportJobInput はエクスポートジョブ作成の入力データです
+// TripIDを指定した場合は旅行に含まれる温泉メモのみをエクスポートします
+// FileNameとContentTypeはダウンロード時のファイル名とContent-Typeです
+type CreateExportJobInput struct {
+	UserID      string           `json:"user_id"`
+	Format      string           `json:"format"`
+	TripID      string           `json:"trip_id"`
+	CSV         CSVExportOptions `json:"csv"`
+	FileName    string           `json:"file_name"`
+	ContentType string           `json:"content_type"`
+}
+
+// ExportJobOutputData はエクスポートジョブの出力データです
+// Progressは処理済みの割合（0〜100）で、DownloadURLは完了してダウンロードできる場合のみ設定します
+type ExportJobOutputData struct {
+	ID                string     `json:"id"`
+	Format            string     `json:"format"`
+	TripID            string     `json:"trip_id,omitempty"`
+	Status            string     `json:"status"`
+	Processed         int        `json:"processed"`
+	Total             int        `json:"total"`
+	Progress          int        `json:"progress"`
+	Attempts          int        `json:"attempts"`
+	MaxAttempts       int        `json:"max_attempts"`
+	Error             string     `json:"error,omitempty"`
+	FileName          string     `json:"file_name"`
+	Size              int64      `json:"size,omitempty"`
+	DownloadURL       string     `json:"download_url,omitempty"`
+	DownloadExpiresAt *time.Time `json:"download_expires_at,omitempty"`
+	NextAttemptAt     *time.Time `json:"next_attempt_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	StartedAt         *time.Time `json:"started_at,omitempty"`
+	CompletedAt       *time.Time `json:"completed_at,omitempty"`
+}
+
+// ExportFileOutputData はダウンロードするエクスポートファイルの出力データです
+type ExportFileOutputData struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
@@ -0,0 +1,13 @@
+package port
+
+// ExportJobPresenterPort はエクスポートジョブ関連のレスポンスを整形するためのインターフェースです
+type ExportJobPresenterPort interface {
+	// PresentExportJob はエクスポートジョブのレスポンスを整形します
+	PresentExportJob(data ExportJobOutputData) map[string]interface{}
+
+	// PresentExportJobs はエクスポートジョブのリストレスポンスを整形します
+	PresentExportJobs(data []ExportJobOutputData) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
@@ -0,0 +1,114 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// FollowInputPort はフォローとフィードのユースケースの入力ポートです
+type FollowInputPort interface {
+	// Follow はユーザーにフォローリクエストを送ります
+	Follow(ctx context.Context, input FollowInput) (FollowUserOutputData, error)
+
+	// Unfollow はユーザーのフォローを解除します（フォローリクエストの取り消しを含みます）
+	Unfollow(ctx context.Context, userID, followeeID string) error
+
+	// GetFollowRequests は承認待ちのフォローリクエストを取得します
+	GetFollowRequests(ctx context.Context, userID string) ([]FollowUserOutputData, error)
+
+	// ApproveFollowRequest はフォローリクエストを承認します
+	ApproveFollowRequest(ctx context.Context, userID, followerID string) (FollowUserOutputData, error)
+
+	// RejectFollowRequest はフォローリクエストを拒否します
+	RejectFollowRequest(ctx context.Context, userID, followerID string) error
+
+	// RemoveFollower はフォロワーを解除します
+	RemoveFollower(ctx context.Context, userID, followerID string) error
+
+	// GetFollowing はフォローしているユーザーを取得します
+	GetFollowing(ctx context.Context, userID string) ([]FollowUserOutputData, error)
+
+	// GetFollowers はフォロワーを取得します
+	GetFollowers(ctx context.Context, userID string) ([]FollowUserOutputData, error)
+
+	// GetFeed はフォローしているユーザーの温泉メモのフィードを取得します
+	GetFeed(ctx context.Context, input GetFeedInput) (FeedOutputData, error)
+
+	// GetUserOnsenLogs はユーザーの温泉メモのうち閲覧者が閲覧できるものを取得します
+	GetUserOnsenLogs(ctx context.Context, input GetUserOnsenLogsInput) (FeedOutputData, error)
+}
+
+// FollowOutputPort はフォローとフィードのユースケースの出力ポートです
+type FollowOutputPort interface {
+	// PresentFollowUser はフォローしたユーザーを表示します
+	PresentFollowUser(ctx context.Context, data FollowUserOutputData) error
+
+	// PresentFollowUsers はフォロー中・フォロワーのユーザーのリストを表示します
+	PresentFollowUsers(ctx context.Context, data []FollowUserOutputData) error
+
+	// PresentFeed はフィードを表示します
+	PresentFeed(ctx context.Context, data FeedOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// FollowInput はフォローの入力データです
+type FollowInput struct {
+	UserID     string `json:"user_id"`
+	FolloweeID string `json:"followee_id"`
+}
+
+// GetFeedInput はフィード取得の入力データです
+type GetFeedInput struct {
+	UserID string `json:"user_id"`
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+}
+
+// GetUserOnsenLogsInput はユーザーの温泉メモ取得の入力データです
+// ViewerIDはログインしている閲覧者のユーザーIDで、未ログインの場合は空です
+type GetUserOnsenLogsInput struct {
+	UserID   string `json:"user_id"`
+	ViewerID string `json:"viewer_id"`
+	Cursor   string `json:"cursor"`
+	Limit    int    `json:"limit"`
+}
+
+// FollowUserOutputData はフォロー中・フォロワー・フォローリクエストのユーザーの出力データです
+// Statusはフォロー関係の状態（pending・approved）です
+type FollowUserOutputData struct {
+	UserID     string              `json:"user_id"`
+	Name       string              `json:"name"`
+	Status     entity.FollowStatus `json:"status"`
+	FollowedAt time.Time           `json:"followed_at"`
+}
+
+// FeedOutputData はフィードの出力データです
+// NextCursorは次のページを取得するためのカーソルで、次のページがない場合は空です
+type FeedOutputData struct {
+	Items      []FeedItemOutputData `json:"items"`
+	NextCursor string               `json:"next_cursor"`
+	HasMore    bool                 `json:"has_more"`
+}
+
+// FeedItemOutputData はフィードの温泉メモの出力データです
+// 同行者や費用などの訪問時の状況は含めません
+type FeedItemOutputData struct {
+	ID          string              `json:"id"`
+	AuthorID    string              `json:"author_id"`
+	AuthorName  string              `json:"author_name"`
+	Visibility  entity.Visibility   `json:"visibility"`
+	Name        string              `json:"name"`
+	Location    string              `json:"location"`
+	Area        entity.LocationArea `json:"area"`
+	SpringTypes []entity.SpringType `json:"spring_types"`
+	Features    []entity.Feature    `json:"features"`
+	Tags        []string            `json:"tags"`
+	VisitDate   time.Time           `json:"visit_date"`
+	Rating      float64             `json:"rating"`
+	Comment     string              `json:"comment"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
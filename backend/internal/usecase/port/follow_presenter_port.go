@@ -0,0 +1,13 @@
+package port
+
+// FollowPresenterPort はフォローとフィード関連のレスポンスを整形するためのインターフェースです
+type FollowPresenterPort interface {
+	// PresentFollowUsers はフォロー中・フォロワーのユーザーのリストレスポンスを整形します
+	PresentFollowUsers(data []FollowUserOutputData) map[string]interface{}
+
+	// PresentFeed はフィードのレスポンスを整形します
+	PresentFeed(data FeedOutputData) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
@@ -14,7 +14,7 @@ type OnsenImageInputPort interface {
 	// GetImagesByOnsenID は温泉IDに紐づく画像を取得します
 	GetImagesByOnsenID(ctx context.Context, input GetImagesByOnsenIDInput) ([]ImageOutputData, error)
 
-	// DeleteImage は温泉画像を削除します
+	// DeleteImage は温泉画像をゴミ箱に移動します
 	DeleteImage(ctx context.Context, input DeleteImageInput) error
 }
 
@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/yourusername/yuroku/internal/domain/entity"
@@ -21,14 +22,26 @@ type OnsenLogInputPort interface {
 	// GetFilteredOnsenLogs はユーザーIDと条件に紐づく温泉メモを取得します
 	GetFilteredOnsenLogs(ctx context.Context, input FilterOnsenLogsInput) (OnsenLogsOutputData, error)
 
+	// GetOnsenLogStats はユーザーIDと条件に紐づく温泉メモの統計を取得します
+	GetOnsenLogStats(ctx context.Context, input FilterOnsenLogsInput) (OnsenLogStatsOutputData, error)
+
+	// GetNearbyOnsenLogs は指定地点の周辺にある温泉メモを距離の近い順に取得します
+	GetNearbyOnsenLogs(ctx context.Context, input NearbyOnsenLogsInput) ([]NearbyOnsenLogOutputData, error)
+
 	// UpdateOnsenLog は温泉メモを更新します
 	UpdateOnsenLog(ctx context.Context, input UpdateOnsenLogInput) (OnsenLogOutputData, error)
 
 	// DeleteOnsenLog は温泉メモを削除します
 	DeleteOnsenLog(ctx context.Context, id, userID string) error
 
-	// ExportOnsenLogs はユーザーIDに紐づく温泉メモをエクスポートします
-	ExportOnsenLogs(ctx context.Context, userID string, format string) ([]byte, error)
+	// ExportOnsenLogs はユーザーIDに紐づく温泉メモを1件ずつ読み取りながらwに書き出します
+	ExportOnsenLogs(ctx context.Context, input ExportOnsenLogsInput, w io.Writer) error
+
+	// ExportYearbook は温泉メモを訪問ごとのページと年ごとのまとめのページからなる温泉アルバムとしてwに書き出します
+	ExportYearbook(ctx context.Context, input ExportYearbookInput, w io.Writer) error
+
+	// ImportOnsenLogs はエクスポートと同じ形式のファイルから温泉メモをインポートします
+	ImportOnsenLogs(ctx context.Context, input ImportOnsenLogsInput) (ImportOnsenLogsOutputData, error)
 }
 
 // OnsenLogOutputPort は温泉メモユースケースの出力ポートです
@@ -39,8 +52,17 @@ type OnsenLogOutputPort interface {
 	// PresentOnsenLogs は温泉メモのリストを表示します
 	PresentOnsenLogs(ctx context.Context, data OnsenLogsOutputData) error
 
-	// PresentExportedData はエクスポートされたデータを表示します
-	PresentExportedData(ctx context.Context, data []byte, format string) error
+	// PresentOnsenLogStats は温泉メモの統計を表示します
+	PresentOnsenLogStats(ctx context.Context, data OnsenLogStatsOutputData) error
+
+	// PresentNearbyOnsenLogs は周辺の温泉メモのリストを表示します
+	PresentNearbyOnsenLogs(ctx context.Context, data []NearbyOnsenLogOutputData) error
+
+	// PresentExportedData はエクスポートの結果（形式と件数）を表示します
+	PresentExportedData(ctx context.Context, format string, count int) error
+
+	// PresentImportResult はインポートの処理結果を表示します
+	PresentImportResult(ctx context.Context, data ImportOnsenLogsOutputData) error
 
 	// PresentError はエラーを表示します
 	PresentError(ctx context.Context, err error) error
@@ -48,55 +70,162 @@ type OnsenLogOutputPort interface {
 
 // CreateOnsenLogInput は温泉メモ作成の入力データです
 type CreateOnsenLogInput struct {
-	UserID     string            `json:"user_id"`
-	Name       string            `json:"name"`
-	Location   string            `json:"location"`
-	SpringType entity.SpringType `json:"spring_type"`
-	Features   []entity.Feature  `json:"features"`
-	VisitDate  time.Time         `json:"visit_date"`
-	Rating     int               `json:"rating"`
-	Comment    string            `json:"comment"`
+	UserID         string               `json:"user_id"`
+	OnsenID        string               `json:"onsen_id"`
+	Name           string               `json:"name"`
+	Location       string               `json:"location"`
+	Latitude       *float64             `json:"latitude"`
+	Longitude      *float64             `json:"longitude"`
+	SpringTypes    []entity.SpringType  `json:"spring_types"`
+	WaterAnalysis  entity.WaterAnalysis `json:"water_analysis"`
+	Features       []entity.Feature     `json:"features"`
+	Tags           []string             `json:"tags"`
+	VisitDate      time.Time            `json:"visit_date"`
+	Visit          entity.VisitDetails  `json:"visit"`
+	Rating         *float64             `json:"rating"`
+	RatingScores   entity.RatingScores  `json:"rating_scores"`
+	Comment        string               `json:"comment"`
+	Visibility     entity.Visibility    `json:"visibility"`
+	WishlistItemID string               `json:"wishlist_item_id"`
+}
+
+// ExportOnsenLogsInput は温泉メモのエクスポートの入力データです
+// OnsenLogIDsを指定した場合はその温泉メモのみを指定した順に、nilの場合はすべての温泉メモをエクスポートします
+// CSVはCSV形式・XLSX形式・ZIP形式（アーカイブ内のCSV）の場合のみ使用します
+// Progressを指定した場合は温泉メモを1件書き出すごとに書き出した件数で呼び出します
+type ExportOnsenLogsInput struct {
+	UserID      string              `json:"user_id"`
+	Format      string              `json:"format"`
+	OnsenLogIDs []string            `json:"onsen_log_ids"`
+	CSV         CSVExportOptions    `json:"csv"`
+	Progress    func(processed int) `json:"-"`
+}
+
+// ExportYearbookInput は温泉アルバムのエクスポートの入力データです
+// Formatはhtmlまたはpdfで、Yearが0の場合はすべての年の温泉メモを含めます
+type ExportYearbookInput struct {
+	UserID string `json:"user_id"`
+	Format string `json:"format"`
+	Year   int    `json:"year"`
+}
+
+// CSVExportOptions はCSVエクスポートの出力オプションです
+// ゼロ値の項目は既定値（UTF-8、カンマ区切り、既定の列、YYYY-MM-DD、日本語ヘッダー）を使用します
+// DateLayoutは訪問日のGoの日付レイアウトで、作成日・更新日は時刻を付けて出力します
+// XLSXエクスポートでも列とヘッダーの言語を使用します
+type CSVExportOptions struct {
+	Encoding       entity.CSVEncoding    `json:"encoding"`
+	Delimiter      rune                  `json:"delimiter"`
+	Columns        []entity.CSVColumn    `json:"columns"`
+	DateLayout     string                `json:"date_layout"`
+	HeaderLanguage entity.HeaderLanguage `json:"header_language"`
+}
+
+// ImportOnsenLogsInput は温泉メモのインポートの入力データです
+// Formatはjsonまたはcsv、Policyは既存の温泉メモと重複した場合の扱い（skip・overwrite・merge）です
+// DateLayoutはCSVの訪問日の日付レイアウトです（空の場合は標準の形式のみ読み取ります）
+type ImportOnsenLogsInput struct {
+	UserID     string              `json:"user_id"`
+	Format     string              `json:"format"`
+	Data       []byte              `json:"-"`
+	Policy     entity.ImportPolicy `json:"policy"`
+	DryRun     bool                `json:"dry_run"`
+	DateLayout string              `json:"date_layout"`
 }
 
 // UpdateOnsenLogInput は温泉メモ更新の入力データです
 type UpdateOnsenLogInput struct {
-	ID         string            `json:"id"`
-	UserID     string            `json:"user_id"`
-	Name       string            `json:"name"`
-	Location   string            `json:"location"`
-	SpringType entity.SpringType `json:"spring_type"`
-	Features   []entity.Feature  `json:"features"`
-	VisitDate  time.Time         `json:"visit_date"`
-	Rating     int               `json:"rating"`
-	Comment    string            `json:"comment"`
+	ID            string               `json:"id"`
+	UserID        string               `json:"user_id"`
+	OnsenID       string               `json:"onsen_id"`
+	Name          string               `json:"name"`
+	Location      string               `json:"location"`
+	Latitude      *float64             `json:"latitude"`
+	Longitude     *float64             `json:"longitude"`
+	SpringTypes   []entity.SpringType  `json:"spring_types"`
+	WaterAnalysis entity.WaterAnalysis `json:"water_analysis"`
+	Features      []entity.Feature     `json:"features"`
+	Tags          []string             `json:"tags"`
+	VisitDate     time.Time            `json:"visit_date"`
+	Visit         entity.VisitDetails  `json:"visit"`
+	Rating        *float64             `json:"rating"`
+	RatingScores  entity.RatingScores  `json:"rating_scores"`
+	Comment       string               `json:"comment"`
+	Visibility    entity.Visibility    `json:"visibility"`
 }
 
 // FilterOnsenLogsInput は温泉メモフィルタリングの入力データです
 type FilterOnsenLogsInput struct {
-	UserID     string            `json:"user_id"`
-	SpringType entity.SpringType `json:"spring_type"`
-	Location   string            `json:"location"`
-	MinRating  int               `json:"min_rating"`
-	StartDate  *time.Time        `json:"start_date"`
-	EndDate    *time.Time        `json:"end_date"`
-	Page       int               `json:"page"`
-	Limit      int               `json:"limit"`
+	UserID         string                         `json:"user_id"`
+	SpringTypes    []entity.SpringType            `json:"spring_types"`
+	Location       string                         `json:"location"`
+	Prefectures    []string                       `json:"prefectures"`
+	MinRating      float64                        `json:"min_rating"`
+	MinScores      map[entity.RatingCriterion]int `json:"min_scores"`
+	MinPH          *float64                       `json:"min_ph"`
+	MaxPH          *float64                       `json:"max_ph"`
+	MinTemperature *float64                       `json:"min_temperature"`
+	MaxTemperature *float64                       `json:"max_temperature"`
+	Tonicity       entity.Tonicity                `json:"tonicity"`
+	StartDate      *time.Time                     `json:"start_date"`
+	EndDate        *time.Time                     `json:"end_date"`
+	StayType       entity.StayType                `json:"stay_type"`
+	Companions     []entity.Companion             `json:"companions"`
+	ArrivalFrom    string                         `json:"arrival_from"`
+	ArrivalTo      string                         `json:"arrival_to"`
+	MinDuration    *float64                       `json:"min_duration"`
+	MaxDuration    *float64                       `json:"max_duration"`
+	MinTotalCost   *float64                       `json:"min_total_cost"`
+	MaxTotalCost   *float64                       `json:"max_total_cost"`
+	Currency       string                         `json:"currency"`
+	Query          string                         `json:"q"`
+	Tags           []string                       `json:"tags"`
+	TagMatch       entity.TagMatchMode            `json:"tag_match"`
+	SortBy         string                         `json:"sort_by"`
+	SortOrder      string                         `json:"sort_order"`
+	Page           int                            `json:"page"`
+	Limit          int                            `json:"limit"`
+}
+
+// NearbyOnsenLogsInput は周辺の温泉メモ検索の入力データです
+type NearbyOnsenLogsInput struct {
+	UserID    string  `json:"user_id"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	RadiusKm  float64 `json:"radius_km"`
+	Limit     int     `json:"limit"`
 }
 
 // OnsenLogOutputData は温泉メモの出力データです
 type OnsenLogOutputData struct {
-	ID         string            `json:"id"`
-	UserID     string            `json:"user_id"`
-	Name       string            `json:"name"`
-	Location   string            `json:"location"`
-	SpringType entity.SpringType `json:"spring_type"`
-	Features   []entity.Feature  `json:"features"`
-	VisitDate  time.Time         `json:"visit_date"`
-	Rating     int               `json:"rating"`
-	Comment    string            `json:"comment"`
-	CreatedAt  time.Time         `json:"created_at"`
-	UpdatedAt  time.Time         `json:"updated_at"`
-	Images     []ImageOutputData `json:"images,omitempty"`
+	ID               string                  `json:"id"`
+	UserID           string                  `json:"user_id"`
+	OnsenID          string                  `json:"onsen_id"`
+	Name             string                  `json:"name"`
+	Location         string                  `json:"location"`
+	Area             entity.LocationArea     `json:"area"`
+	Latitude         *float64                `json:"latitude,omitempty"`
+	Longitude        *float64                `json:"longitude,omitempty"`
+	SpringTypes      []entity.SpringType     `json:"spring_types"`
+	LegacySpringType string                  `json:"legacy_spring_type,omitempty"`
+	WaterAnalysis    entity.WaterAnalysis    `json:"water_analysis"`
+	LiquidClass      entity.LiquidClass      `json:"liquid_class,omitempty"`
+	TemperatureClass entity.TemperatureClass `json:"temperature_class,omitempty"`
+	Features         []entity.Feature        `json:"features"`
+	Tags             []string                `json:"tags"`
+	VisitDate        time.Time               `json:"visit_date"`
+	Visit            entity.VisitDetails     `json:"visit"`
+	TimeOfDay        entity.TimeOfDay        `json:"time_of_day,omitempty"`
+	Rating           float64                 `json:"rating"`
+	RatingScores     entity.RatingScores     `json:"rating_scores"`
+	RatingOverridden bool                    `json:"rating_overridden"`
+	Comment          string                  `json:"comment"`
+	Visibility       entity.Visibility       `json:"visibility"`
+	WishlistItemID   string                  `json:"wishlist_item_id,omitempty"`
+	CreatedAt        time.Time               `json:"created_at"`
+	UpdatedAt        time.Time               `json:"updated_at"`
+	Images           []ImageOutputData       `json:"images,omitempty"`
+	NewAchievements  []AchievementOutputData `json:"new_achievements,omitempty"`
 }
 
 // OnsenLogsOutputData は温泉メモリストの出力データです
@@ -106,3 +235,31 @@ type OnsenLogsOutputData struct {
 	Page       int                  `json:"page"`
 	Limit      int                  `json:"limit"`
 }
+
+// OnsenLogStatsOutputData は温泉メモの統計の出力データです
+type OnsenLogStatsOutputData struct {
+	TotalCount         int                         `json:"total_count"`
+	AverageRating      float64                     `json:"average_rating"`
+	FirstVisit         *time.Time                  `json:"first_visit"`
+	LastVisit          *time.Time                  `json:"last_visit"`
+	BySpringType       []entity.StatsCount         `json:"by_spring_type"`
+	ByPrefecture       []entity.StatsCount         `json:"by_prefecture"`
+	ByMonth            []entity.StatsCount         `json:"by_month"`
+	ByYear             []entity.StatsCount         `json:"by_year"`
+	RatingDistribution []entity.RatingBucket       `json:"rating_distribution"`
+	RatingByFeature    []entity.FeatureRatingStats `json:"rating_by_feature"`
+	Spending           []entity.CurrencySpending   `json:"spending"`
+	TopFacilities      []entity.FacilityVisitStats `json:"top_facilities"`
+}
+
+// ImportOnsenLogsOutputData は温泉メモのインポートの処理結果の出力データです
+type ImportOnsenLogsOutputData struct {
+	entity.ImportSummary
+	NewAchievements []AchievementOutputData `json:"new_achievements,omitempty"`
+}
+
+// NearbyOnsenLogOutputData は検索地点からの距離付きの温泉メモの出力データです
+type NearbyOnsenLogOutputData struct {
+	OnsenLogOutputData
+	DistanceKm float64 `json:"distance_km"`
+}
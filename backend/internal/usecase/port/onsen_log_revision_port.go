@@ -0,0 +1,72 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// OnsenLogRevisionInputPort は温泉メモの変更履歴ユースケースの入力ポートです
+type OnsenLogRevisionInputPort interface {
+	// GetRevisions は温泉メモの版を新しい順に取得します
+	GetRevisions(ctx context.Context, onsenLogID, userID string) ([]OnsenLogRevisionOutputData, error)
+
+	// DiffRevisions は温泉メモの2つの版を項目ごとに比較します
+	DiffRevisions(ctx context.Context, input DiffOnsenLogRevisionsInput) (OnsenLogRevisionDiffOutputData, error)
+
+	// RevertOnsenLog は温泉メモを以前の版の状態に戻します
+	RevertOnsenLog(ctx context.Context, input RevertOnsenLogInput) (OnsenLogOutputData, error)
+}
+
+// OnsenLogRevisionOutputPort は温泉メモの変更履歴ユースケースの出力ポートです
+type OnsenLogRevisionOutputPort interface {
+	// PresentRevisions は温泉メモの版のリストを表示します
+	PresentRevisions(ctx context.Context, data []OnsenLogRevisionOutputData) error
+
+	// PresentRevisionDiff は温泉メモの版の比較結果を表示します
+	PresentRevisionDiff(ctx context.Context, data OnsenLogRevisionDiffOutputData) error
+
+	// PresentRevertedOnsenLog は以前の版の状態に戻した温泉メモを表示します
+	PresentRevertedOnsenLog(ctx context.Context, data OnsenLogOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// DiffOnsenLogRevisionsInput は温泉メモの版の比較の入力データです
+// FromとToは版のIDまたは版番号です
+type DiffOnsenLogRevisionsInput struct {
+	OnsenLogID string
+	UserID     string
+	From       string
+	To         string
+}
+
+// RevertOnsenLogInput は温泉メモを以前の版に戻す入力データです
+// RevisionIDは版のIDまたは版番号です
+type RevertOnsenLogInput struct {
+	OnsenLogID string
+	RevisionID string
+	UserID     string
+}
+
+// OnsenLogRevisionOutputData は温泉メモの版の出力データです
+// Changesは版で変更された項目の変更前と変更後の値です
+type OnsenLogRevisionOutputData struct {
+	ID                string                `json:"id"`
+	Version           int                   `json:"version"`
+	Action            entity.RevisionAction `json:"action"`
+	ActorID           string                `json:"actor_id"`
+	RevertedToVersion int                   `json:"reverted_to_version,omitempty"`
+	ChangedFields     []string              `json:"changed_fields"`
+	Changes           []entity.FieldChange  `json:"changes"`
+	CreatedAt         time.Time             `json:"created_at"`
+}
+
+// OnsenLogRevisionDiffOutputData は温泉メモの2つの版の比較結果の出力データです
+type OnsenLogRevisionDiffOutputData struct {
+	From    OnsenLogRevisionOutputData `json:"from"`
+	To      OnsenLogRevisionOutputData `json:"to"`
+	Changes []entity.FieldChange       `json:"changes"`
+}
@@ -0,0 +1,13 @@
+package port
+
+// OnsenLogRevisionPresenterPort は温泉メモの変更履歴関連のレスポンスを整形するためのインターフェースです
+type OnsenLogRevisionPresenterPort interface {
+	// PresentRevisions は温泉メモの版のリストレスポンスを整形します
+	PresentRevisions(data []OnsenLogRevisionOutputData) map[string]interface{}
+
+	// PresentRevisionDiff は温泉メモの版の比較結果のレスポンスを整形します
+	PresentRevisionDiff(data OnsenLogRevisionDiffOutputData) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
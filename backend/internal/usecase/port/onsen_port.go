@@ -0,0 +1,67 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// OnsenInputPort は温泉施設ユースケースの入力ポートです
+type OnsenInputPort interface {
+	// CreateOnsen は新しい温泉施設を作成します
+	CreateOnsen(ctx context.Context, input CreateOnsenInput) (OnsenOutputData, error)
+
+	// GetOnsen は温泉施設を訪問集計付きで取得します
+	GetOnsen(ctx context.Context, id, userID string) (OnsenOutputData, error)
+
+	// GetOnsens はユーザーIDに紐づく温泉施設を訪問集計付きで取得します
+	GetOnsens(ctx context.Context, userID string) ([]OnsenOutputData, error)
+
+	// GetOnsenVisits は温泉施設に紐づく温泉メモを取得します
+	GetOnsenVisits(ctx context.Context, id, userID string) ([]OnsenLogOutputData, error)
+
+	// UpdateOnsen は温泉施設を更新します
+	UpdateOnsen(ctx context.Context, input UpdateOnsenInput) (OnsenOutputData, error)
+
+	// DeleteOnsen は温泉施設を削除します
+	DeleteOnsen(ctx context.Context, id, userID string) error
+}
+
+// OnsenOutputPort は温泉施設ユースケースの出力ポートです
+type OnsenOutputPort interface {
+	// PresentOnsen は温泉施設を表示します
+	PresentOnsen(ctx context.Context, data OnsenOutputData) error
+
+	// PresentOnsens は温泉施設のリストを表示します
+	PresentOnsens(ctx context.Context, data []OnsenOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// CreateOnsenInput は温泉施設作成の入力データです
+type CreateOnsenInput struct {
+	UserID   string `json:"user_id"`
+	Name     string `json:"name"`
+	Location string `json:"location"`
+}
+
+// UpdateOnsenInput は温泉施設更新の入力データです
+type UpdateOnsenInput struct {
+	ID       string `json:"id"`
+	UserID   string `json:"user_id"`
+	Name     string `json:"name"`
+	Location string `json:"location"`
+}
+
+// OnsenOutputData は温泉施設の出力データです
+type OnsenOutputData struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Location      string     `json:"location"`
+	VisitCount    int        `json:"visit_count"`
+	FirstVisit    *time.Time `json:"first_visit"`
+	LastVisit     *time.Time `json:"last_visit"`
+	AverageRating float64    `json:"average_rating"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
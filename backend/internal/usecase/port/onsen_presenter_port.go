@@ -0,0 +1,17 @@
+package port
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// OnsenPresenterPort は温泉施設関連のレスポンスを整形するためのインターフェースです
+type OnsenPresenterPort interface {
+	// PresentOnsen は単一の温泉施設レスポンスを整形します
+	PresentOnsen(onsen *entity.Onsen) map[string]interface{}
+
+	// PresentOnsens は複数の温泉施設レスポンスを整形します
+	PresentOnsens(onsens []*entity.Onsen) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
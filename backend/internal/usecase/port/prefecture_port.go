@@ -0,0 +1,56 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// PrefectureInputPort は都道府県ユースケースの入力ポートです
+type PrefectureInputPort interface {
+	// GetPrefectures は47都道府県を取得します
+	GetPrefectures(ctx context.Context) ([]PrefectureOutputData, error)
+
+	// GetCompletionMap はユーザーの47都道府県の制覇状況を取得します
+	GetCompletionMap(ctx context.Context, userID string) (PrefectureCompletionOutputData, error)
+}
+
+// PrefectureOutputPort は都道府県ユースケースの出力ポートです
+type PrefectureOutputPort interface {
+	// PresentPrefectures は都道府県のリストを表示します
+	PresentPrefectures(ctx context.Context, data []PrefectureOutputData) error
+
+	// PresentCompletionMap は都道府県の制覇状況を表示します
+	PresentCompletionMap(ctx context.Context, data PrefectureCompletionOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// PrefectureOutputData は都道府県の出力データです
+type PrefectureOutputData struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Kana   string `json:"kana"`
+	Romaji string `json:"romaji"`
+	Region string `json:"region"`
+}
+
+// PrefectureStatusOutputData は都道府県ごとの訪問状況の出力データです
+type PrefectureStatusOutputData struct {
+	PrefectureOutputData
+	Visited    bool       `json:"visited"`
+	VisitCount int        `json:"visit_count"`
+	FirstVisit *time.Time `json:"first_visit"`
+	LastVisit  *time.Time `json:"last_visit"`
+}
+
+// PrefectureCompletionOutputData は47都道府県の制覇状況の出力データです
+// UnresolvedCountは所在地から都道府県を判定できなかった温泉メモの件数です
+type PrefectureCompletionOutputData struct {
+	Prefectures     []PrefectureStatusOutputData `json:"prefectures"`
+	TotalCount      int                          `json:"total_count"`
+	VisitedCount    int                          `json:"visited_count"`
+	UnvisitedCount  int                          `json:"unvisited_count"`
+	CompletionRate  float64                      `json:"completion_rate"`
+	UnresolvedCount int                          `json:"unresolved_count"`
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// PrefecturePresenterPort は都道府県関連のレスポンスを整形するためのインターフェースです
+type PrefecturePresenterPort interface {
+	// PresentPrefectures は都道府県のリストレスポンスを整形します
+	PresentPrefectures(prefectures []entity.Prefecture) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
@@ -0,0 +1,93 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// ShareLinkInputPort は共有リンクユースケースの入力ポートです
+type ShareLinkInputPort interface {
+	// CreateShareLink は温泉メモの共有リンクを作成します
+	CreateShareLink(ctx context.Context, input CreateShareLinkInput) (ShareLinkOutputData, error)
+
+	// GetShareLinks はユーザーの有効な共有リンクを取得します
+	GetShareLinks(ctx context.Context, userID string) ([]ShareLinkOutputData, error)
+
+	// RevokeShareLink は共有リンクを取り消します
+	RevokeShareLink(ctx context.Context, id, userID string) error
+
+	// GetPublicOnsenLog は共有リンクで公開されている温泉メモを取得します
+	GetPublicOnsenLog(ctx context.Context, input GetPublicOnsenLogInput) (PublicOnsenLogOutputData, error)
+}
+
+// ShareLinkOutputPort は共有リンクユースケースの出力ポートです
+type ShareLinkOutputPort interface {
+	// PresentShareLink は共有リンクを表示します
+	PresentShareLink(ctx context.Context, data ShareLinkOutputData) error
+
+	// PresentShareLinks は共有リンクのリストを表示します
+	PresentShareLinks(ctx context.Context, data []ShareLinkOutputData) error
+
+	// PresentPublicOnsenLog は公開されている温泉メモを表示します
+	PresentPublicOnsenLog(ctx context.Context, data PublicOnsenLogOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// CreateShareLinkInput は共有リンク作成の入力データです
+// ExpiresInが0の場合は無期限の共有リンクになります
+type CreateShareLinkInput struct {
+	OnsenLogID string        `json:"onsen_log_id"`
+	UserID     string        `json:"user_id"`
+	ExpiresIn  time.Duration `json:"expires_in"`
+}
+
+// GetPublicOnsenLogInput は公開されている温泉メモ取得の入力データです
+// ViewerIDはログインしている閲覧者のユーザーIDで、未ログインの場合は空です
+type GetPublicOnsenLogInput struct {
+	Token    string `json:"token"`
+	ViewerID string `json:"viewer_id"`
+}
+
+// ShareLinkOutputData は共有リンクの出力データです
+type ShareLinkOutputData struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	OnsenLogID string     `json:"onsen_log_id"`
+	Token      string     `json:"token"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// PublicOnsenLogOutputData は共有リンクで公開する温泉メモの出力データです
+// ユーザーIDや内部のID、同行者や費用などの訪問時の状況は含めません
+type PublicOnsenLogOutputData struct {
+	Name             string                  `json:"name"`
+	Location         string                  `json:"location"`
+	Area             entity.LocationArea     `json:"area"`
+	Latitude         *float64                `json:"latitude,omitempty"`
+	Longitude        *float64                `json:"longitude,omitempty"`
+	SpringTypes      []entity.SpringType     `json:"spring_types"`
+	WaterAnalysis    entity.WaterAnalysis    `json:"water_analysis"`
+	LiquidClass      entity.LiquidClass      `json:"liquid_class,omitempty"`
+	TemperatureClass entity.TemperatureClass `json:"temperature_class,omitempty"`
+	Features         []entity.Feature        `json:"features"`
+	Tags             []string                `json:"tags"`
+	VisitDate        time.Time               `json:"visit_date"`
+	TimeOfDay        entity.TimeOfDay        `json:"time_of_day,omitempty"`
+	Rating           float64                 `json:"rating"`
+	RatingScores     entity.RatingScores     `json:"rating_scores"`
+	Comment          string                  `json:"comment"`
+	Images           []PublicImageOutputData `json:"images"`
+	ExpiresAt        *time.Time              `json:"expires_at"`
+	IsOwner          bool                    `json:"is_owner"`
+}
+
+// PublicImageOutputData は共有リンクで公開する画像の出力データです
+type PublicImageOutputData struct {
+	URL         string `json:"url"`
+	Description string `json:"description"`
+}
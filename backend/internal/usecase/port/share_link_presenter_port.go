@@ -0,0 +1,20 @@
+package port
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// ShareLinkPresenterPort は共有リンク関連のレスポンスを整形するためのインターフェースです
+type ShareLinkPresenterPort interface {
+	// PresentShareLink は共有リンクのレスポンスを整形します
+	PresentShareLink(shareLink *entity.ShareLink) map[string]interface{}
+
+	// PresentShareLinks は共有リンクのリストレスポンスを整形します
+	PresentShareLinks(shareLinks []*entity.ShareLink) map[string]interface{}
+
+	// PresentPublicOnsenLog は公開されている温泉メモのレスポンスを整形します
+	PresentPublicOnsenLog(data PublicOnsenLogOutputData) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
@@ -0,0 +1,60 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// TagInputPort はタグ管理ユースケースの入力ポートです
+type TagInputPort interface {
+	// GetTags はユーザーが使用しているタグを使用回数付きで取得します
+	GetTags(ctx context.Context, userID string) ([]TagOutputData, error)
+
+	// RenameTag はすべての温泉メモでタグの名前を変更します
+	RenameTag(ctx context.Context, input RenameTagInput) (TagUpdateOutputData, error)
+
+	// MergeTags は統合元のタグを統合先のタグに統合します
+	MergeTags(ctx context.Context, input MergeTagsInput) (TagUpdateOutputData, error)
+
+	// DeleteTag はすべての温泉メモからタグを削除します
+	DeleteTag(ctx context.Context, userID, name string) (TagUpdateOutputData, error)
+}
+
+// TagOutputPort はタグ管理ユースケースの出力ポートです
+type TagOutputPort interface {
+	// PresentTags はタグのリストを表示します
+	PresentTags(ctx context.Context, data []TagOutputData) error
+
+	// PresentTagUpdate はタグの更新結果を表示します
+	PresentTagUpdate(ctx context.Context, data TagUpdateOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// RenameTagInput はタグ名変更の入力データです
+type RenameTagInput struct {
+	UserID  string `json:"user_id"`
+	Name    string `json:"name"`
+	NewName string `json:"new_name"`
+}
+
+// MergeTagsInput はタグ統合の入力データです
+type MergeTagsInput struct {
+	UserID string `json:"user_id"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// TagOutputData はタグの出力データです
+type TagOutputData struct {
+	Name       string    `json:"name"`
+	Count      int       `json:"count"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+// TagUpdateOutputData はタグの更新結果の出力データです
+type TagUpdateOutputData struct {
+	Tag          string `json:"tag,omitempty"`
+	UpdatedCount int    `json:"updated_count"`
+}
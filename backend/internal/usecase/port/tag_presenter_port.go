@@ -0,0 +1,14 @@
+package port
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// TagPresenterPort はタグ関連のレスポンスを整形するためのインターフェースです
+type TagPresenterPort interface {
+	// PresentTags はタグのリストレスポンスを整形します
+	PresentTags(tags []*entity.TagUsage) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
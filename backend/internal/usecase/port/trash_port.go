@@ -0,0 +1,69 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// TrashInputPort はゴミ箱ユースケースの入力ポートです
+type TrashInputPort interface {
+	// GetTrash はゴミ箱の温泉メモと画像を取得します
+	GetTrash(ctx context.Context, userID string) (TrashOutputData, error)
+
+	// RestoreOnsenLog はゴミ箱の温泉メモを復元します
+	RestoreOnsenLog(ctx context.Context, id, userID string) (OnsenLogOutputData, error)
+
+	// PurgeOnsenLog はゴミ箱の温泉メモを完全に削除します
+	PurgeOnsenLog(ctx context.Context, id, userID string) error
+
+	// RestoreImage はゴミ箱の温泉画像を復元します
+	RestoreImage(ctx context.Context, id, userID string) (ImageOutputData, error)
+
+	// PurgeImage はゴミ箱の温泉画像を完全に削除します
+	PurgeImage(ctx context.Context, id, userID string) error
+
+	// PurgeExpiredTrash は保持期間を過ぎたゴミ箱の温泉メモと画像を完全に削除し、削除した件数を返します
+	PurgeExpiredTrash(ctx context.Context) (int, error)
+}
+
+// TrashOutputPort はゴミ箱ユースケースの出力ポートです
+type TrashOutputPort interface {
+	// PresentTrash はゴミ箱の温泉メモと画像のリストを表示します
+	PresentTrash(ctx context.Context, data TrashOutputData) error
+
+	// PresentRestoredOnsenLog は復元した温泉メモを表示します
+	PresentRestoredOnsenLog(ctx context.Context, data OnsenLogOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// TrashOutputData はゴミ箱の出力データです
+// OnsenImagesは温泉メモと別に削除した画像です（温泉メモとともに削除した画像は温泉メモとともに復元します）
+type TrashOutputData struct {
+	OnsenLogs   []TrashItemOutputData      `json:"onsen_logs"`
+	OnsenImages []TrashImageItemOutputData `json:"onsen_images"`
+}
+
+// TrashItemOutputData はゴミ箱の温泉メモの出力データです
+// PurgeAtは保持期間が過ぎて完全に削除される予定日時です
+type TrashItemOutputData struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Location  string    `json:"location"`
+	VisitDate time.Time `json:"visit_date"`
+	Rating    float64   `json:"rating"`
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+// TrashImageItemOutputData はゴミ箱の温泉画像の出力データです
+// PurgeAtは保持期間が過ぎて完全に削除される予定日時です
+type TrashImageItemOutputData struct {
+	ID          string    `json:"id"`
+	OnsenID     string    `json:"onsen_id"`
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	DeletedAt   time.Time `json:"deleted_at"`
+	PurgeAt     time.Time `json:"purge_at"`
+}
@@ -0,0 +1,10 @@
+package port
+
+// TrashPresenterPort はゴミ箱関連のレスポンスを整形するためのインターフェースです
+type TrashPresenterPort interface {
+	// PresentTrash はゴミ箱の温泉メモと画像のリストレスポンスを整形します
+	PresentTrash(data TrashOutputData) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
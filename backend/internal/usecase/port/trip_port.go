@@ -0,0 +1,89 @@
+package port
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// TripInputPort は旅行ユースケースの入力ポートです
+type TripInputPort interface {
+	// CreateTrip は新しい旅行を作成します
+	CreateTrip(ctx context.Context, input CreateTripInput) (TripOutputData, error)
+
+	// GetTrip は旅行を温泉メモと統計付きで取得します
+	GetTrip(ctx context.Context, id, userID string) (TripOutputData, error)
+
+	// GetTrips はユーザーの旅行を開始日の新しい順に取得します
+	GetTrips(ctx context.Context, userID string) ([]TripOutputData, error)
+
+	// UpdateTrip は旅行を更新します
+	UpdateTrip(ctx context.Context, input UpdateTripInput) (TripOutputData, error)
+
+	// DeleteTrip は旅行を削除します
+	DeleteTrip(ctx context.Context, id, userID string) error
+
+	// ExportTrip は旅行に含まれる温泉メモを旅程の順にwに書き出します
+	ExportTrip(ctx context.Context, input ExportTripInput, w io.Writer) error
+}
+
+// TripOutputPort は旅行ユースケースの出力ポートです
+type TripOutputPort interface {
+	// PresentTrip は旅行を表示します
+	PresentTrip(ctx context.Context, data TripOutputData) error
+
+	// PresentTrips は旅行のリストを表示します
+	PresentTrips(ctx context.Context, data []TripOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// CreateTripInput は旅行作成の入力データです
+type CreateTripInput struct {
+	UserID      string    `json:"user_id"`
+	Title       string    `json:"title"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	Notes       string    `json:"notes"`
+	OnsenLogIDs []string  `json:"onsen_log_ids"`
+}
+
+// UpdateTripInput は旅行更新の入力データです
+type UpdateTripInput struct {
+	ID          string    `json:"id"`
+	UserID      string    `json:"user_id"`
+	Title       string    `json:"title"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	Notes       string    `json:"notes"`
+	OnsenLogIDs []string  `json:"onsen_log_ids"`
+}
+
+// ExportTripInput は旅行のエクスポートの入力データです
+// Progressは温泉メモのエクスポートと同様に、温泉メモを1件書き出すごとに呼び出します
+type ExportTripInput struct {
+	ID       string              `json:"id"`
+	UserID   string              `json:"user_id"`
+	Format   string              `json:"format"`
+	CSV      CSVExportOptions    `json:"csv"`
+	Progress func(processed int) `json:"-"`
+}
+
+// TripOutputData は旅行の出力データです
+// OnsenLogsとStatsは旅行の詳細・作成・更新でのみ設定されます
+type TripOutputData struct {
+	ID          string               `json:"id"`
+	UserID      string               `json:"user_id"`
+	Title       string               `json:"title"`
+	StartDate   time.Time            `json:"start_date"`
+	EndDate     time.Time            `json:"end_date"`
+	Notes       string               `json:"notes"`
+	OnsenLogIDs []string             `json:"onsen_log_ids"`
+	OnsenLogs   []OnsenLogOutputData `json:"onsen_logs,omitempty"`
+	Stats       *entity.TripStats    `json:"stats,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
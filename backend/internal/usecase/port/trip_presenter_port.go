@@ -0,0 +1,17 @@
+package port
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// TripPresenterPort は旅行関連のレスポンスを整形するためのインターフェースです
+type TripPresenterPort interface {
+	// PresentTrip は旅行のレスポンスを整形します
+	PresentTrip(trip *entity.Trip) map[string]interface{}
+
+	// PresentTrips は旅行のリストレスポンスを整形します
+	PresentTrips(trips []*entity.Trip) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}
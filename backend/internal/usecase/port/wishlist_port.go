@@ -0,0 +1,102 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// WishlistInputPort は行きたい温泉ユースケースの入力ポートです
+type WishlistInputPort interface {
+	// CreateWishlistItem は新しい行きたい温泉を作成します
+	CreateWishlistItem(ctx context.Context, input CreateWishlistItemInput) (WishlistItemOutputData, error)
+
+	// GetWishlistItem は行きたい温泉を取得します
+	GetWishlistItem(ctx context.Context, id, userID string) (WishlistItemOutputData, error)
+
+	// GetWishlist はユーザーの行きたい温泉を優先度の高い順に取得します
+	GetWishlist(ctx context.Context, input GetWishlistInput) ([]WishlistItemOutputData, error)
+
+	// UpdateWishlistItem は行きたい温泉を更新します
+	UpdateWishlistItem(ctx context.Context, input UpdateWishlistItemInput) (WishlistItemOutputData, error)
+
+	// DeleteWishlistItem は行きたい温泉を削除します
+	DeleteWishlistItem(ctx context.Context, id, userID string) error
+
+	// ConvertToOnsenLog は訪問した行きたい温泉を温泉メモに変換します
+	ConvertToOnsenLog(ctx context.Context, input ConvertWishlistItemInput) (ConvertWishlistItemOutputData, error)
+}
+
+// WishlistOutputPort は行きたい温泉ユースケースの出力ポートです
+type WishlistOutputPort interface {
+	// PresentWishlistItem は行きたい温泉を表示します
+	PresentWishlistItem(ctx context.Context, data WishlistItemOutputData) error
+
+	// PresentWishlist は行きたい温泉のリストを表示します
+	PresentWishlist(ctx context.Context, data []WishlistItemOutputData) error
+
+	// PresentConversion は温泉メモへの変換結果を表示します
+	PresentConversion(ctx context.Context, data ConvertWishlistItemOutputData) error
+
+	// PresentError はエラーを表示します
+	PresentError(ctx context.Context, err error) error
+}
+
+// CreateWishlistItemInput は行きたい温泉作成の入力データです
+type CreateWishlistItemInput struct {
+	UserID       string                  `json:"user_id"`
+	Name         string                  `json:"name"`
+	Location     string                  `json:"location"`
+	Notes        string                  `json:"notes"`
+	Priority     entity.WishlistPriority `json:"priority"`
+	TargetSeason entity.Season           `json:"target_season"`
+}
+
+// UpdateWishlistItemInput は行きたい温泉更新の入力データです
+type UpdateWishlistItemInput struct {
+	ID           string                  `json:"id"`
+	UserID       string                  `json:"user_id"`
+	Name         string                  `json:"name"`
+	Location     string                  `json:"location"`
+	Notes        string                  `json:"notes"`
+	Priority     entity.WishlistPriority `json:"priority"`
+	TargetSeason entity.Season           `json:"target_season"`
+}
+
+// GetWishlistInput は行きたい温泉一覧取得の入力データです
+type GetWishlistInput struct {
+	UserID       string                `json:"user_id"`
+	Status       entity.WishlistStatus `json:"status"`
+	TargetSeason entity.Season         `json:"target_season"`
+}
+
+// ConvertWishlistItemInput は行きたい温泉を温泉メモに変換する入力データです
+// OnsenLogの温泉名と所在地が空の場合は行きたい温泉の値で補います
+type ConvertWishlistItemInput struct {
+	ID       string              `json:"id"`
+	UserID   string              `json:"user_id"`
+	OnsenLog CreateOnsenLogInput `json:"onsen_log"`
+}
+
+// WishlistItemOutputData は行きたい温泉の出力データです
+type WishlistItemOutputData struct {
+	ID           string                  `json:"id"`
+	UserID       string                  `json:"user_id"`
+	Name         string                  `json:"name"`
+	Location     string                  `json:"location"`
+	Notes        string                  `json:"notes"`
+	Priority     entity.WishlistPriority `json:"priority"`
+	TargetSeason entity.Season           `json:"target_season"`
+	Visited      bool                    `json:"visited"`
+	OnsenLogID   string                  `json:"onsen_log_id"`
+	VisitedAt    *time.Time              `json:"visited_at"`
+	CreatedAt    time.Time               `json:"created_at"`
+	UpdatedAt    time.Time               `json:"updated_at"`
+}
+
+// ConvertWishlistItemOutputData は温泉メモへの変換結果の出力データです
+type ConvertWishlistItemOutputData struct {
+	WishlistItem WishlistItemOutputData `json:"wishlist_item"`
+	OnsenLog     OnsenLogOutputData     `json:"onsen_log"`
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"github.com/yourusername/yuroku/internal/domain/entity"
+)
+
+// WishlistPresenterPort は行きたい温泉関連のレスポンスを整形するためのインターフェースです
+type WishlistPresenterPort interface {
+	// PresentWishlistItem は行きたい温泉のレスポンスを整形します
+	PresentWishlistItem(item *entity.WishlistItem) map[string]interface{}
+
+	// PresentWishlist は行きたい温泉のリストレスポンスを整形します
+	PresentWishlist(items []*entity.WishlistItem) map[string]interface{}
+
+	// PresentError はエラーレスポンスを整形します
+	PresentError(err error) map[string]interface{}
+}